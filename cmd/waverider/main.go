@@ -21,7 +21,9 @@ package main
 
 import (
 	"bufio"
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -44,13 +46,13 @@ func main() {
 	hfmi.SetSegmentCache(5 * 1024 * 1024)
 
 	var rootCmd = &cobra.Command{Use: "waverider"}
-	rootCmd.AddCommand(indexCommand(), queryCommand(), purgeCommand())
+	rootCmd.AddCommand(indexCommand(), queryCommand(), purgeCommand(), compactCommand(), fsckCommand(), exportCommand(), namespaceCommand(), keysCommand())
 	rootCmd.Execute()
 }
 
 func indexCommand() *cobra.Command {
-	var timestamp string
-	var keys []string
+	var timestamp, namespace string
+	var keys, pairs []string
 	cmd := &cobra.Command{
 		Use:   "index [path to json file]",
 		Short: "Build index in -d {dir}",
@@ -68,6 +70,18 @@ func indexCommand() *cobra.Command {
 				os.Exit(1)
 			}
 
+			ns, err := embed.Default().Namespace(namespace)
+			if err != nil {
+				printToScreen("Error: "+err.Error(), true)
+				os.Exit(1)
+			}
+
+			labels, err := embed.ParseLabels(pairs)
+			if err != nil {
+				printToScreen("Error: "+err.Error(), true)
+				os.Exit(1)
+			}
+
 			for _, f := range args {
 				info, err := os.Stat(f)
 				if err != nil {
@@ -76,7 +90,7 @@ func indexCommand() *cobra.Command {
 				}
 
 				s := time.Now()
-				if err := embed.Build(f, timestamp, keys); err != nil {
+				if err := ns.BuildWithLabels(f, timestamp, keys, labels); err != nil {
 					fmt.Printf("indexing %v err: %v\n", f, err)
 				} else {
 					fmt.Printf("indexed %v with %d Bytes in %v\n", f, info.Size(), time.Since(s))
@@ -87,12 +101,17 @@ func indexCommand() *cobra.Command {
 
 	cmd.Flags().StringVarP(&embed.Directory, "dir", "d", "data", "index directory")
 	cmd.Flags().StringVarP(&timestamp, "timestamp", "t", "", "JSON path to timestamp field, example '/timestamp'")
-	cmd.Flags().StringSliceVarP(&keys, "keys", "k", nil, "JSON path to key field in JSON can be queried by key, w/o time range")
+	cmd.Flags().StringVarP(&embed.TimeUnit, "unit", "u", "", "unit of integer timestamp, one of s, ms, us, ns, default to the unit of index directory or us")
+	cmd.Flags().StringVarP(&embed.CatalogType, "catalog", "c", "", "catalog of new index directory, one of sqlite, journal, default to sqlite when built with cgo")
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "namespace of data, queried by FROM, default namespace if empty")
+	cmd.Flags().StringArrayVarP(&keys, "keys", "k", nil, "JSON path to key field in JSON can be queried by key, w/o time range, composite key joins paths with +, e.g. /traceID+/spanID")
+	cmd.Flags().StringArrayVarP(&pairs, "labels", "l", nil, "label of index blocks in form of key=value, queried by FROM, e.g. -l env=prod -l region=us-east")
 
 	return cmd
 }
 
 func queryCommand() *cobra.Command {
+	var explain bool
 	cmd := &cobra.Command{
 		Use:   "query [SSQL query]",
 		Short: "Execute SSQL",
@@ -142,17 +161,29 @@ func queryCommand() *cobra.Command {
 				return
 			}
 
+			if explain {
+				stmt.Explain = true
+			}
+
 			answer := embed.Query(context.Background(), stmt)
+			if stmt.Explain {
+				var indented bytes.Buffer
+				if err := json.Indent(&indented, answer, "", "  "); err == nil {
+					answer = indented.Bytes()
+				}
+			}
 			fmt.Println(string(answer))
 		},
 	}
 
 	cmd.Flags().StringVarP(&embed.Directory, "dir", "d", "data", "index directory")
+	cmd.Flags().BoolVarP(&explain, "explain", "e", false, "explain query execution instead of returning result, same as EXPLAIN prefix")
 
 	return cmd
 }
 
 func purgeCommand() *cobra.Command {
+	var namespace string
 	cmd := &cobra.Command{
 		Use:   "purge [local time]",
 		Short: "Purge data before local time, as in 2019-09-25T10:38:38",
@@ -170,7 +201,16 @@ func purgeCommand() *cobra.Command {
 				panic(err)
 			}
 
-			if err = embed.Purge(context.Background(), time); err != nil {
+			if cmd.Flags().Changed("namespace") {
+				var ns *embed.Namespace
+				if ns, err = embed.Default().Namespace(namespace); err == nil {
+					err = ns.Purge(context.Background(), time)
+				}
+			} else {
+				err = embed.Purge(context.Background(), time)
+			}
+
+			if err != nil {
 				panic(err)
 			} else {
 				printToScreen(fmt.Sprintf("purged data before %v", time), true)
@@ -179,6 +219,323 @@ func purgeCommand() *cobra.Command {
 	}
 
 	cmd.Flags().StringVarP(&embed.Directory, "dir", "d", "data", "index directory")
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "purge only the namespace, all namespaces if not set")
+
+	return cmd
+}
+
+func compactCommand() *cobra.Command {
+	var namespace string
+	cmd := &cobra.Command{
+		Use:   "compact",
+		Short: "Merge small index blocks in -d {dir}",
+		Long:  `Merge adjacent small index blocks of the same labels and keys into larger blocks`,
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			close, err := embed.Verify()
+			if err != nil {
+				panic(err)
+			}
+			defer close()
+
+			store := embed.Default()
+			names := []string{namespace}
+			if !cmd.Flags().Changed("namespace") {
+				if names, err = store.Namespaces(); err != nil {
+					panic(err)
+				}
+				names = append([]string{""}, names...)
+			}
+
+			for _, name := range names {
+				ns, err := store.Namespace(name)
+				if err != nil {
+					printToScreen("Error: "+err.Error(), true)
+					os.Exit(1)
+				}
+
+				s := time.Now()
+				merged, err := ns.Compact(context.Background())
+				if err != nil {
+					panic(err)
+				}
+				fmt.Printf("compacted %d index blocks of namespace [%v] in %v\n", merged, name, time.Since(s))
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&embed.Directory, "dir", "d", "data", "index directory")
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "compact only the namespace, all namespaces if not set")
+
+	return cmd
+}
+
+func exportCommand() *cobra.Command {
+	var from, to, query string
+	var opts embed.ExportOptions
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Restore JSON documents from -d {dir} as NDJSON in timestamp order",
+		Long:  `Restore documents between local time --from and --to, as in 2019-09-25T10:38:38, filtered by WHERE of --query, output can be indexed again`,
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			close, err := embed.Verify()
+			if err != nil {
+				panic(err)
+			}
+			defer close()
+
+			if len(opts.Timestamp) == 0 {
+				printToScreen("Error: timestamp field must not be empty", true)
+				os.Exit(1)
+			}
+
+			for _, t := range []struct {
+				value string
+				time  *time.Time
+			}{{from, &opts.From}, {to, &opts.To}} {
+				if len(t.value) == 0 {
+					continue
+				}
+				if *t.time, err = dateparse.ParseLocal(t.value); err != nil {
+					printToScreen("Error: "+err.Error(), true)
+					os.Exit(1)
+				}
+			}
+
+			if len(query) > 0 {
+				stmt, errs := parser.Parse(query)
+				if len(errs) > 0 {
+					for _, e := range errs {
+						printToScreen(fmt.Sprintf("Error: %d:%d %v", e.Line, e.Column, e.Message), true)
+					}
+					os.Exit(1)
+				}
+				opts.Query = stmt
+			}
+
+			if _, err = embed.Export(context.Background(), os.Stdout, opts); err != nil {
+				printToScreen("Error: "+err.Error(), true)
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&embed.Directory, "dir", "d", "data", "index directory")
+	cmd.Flags().StringVarP(&opts.Timestamp, "timestamp", "t", "", "JSON path to timestamp field, example '/timestamp'")
+	cmd.Flags().StringVar(&from, "from", "", "local time of the first exported document, from the earliest if not set")
+	cmd.Flags().StringVar(&to, "to", "", "local time of the last exported document, to the latest if not set")
+	cmd.Flags().StringVarP(&query, "query", "q", "", "SSQL selecting documents by its FROM and WHERE, e.g. 'find $a from tenantA where [$a /process [/serviceName contain(\"redis\")]]'")
+
+	return cmd
+}
+
+func fsckCommand() *cobra.Command {
+	var namespace string
+	var opts embed.FsckOptions
+	cmd := &cobra.Command{
+		Use:   "fsck",
+		Short: "Check catalog against index files in -d {dir}",
+		Long:  `Decode every index block, restore sample documents, report missing, corrupted and orphaned blocks and WAL leftovers, fix them with --repair`,
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			close, err := embed.Verify()
+			if err != nil {
+				panic(err)
+			}
+			defer close()
+
+			var report *embed.FsckReport
+			if cmd.Flags().Changed("namespace") {
+				var ns *embed.Namespace
+				if ns, err = embed.Default().Namespace(namespace); err == nil {
+					report, err = ns.Fsck(context.Background(), opts)
+				}
+			} else {
+				report, err = embed.Fsck(context.Background(), opts)
+			}
+			if err != nil {
+				printToScreen("Error: "+err.Error(), true)
+				os.Exit(1)
+			}
+
+			fmt.Printf("checked %d index blocks\n", report.Blocks)
+			for _, f := range report.Missing {
+				fmt.Printf("missing: %v\n", f)
+			}
+			for _, f := range report.Corrupted {
+				fmt.Printf("corrupted: %v\n", f)
+			}
+			for _, f := range report.Orphaned {
+				fmt.Printf("orphaned: %v\n", f)
+			}
+			for _, f := range report.Leftovers {
+				fmt.Printf("WAL leftover: %v\n", f)
+			}
+			for _, id := range report.Dangling {
+				fmt.Printf("dangling catalog entries: %016X\n", id)
+			}
+			for _, r := range report.Repaired {
+				fmt.Printf("repaired: %v\n", r)
+			}
+
+			if !report.Healthy() && !opts.Repair {
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&embed.Directory, "dir", "d", "data", "index directory")
+	cmd.Flags().StringVarP(&namespace, "namespace", "n", "", "check only the namespace, all namespaces if not set")
+	cmd.Flags().BoolVar(&opts.Repair, "repair", false, "unregister missing blocks, quarantine corrupted and orphaned files, resolve WAL leftovers")
+	cmd.Flags().StringVarP(&opts.Timestamp, "timestamp", "t", "", "JSON path to timestamp field, orphaned blocks are registered again instead of quarantined when set")
+	cmd.Flags().StringArrayVarP(&opts.Keys, "keys", "k", nil, "JSON path to key field of orphaned blocks registered again")
+
+	return cmd
+}
+
+func namespaceCommand() *cobra.Command {
+	var (
+		quota     int64
+		retention time.Duration
+	)
+	cmd := &cobra.Command{
+		Use:   "namespace [name]",
+		Short: "List namespaces, or show and change quota and retention of namespace",
+		Long:  `Without name, lists namespaces. Quota is max bytes of index blocks, retention is kept duration as in 720h, 0 is unlimited`,
+		Args:  cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			close, err := embed.Verify()
+			if err != nil {
+				panic(err)
+			}
+			defer close()
+
+			store := embed.Default()
+			if len(args) == 0 {
+				names, err := store.Namespaces()
+				if err != nil {
+					panic(err)
+				}
+				for _, name := range names {
+					fmt.Println(name)
+				}
+				return
+			}
+
+			ns, err := store.Namespace(args[0])
+			if err != nil {
+				printToScreen("Error: "+err.Error(), true)
+				os.Exit(1)
+			}
+
+			if cmd.Flags().Changed("quota") {
+				if err = ns.SetQuota(quota); err != nil {
+					panic(err)
+				}
+			}
+			if cmd.Flags().Changed("retention") {
+				if err = ns.SetRetention(retention); err != nil {
+					panic(err)
+				}
+			}
+
+			fmt.Printf("namespace: %v\nusage: %d bytes\nquota: %d bytes\nretention: %v\n",
+				ns.Name(), ns.Usage(), ns.Quota(), ns.Retention())
+		},
+	}
+
+	cmd.Flags().StringVarP(&embed.Directory, "dir", "d", "data", "index directory")
+	cmd.Flags().Int64VarP(&quota, "quota", "q", 0, "max bytes of index blocks, 0 is unlimited")
+	cmd.Flags().DurationVarP(&retention, "retention", "r", 0, "how long data is kept, 0 keeps data until purged")
+
+	return cmd
+}
+
+func keysCommand() *cobra.Command {
+	var namespace string
+	cmd := &cobra.Command{
+		Use:   "keys",
+		Short: "Add, list and drop keys of namespace in -d {dir}",
+		Long:  `Keys added to namespace are indexed in every new index block, and backfilled into existing blocks`,
+	}
+
+	run := func(do func(ns *embed.Namespace, args []string)) func(cmd *cobra.Command, args []string) {
+		return func(cmd *cobra.Command, args []string) {
+			close, err := embed.Verify()
+			if err != nil {
+				panic(err)
+			}
+			defer close()
+
+			ns, err := embed.Default().Namespace(namespace)
+			if err != nil {
+				printToScreen("Error: "+err.Error(), true)
+				os.Exit(1)
+			}
+			do(ns, args)
+		}
+	}
+
+	add := &cobra.Command{
+		Use:   "add [key]",
+		Short: "Add keys to namespace and backfill existing index blocks",
+		Long:  `Composite key joins JSON paths with +, e.g. /traceID+/spanID`,
+		Args:  cobra.MinimumNArgs(1),
+		Run: run(func(ns *embed.Namespace, args []string) {
+			for _, key := range args {
+				if err := ns.AddKey(key); err != nil {
+					printToScreen("Error: "+err.Error(), true)
+					os.Exit(1)
+				}
+			}
+
+			s := time.Now()
+			filled, err := ns.Backfill(context.Background())
+			if err != nil {
+				panic(err)
+			}
+			fmt.Printf("backfilled %d index blocks of namespace [%v] in %v\n", filled, ns.Name(), time.Since(s))
+		}),
+	}
+
+	list := &cobra.Command{
+		Use:   "list",
+		Short: "List keys of namespace with number of index blocks keyed by them",
+		Args:  cobra.NoArgs,
+		Run: run(func(ns *embed.Namespace, args []string) {
+			keys, err := ns.ListKeys()
+			if err != nil {
+				panic(err)
+			}
+			for _, k := range keys {
+				declared := "index time"
+				if k.Declared {
+					declared = "added"
+				}
+				fmt.Printf("%v\t%v\t%d blocks\n", k.Key, declared, k.Blocks)
+			}
+		}),
+	}
+
+	drop := &cobra.Command{
+		Use:   "drop [key]",
+		Short: "Drop keys from namespace and its index blocks",
+		Args:  cobra.MinimumNArgs(1),
+		Run: run(func(ns *embed.Namespace, args []string) {
+			for _, key := range args {
+				if err := ns.DropKey(key); err != nil {
+					panic(err)
+				}
+			}
+		}),
+	}
+
+	for _, c := range []*cobra.Command{add, list, drop} {
+		c.Flags().StringVarP(&embed.Directory, "dir", "d", "data", "index directory")
+		c.Flags().StringVarP(&namespace, "namespace", "n", "", "namespace of keys, default namespace if empty")
+		cmd.AddCommand(c)
+	}
 
 	return cmd
 }
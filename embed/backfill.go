@@ -0,0 +1,245 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package embed
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+
+	ssdexec "github.com/chronowave/chronowave/ssd/exec"
+	"github.com/chronowave/chronowave/ssql"
+)
+
+// KeyInfo is key of namespace and number of index blocks keyed by it
+type KeyInfo struct {
+	Key string
+	// Declared tells key is added to namespace, otherwise it is only given at index time of blocks
+	Declared bool
+	Blocks   int
+}
+
+// Keys returns keys added to namespace, every new index block of namespace is keyed by them
+func (n *Namespace) Keys() ([]string, error) {
+	value, err := n.store.catalog.SelectSetting("keys/" + n.name)
+	if err != nil || len(value) == 0 {
+		return nil, err
+	}
+
+	var keys []string
+	if err = json.Unmarshal([]byte(value), &keys); err != nil {
+		return nil, fmt.Errorf("invalid keys [%v] of namespace [%v]: %v", value, n.name, err)
+	}
+
+	return keys, nil
+}
+
+// AddKey adds key to namespace, JSON paths of composite key are joined with +. New index blocks
+// are keyed by it, and existing ones by Backfill.
+func (n *Namespace) AddKey(key string) error {
+	key = canonicalKey(key)
+	if len(key) == 0 {
+		return errors.New("empty key")
+	}
+
+	n.keying.Lock()
+	defer n.keying.Unlock()
+
+	keys, err := n.Keys()
+	if err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if k == key {
+			return nil
+		}
+	}
+
+	return n.setKeys(append(keys, key))
+}
+
+// DropKey removes key from namespace, along with key values of index blocks keyed by it at index
+// time or by Backfill
+func (n *Namespace) DropKey(key string) error {
+	key = canonicalKey(key)
+
+	n.keying.Lock()
+	defer n.keying.Unlock()
+
+	keys, err := n.Keys()
+	if err != nil {
+		return err
+	}
+
+	kept := make([]string, 0, len(keys))
+	for _, k := range keys {
+		if k != key {
+			kept = append(kept, k)
+		}
+	}
+	if len(kept) < len(keys) {
+		if err = n.setKeys(kept); err != nil {
+			return err
+		}
+	}
+
+	return n.store.catalog.RemoveKey(n.name, key)
+}
+
+// ListKeys returns keys added to namespace and keys given at index time, in order of key
+func (n *Namespace) ListKeys() ([]KeyInfo, error) {
+	declared, err := n.Keys()
+	if err != nil {
+		return nil, err
+	}
+
+	paths, err := n.store.catalog.SelectKeyPath(n.name)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := map[string]*KeyInfo{}
+	for _, key := range declared {
+		infos[key] = &KeyInfo{Key: key, Declared: true}
+	}
+	for _, key := range paths {
+		if _, ok := infos[key]; !ok {
+			infos[key] = &KeyInfo{Key: key}
+		}
+	}
+
+	list := make([]KeyInfo, 0, len(infos))
+	for key, info := range infos {
+		wid, err := n.store.catalog.SelectKeyWave(n.name, key)
+		if err != nil {
+			return nil, err
+		}
+		info.Blocks = len(wid)
+		list = append(list, *info)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Key < list[j].Key })
+
+	return list, nil
+}
+
+// Backfill keys existing index blocks of namespace by keys added to namespace, blocks already
+// keyed are skipped. It returns number of blocks keyed.
+func (n *Namespace) Backfill(ctx context.Context) (int, error) {
+	// keys are not dropped, and blocks are not replaced by compaction meanwhile
+	n.keying.Lock()
+	defer n.keying.Unlock()
+	n.compacting.Lock()
+	defer n.compacting.Unlock()
+
+	keys, err := n.Keys()
+	if err != nil || len(keys) == 0 {
+		return 0, err
+	}
+
+	c := n.store.catalog
+	waves, err := c.SelectWaveInfo(n.name)
+	if err != nil {
+		return 0, err
+	}
+
+	missing := map[int64][]string{}
+	for _, key := range keys {
+		keyed, err := c.SelectKeyWave(n.name, key)
+		if err != nil {
+			return 0, err
+		}
+		set := toWidSet(keyed)
+		for _, w := range waves {
+			if _, ok := set[w.ID]; !ok {
+				missing[w.ID] = append(missing[w.ID], key)
+			}
+		}
+	}
+
+	filled := 0
+	for _, w := range waves {
+		if len(missing[w.ID]) == 0 {
+			continue
+		}
+		if err = ctx.Err(); err != nil {
+			return filled, err
+		}
+
+		if err = n.backfill(w.ID, missing[w.ID]); err != nil {
+			// block is left for next backfill, e.g. it is purged meanwhile
+			log.Err(err).Msgf("backfilling keys %v of block %v in namespace [%v]", missing[w.ID], w.ID, n.name)
+			continue
+		}
+		filled++
+	}
+
+	return filled, nil
+}
+
+// backfill extracts values of keys from index block wid, and registers them in catalog
+func (n *Namespace) backfill(wid int64, keys []string) error {
+	b, err := n.store.cache.acquire(n.blockPath(wid))
+	if err != nil {
+		return err
+	}
+	defer n.store.cache.release(b)
+
+	ki := newKeyIndex(keys, 0)
+	attributes, expr := ki.tuples()
+	return ki.insert(n.store.catalog, wid, ssdexec.Exec(b.block, &ssql.Statement{Find: attributes, Where: expr}))
+}
+
+func (n *Namespace) setKeys(keys []string) error {
+	value, err := json.Marshal(keys)
+	if err != nil {
+		return err
+	}
+
+	return n.store.catalog.InsertSetting("keys/"+n.name, string(value))
+}
+
+// withKeys returns keys given at index time along with keys added to namespace, without duplicates
+func (n *Namespace) withKeys(keys []string) ([]string, error) {
+	declared, err := n.Keys()
+	if err != nil || len(declared) == 0 {
+		return keys, err
+	}
+
+	seen := map[string]bool{}
+	merged := make([]string, 0, len(keys)+len(declared))
+	for _, key := range append(append([]string{}, keys...), declared...) {
+		if k := canonicalKey(key); !seen[k] {
+			seen[k] = true
+			merged = append(merged, key)
+		}
+	}
+
+	return merged, nil
+}
+
+// canonicalKey joins canonical JSON paths of key with +
+func canonicalKey(key string) string {
+	return strings.Join(splitComposite(key), compositeSeparator)
+}
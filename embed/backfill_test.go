@@ -0,0 +1,155 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package embed
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+)
+
+func TestAddDropKey(t *testing.T) {
+	testStores(t, func(t *testing.T, s *Store) {
+		n := s.root
+		for _, key := range []string{"/a", `/"b" + /c`, "/a", `/"a"`} {
+			if err := n.AddKey(key); err != nil {
+				t.Fatalf("AddKey(%v) error = %v", key, err)
+			}
+		}
+		if err := n.AddKey(" "); err == nil {
+			t.Errorf("AddKey() of empty key error = nil")
+		}
+
+		keys, err := n.Keys()
+		if want := []string{"/a", "/b+/c"}; err != nil || !reflect.DeepEqual(keys, want) {
+			t.Fatalf("Keys() = %v, %v, want %v", keys, err, want)
+		}
+
+		for _, key := range []string{"/b+/c", "/d"} {
+			if err := n.DropKey(key); err != nil {
+				t.Fatalf("DropKey(%v) error = %v", key, err)
+			}
+		}
+		keys, err = n.Keys()
+		if want := []string{"/a"}; err != nil || !reflect.DeepEqual(keys, want) {
+			t.Errorf("Keys() = %v, %v, want %v", keys, err, want)
+		}
+	})
+}
+
+func TestBackfill(t *testing.T) {
+	testStores(t, func(t *testing.T, s *Store) {
+		n, c := s.root, s.catalog
+		buildTestBlock(t, n, `{"ts":100,"a":"x","b":1} {"ts":200,"a":"y","b":2}`)
+		buildTestBlock(t, n, `{"ts":300,"a":"z","b":1}`)
+
+		for _, key := range []string{"/a", "/a+/b"} {
+			if err := n.AddKey(key); err != nil {
+				t.Fatalf("AddKey() error = %v", err)
+			}
+		}
+		if filled, err := n.Backfill(context.Background()); err != nil || filled != 2 {
+			t.Fatalf("Backfill() = %v, %v, want 2", filled, err)
+		}
+
+		// blocks keyed already, at index time or by the last backfill, are skipped
+		buildTestBlock(t, n, `{"ts":400,"a":"x","b":2}`)
+		if filled, err := n.Backfill(context.Background()); err != nil || filled != 0 {
+			t.Fatalf("Backfill() again = %v, %v, want 0", filled, err)
+		}
+
+		tests := []struct {
+			path string
+			keys []string
+			want []int64
+		}{
+			{"/a", []string{"x"}, []int64{1, 3}},
+			{"/a", []string{"y", "z"}, []int64{1, 2}},
+			{"/a+/b", []string{"x\x1f1"}, []int64{1}},
+			{"/a+/b", []string{"x\x1f2", "z\x1f1"}, []int64{2, 3}},
+			{"/a+/b", []string{"y\x1f1"}, nil},
+		}
+		for _, tt := range tests {
+			got, err := c.SelectKeys(n.name, tt.path, tt.keys)
+			if err != nil {
+				t.Fatalf("SelectKeys() error = %v", err)
+			}
+			sort.Slice(got, func(i, j int) bool { return got[i] < got[j] })
+			if len(got) > 0 || len(tt.want) > 0 {
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("SelectKeys(%v, %q) = %v, want %v", tt.path, tt.keys, got, tt.want)
+				}
+			}
+		}
+
+		list, err := n.ListKeys()
+		want := []KeyInfo{{Key: "/a", Declared: true, Blocks: 3}, {Key: "/a+/b", Declared: true, Blocks: 3}}
+		if err != nil || !reflect.DeepEqual(list, want) {
+			t.Errorf("ListKeys() = %v, %v, want %v", list, err, want)
+		}
+
+		// dropped key leaves no value, nor declaration of blocks behind
+		if err = n.DropKey("/a"); err != nil {
+			t.Fatalf("DropKey() error = %v", err)
+		}
+		if wid, err := c.SelectKeys(n.name, "/a", []string{"x", "y", "z"}); err != nil || len(wid) > 0 {
+			t.Errorf("SelectKeys() of dropped key = %v, %v, want none", wid, err)
+		}
+		list, err = n.ListKeys()
+		if want = want[1:]; err != nil || !reflect.DeepEqual(list, want) {
+			t.Errorf("ListKeys() = %v, %v, want %v", list, err, want)
+		}
+	})
+}
+
+func TestBackfillDropKey(t *testing.T) {
+	testStores(t, func(t *testing.T, s *Store) {
+		n, c := s.root, s.catalog
+		for i := 0; i < 4; i++ {
+			buildTestBlock(t, n, `{"ts":100,"a":"x"}`)
+		}
+
+		// key dropped while backfilling is not keyed back by backfill
+		for i := 0; i < 20; i++ {
+			if err := n.AddKey("/a"); err != nil {
+				t.Fatalf("AddKey() error = %v", err)
+			}
+
+			var wg sync.WaitGroup
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if _, err := n.Backfill(context.Background()); err != nil {
+					t.Errorf("Backfill() error = %v", err)
+				}
+			}()
+			if err := n.DropKey("/a"); err != nil {
+				t.Fatalf("DropKey() error = %v", err)
+			}
+			wg.Wait()
+
+			if wid, err := c.SelectKeyWave(n.name, "/a"); err != nil || len(wid) > 0 {
+				t.Fatalf("SelectKeyWave() of dropped key = %v, %v, want none", wid, err)
+			}
+		}
+	})
+}
@@ -0,0 +1,239 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package embed
+
+import (
+	"container/list"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/chronowave/chronowave/ssd"
+	ssdidx "github.com/chronowave/chronowave/ssd/index"
+)
+
+const (
+	defaultCacheSize = 256 << 20
+)
+
+// CacheStats is usage of index block cache of Store
+type CacheStats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64
+	// Blocks and Bytes are number and file size of cached index blocks
+	Blocks int
+	Bytes  int64
+}
+
+// blockCache keeps decoded index blocks of memory mapped files in LRU order, bounded by size of
+// mapped files. block is unmapped once it is evicted and no query uses it.
+type blockCache struct {
+	lock     sync.Mutex
+	capacity int64
+	size     int64
+	// front is the most recently used
+	lru     *list.List
+	blocks  map[string]*list.Element
+	counter CacheStats
+}
+
+type cachedBlock struct {
+	path    string
+	size    int64
+	modTime time.Time
+	data    []byte
+	block   *ssd.IndexedBlock
+	refs    int
+	evicted bool
+}
+
+// newBlockCache bounds cache by bytes of index files, 0 is default and negative disables caching
+func newBlockCache(capacity int64) *blockCache {
+	if capacity == 0 {
+		capacity = defaultCacheSize
+	}
+
+	return &blockCache{capacity: capacity, lru: list.New(), blocks: map[string]*list.Element{}}
+}
+
+// acquire returns decoded index block of file, block is reloaded if file is replaced, caller must
+// release it after use
+func (c *blockCache) acquire(path string) (*cachedBlock, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		c.evict(path)
+		return nil, err
+	}
+
+	c.lock.Lock()
+	if e, ok := c.blocks[path]; ok {
+		b := e.Value.(*cachedBlock)
+		if b.size == info.Size() && b.modTime.Equal(info.ModTime()) {
+			c.counter.Hits++
+			b.refs++
+			c.lru.MoveToFront(e)
+			c.lock.Unlock()
+			return b, nil
+		}
+		c.remove(e)
+	}
+	c.counter.Misses++
+	c.lock.Unlock()
+
+	b, err := load(path)
+	if err != nil {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.capacity < 0 || b.size > c.capacity {
+		// not cached, unmapped on release
+		b.evicted = true
+		return b, nil
+	}
+
+	if e, ok := c.blocks[path]; ok {
+		// loaded by another query meanwhile
+		c.remove(e)
+	}
+	c.blocks[path] = c.lru.PushFront(b)
+	c.size += b.size
+	for c.size > c.capacity {
+		c.remove(c.lru.Back())
+		c.counter.Evictions++
+	}
+
+	return b, nil
+}
+
+// bloom returns Bloom filters of index file, from cached block if it is current, otherwise read from
+// file without mapping and decoding block
+func (c *blockCache) bloom(path string) (map[string]*ssd.Bloom, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	if e, ok := c.blocks[path]; ok {
+		b := e.Value.(*cachedBlock)
+		if b.size == info.Size() && b.modTime.Equal(info.ModTime()) {
+			c.lock.Unlock()
+			return b.block.Bloom, nil
+		}
+	}
+	c.lock.Unlock()
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ssdidx.ReadBloom(f)
+}
+
+// load maps index file and decodes it
+func load(path string) (b *cachedBlock, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := mmap(f, info.Size())
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			munmap(data)
+			b, err = nil, fmt.Errorf("decoding index file %v: %v", path, r)
+		}
+	}()
+
+	block, err := ssdidx.DecodeIndexBlock(data)
+	if err != nil {
+		munmap(data)
+		return nil, err
+	}
+
+	return &cachedBlock{path: path, size: info.Size(), modTime: info.ModTime(), data: data, block: block, refs: 1}, nil
+}
+
+func (c *blockCache) release(b *cachedBlock) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	b.refs--
+	if b.evicted && b.refs == 0 {
+		munmap(b.data)
+	}
+}
+
+// evict drops index block of file, e.g. file is removed by purge or compaction
+func (c *blockCache) evict(path string) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if e, ok := c.blocks[path]; ok {
+		c.remove(e)
+	}
+}
+
+func (c *blockCache) remove(e *list.Element) {
+	b := e.Value.(*cachedBlock)
+	c.lru.Remove(e)
+	delete(c.blocks, b.path)
+	c.size -= b.size
+	b.evicted = true
+	if b.refs == 0 {
+		munmap(b.data)
+	}
+}
+
+func (c *blockCache) close() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for c.lru.Len() > 0 {
+		c.remove(c.lru.Back())
+	}
+}
+
+func (c *blockCache) stats() CacheStats {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	stats := c.counter
+	stats.Blocks = c.lru.Len()
+	stats.Bytes = c.size
+	return stats
+}
@@ -0,0 +1,126 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package embed
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/chronowave/chronowave/ssd/codec"
+	ssdidx "github.com/chronowave/chronowave/ssd/index"
+)
+
+// writeTestFile writes index block of documents to path, replacing the existing file by rename.
+// it returns size of file.
+func writeTestFile(t *testing.T, path, docs string) int64 {
+	parsed, err := codec.ParseJson([]byte(docs))
+	if err != nil {
+		t.Fatalf("ParseJson() error = %v", err)
+	}
+	indexed, err := ssdidx.Build(parsed)
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	encoded, err := ssdidx.EncodeIndexBlock(indexed)
+	if err != nil {
+		t.Fatalf("EncodeIndexBlock() error = %v", err)
+	}
+
+	if err = ioutil.WriteFile(path+".tmp", encoded, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Rename(path+".tmp", path); err != nil {
+		t.Fatal(err)
+	}
+
+	return int64(len(encoded))
+}
+
+func TestCacheStats(t *testing.T) {
+	dir := t.TempDir()
+	a, b := filepath.Join(dir, "a"), filepath.Join(dir, "b")
+	sa := writeTestFile(t, a, `{"ts":1,"s":"a"}`)
+	sb := writeTestFile(t, b, `{"ts":2,"s":"b"}`)
+
+	// room for both blocks
+	c := newBlockCache(sa + sb)
+	defer c.close()
+
+	acquire := func(path string) {
+		cached, err := c.acquire(path)
+		if err != nil {
+			t.Fatalf("acquire() error = %v", err)
+		}
+		c.release(cached)
+	}
+
+	acquire(a)
+	acquire(a)
+	acquire(b)
+	if got, want := c.stats(), (CacheStats{Hits: 1, Misses: 2, Blocks: 2, Bytes: sa + sb}); got != want {
+		t.Errorf("stats() = %+v, want %+v", got, want)
+	}
+
+	// the least recently used a is evicted for c
+	sc := writeTestFile(t, filepath.Join(dir, "c"), `{"ts":3,"s":"c"}`)
+	acquire(filepath.Join(dir, "c"))
+	acquire(b)
+	if got, want := c.stats(), (CacheStats{Hits: 2, Misses: 3, Evictions: 1, Blocks: 2, Bytes: sb + sc}); got != want {
+		t.Errorf("stats() = %+v, want %+v", got, want)
+	}
+
+	acquire(a)
+	if got := c.stats(); got.Misses != 4 || got.Evictions != 2 {
+		t.Errorf("stats() = %+v, want 4 misses and 2 evictions", got)
+	}
+}
+
+func TestCacheReplacedFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "block")
+	writeTestFile(t, path, `{"ts":1,"s":"a"}`)
+
+	c := newBlockCache(0)
+	defer c.close()
+
+	b, err := c.acquire(path)
+	if err != nil {
+		t.Fatalf("acquire() error = %v", err)
+	}
+	if len(b.block.EntityID) != 1 {
+		t.Errorf("acquire() has %v documents, want 1", len(b.block.EntityID))
+	}
+	c.release(b)
+
+	// block of replaced file is loaded again
+	size := writeTestFile(t, path, `{"ts":2,"s":"b"} {"ts":3,"s":"c"}`)
+	b, err = c.acquire(path)
+	if err != nil {
+		t.Fatalf("acquire() error = %v", err)
+	}
+	defer c.release(b)
+	if len(b.block.EntityID) != 2 || b.size != size {
+		t.Errorf("acquire() has %v documents of %v bytes, want 2 of %v", len(b.block.EntityID), b.size, size)
+	}
+	if got := c.stats(); got.Hits != 0 || got.Misses != 2 || got.Blocks != 1 || got.Bytes != size {
+		t.Errorf("stats() = %+v, want 2 misses of a block of %v bytes", got, size)
+	}
+}
@@ -0,0 +1,59 @@
+//go:build linux || darwin || freebsd || netbsd || openbsd
+// +build linux darwin freebsd netbsd openbsd
+
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package embed
+
+import (
+	"path/filepath"
+	"testing"
+
+	ssdexec "github.com/chronowave/chronowave/ssd/exec"
+)
+
+func TestCacheEvictAcquired(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "block")
+	writeTestFile(t, path, `{"ts":1,"s":"a"}`)
+
+	c := newBlockCache(0)
+	defer c.close()
+
+	b, err := c.acquire(path)
+	if err != nil {
+		t.Fatalf("acquire() error = %v", err)
+	}
+
+	c.evict(path)
+	if got := c.stats(); got.Blocks != 0 || got.Bytes != 0 {
+		t.Errorf("stats() = %+v, want no block", got)
+	}
+
+	// block is still mapped for query having acquired it
+	if rs := ssdexec.Exec(b.block, restore); rs == nil || len(rs.Json) != 1 || string(rs.Json[0]) != `{"ts":1,"s":"a"}` {
+		t.Errorf("Exec() of evicted block = %v, want the document", rs)
+	}
+
+	// and unmapped once released, so it can't be unmapped again
+	c.release(b)
+	if err := munmap(b.data); err == nil {
+		t.Errorf("munmap() of released block succeeded, want it unmapped on release")
+	}
+}
@@ -0,0 +1,143 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package embed
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const (
+	SqliteCatalog  = "sqlite"
+	JournalCatalog = "journal"
+)
+
+var (
+	// CatalogType is catalog of new data directory, one of sqlite and journal. empty CatalogType uses
+	// the one already in data directory, or sqlite when built with cgo and journal otherwise.
+	CatalogType string
+)
+
+// Wave is an index block registered in catalog, also known as wid by its ID
+type Wave struct {
+	ID        int64
+	Namespace string
+	// Beg and End are time range of index block
+	Beg, End int64
+	// Size of index block file in bytes
+	Size int64
+}
+
+// Catalog keeps track of index blocks of data directory by namespace, time range and keys.
+type Catalog interface {
+	// InsertWave registers index block
+	InsertWave(w Wave) error
+	// InsertWaveLoc registers keys of JSON path in index block wid
+	InsertWaveLoc(path string, keys []string, wid int64) error
+	// InsertWaveKey records JSON paths declared as keys of index block wid, composite key joins
+	// its paths with +
+	InsertWaveKey(paths []string, wid int64) error
+	// InsertWaveLabel tags index block wid with labels in form of key=value
+	InsertWaveLabel(labels []string, wid int64) error
+	// InsertWaveStats records stats of index block wid, a JSON summary of its paths and values
+	InsertWaveStats(stats string, wid int64) error
+	// InsertSetting persists setting of data directory
+	InsertSetting(name, value string) error
+
+	// SelectWave returns index blocks of namespace overlapping with time range [beg, end]
+	SelectWave(ns string, beg, end int64) ([]int64, error)
+	// SelectWaveInfo returns index blocks of namespace ordered by id
+	SelectWaveInfo(ns string) ([]Wave, error)
+	// SelectKeys returns index blocks of namespace having any of keys of JSON path
+	SelectKeys(ns, path string, keys []string) ([]int64, error)
+	// SelectKeyPath returns JSON paths declared as keys in namespace
+	SelectKeyPath(ns string) ([]string, error)
+	// SelectKeyWave returns index blocks of namespace declaring JSON path as key
+	SelectKeyWave(ns, path string) ([]int64, error)
+	// RemoveKey drops keys and declaration of JSON path as key from index blocks of namespace
+	RemoveKey(ns, path string) error
+	// SelectLabel returns index blocks of namespace tagged with label key=value
+	SelectLabel(ns, label string) ([]int64, error)
+	// SelectLabels returns labels of index blocks in namespace
+	SelectLabels(ns string) ([]string, error)
+	// SelectWaveStats returns stats of those index blocks wid having stats
+	SelectWaveStats(wid []int64) (map[int64]string, error)
+	// SelectDanglingWid returns index block ids having keys, labels or stats without registered block
+	SelectDanglingWid() ([]int64, error)
+	// SelectSetting returns setting of data directory, empty if it is not set
+	SelectSetting(name string) (string, error)
+	// SelectWidBeforeTime returns index blocks of namespace registered before time
+	SelectWidBeforeTime(ns string, time time.Time) ([]int64, error)
+	// SelectUsage returns total size of index blocks of namespace
+	SelectUsage(ns string) (int64, error)
+	// SelectNamespace returns namespaces having index blocks
+	SelectNamespace() ([]string, error)
+	// MaxWid returns the largest index block id, 0 if there is none
+	MaxWid() (int64, error)
+
+	// ReplaceWave registers index block w and removes index blocks wid at once, keys and labels of w
+	// are inserted beforehand. w takes registered time of the newest of wid, it fails if any of wid
+	// no longer exists.
+	ReplaceWave(w Wave, wid []int64) error
+
+	// RemoveWave removes index blocks wid with their keys, labels and stats
+	RemoveWave(wid []int64) error
+
+	// PurgeBeforeTime removes index blocks of namespace registered before time
+	PurgeBeforeTime(ctx context.Context, ns string, time time.Time) error
+
+	Close() error
+}
+
+// OpenCatalog opens catalog of data directory
+func OpenCatalog(dir, kind string) (Catalog, error) {
+	existing := detectCatalog(dir)
+	switch {
+	case len(kind) == 0 && len(existing) > 0:
+		kind = existing
+	case len(kind) == 0:
+		kind = defaultCatalog
+	case len(existing) > 0 && existing != kind:
+		return nil, errors.New("catalog [" + kind + "] conflicts with [" + existing + "] of data directory " + dir)
+	}
+
+	switch kind {
+	case SqliteCatalog:
+		return openSqliteCatalog(dir)
+	case JournalCatalog:
+		return openJournalCatalog(dir)
+	}
+
+	return nil, errors.New("invalid catalog [" + kind + "], expect one of sqlite, journal")
+}
+
+// detectCatalog returns catalog already in data directory, empty if there is none
+func detectCatalog(dir string) string {
+	if _, err := os.Stat(filepath.Join(dir, sqliteFile)); err == nil {
+		return SqliteCatalog
+	}
+	if _, err := os.Stat(filepath.Join(dir, journalFile)); err == nil {
+		return JournalCatalog
+	}
+	return ""
+}
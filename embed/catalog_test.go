@@ -0,0 +1,229 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package embed
+
+import (
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+)
+
+// testCatalogs runs test against journal catalog, and sqlite catalog if it is built
+func testCatalogs(t *testing.T, test func(t *testing.T, c Catalog)) {
+	for _, kind := range []string{JournalCatalog, SqliteCatalog} {
+		t.Run(kind, func(t *testing.T) {
+			c, err := OpenCatalog(t.TempDir(), kind)
+			if err != nil {
+				t.Skipf("OpenCatalog() error = %v", err)
+			}
+			defer c.Close()
+
+			test(t, c)
+		})
+	}
+}
+
+func TestSelectWave(t *testing.T) {
+	testCatalogs(t, func(t *testing.T, c Catalog) {
+		for _, w := range []Wave{
+			{ID: 1, Namespace: "a", Beg: 100, End: 200},
+			{ID: 2, Namespace: "a", Beg: 150, End: 300},
+			{ID: 3, Namespace: "a", Beg: 400, End: 500},
+			{ID: 4, Namespace: "b", Beg: 100, End: 500},
+		} {
+			if err := c.InsertWave(w); err != nil {
+				t.Fatalf("InsertWave() error = %v", err)
+			}
+		}
+
+		tests := []struct {
+			name     string
+			beg, end int64
+			want     []int64
+		}{
+			{"all", 0, 1000, []int64{1, 2, 3}},
+			{"begin overlaps", 180, 220, []int64{1, 2}},
+			{"end overlaps", 450, 600, []int64{3}},
+			{"inside a block", 420, 430, []int64{3}},
+			{"inside blocks", 160, 170, []int64{1, 2}},
+			{"bounds", 300, 400, []int64{2, 3}},
+			{"none", 310, 390, nil},
+		}
+		for _, tt := range tests {
+			got, err := c.SelectWave("a", tt.beg, tt.end)
+			if err != nil {
+				t.Fatalf("SelectWave() error = %v", err)
+			}
+			if len(got) > 0 || len(tt.want) > 0 {
+				if !reflect.DeepEqual(got, tt.want) {
+					t.Errorf("%v: SelectWave() = %v, want %v", tt.name, got, tt.want)
+				}
+			}
+		}
+	})
+}
+
+func TestInsertReplaceWaveConcurrently(t *testing.T) {
+	testCatalogs(t, func(t *testing.T, c Catalog) {
+		var (
+			wg     sync.WaitGroup
+			lock   sync.Mutex
+			failed int
+		)
+		run := func(f func() error) {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				if err := f(); err != nil {
+					lock.Lock()
+					failed++
+					lock.Unlock()
+				}
+			}()
+		}
+
+		// only one of the same wave is inserted, so is the replacement
+		for i := 0; i < 8; i++ {
+			run(func() error { return c.InsertWave(Wave{ID: 1, Namespace: "a", Beg: 100, End: 200}) })
+		}
+		wg.Wait()
+		for i := 0; i < 8; i++ {
+			run(func() error { return c.ReplaceWave(Wave{ID: 2, Namespace: "a", Beg: 100, End: 200}, []int64{1}) })
+		}
+		wg.Wait()
+		if failed != 14 {
+			t.Errorf("InsertWave() and ReplaceWave() failed %v times, want 14", failed)
+		}
+	})
+}
+
+// catalogState returns what catalog selects of namespace a, index blocks in ascending order
+func catalogState(t *testing.T, c Catalog) map[string]interface{} {
+	state := map[string]interface{}{}
+	add := func(name string, v interface{}, err error) {
+		if err != nil {
+			t.Fatalf("%v error = %v", name, err)
+		}
+		if wid, ok := v.([]int64); ok {
+			sort.Slice(wid, func(i, j int) bool { return wid[i] < wid[j] })
+		}
+		state[name] = v
+	}
+
+	waves, err := c.SelectWaveInfo("a")
+	add("SelectWaveInfo", waves, err)
+	wid, err := c.SelectKeys("a", "/k", []string{"x", "y"})
+	add("SelectKeys", wid, err)
+	paths, err := c.SelectKeyPath("a")
+	add("SelectKeyPath", paths, err)
+	wid, err = c.SelectKeyWave("a", "/k")
+	add("SelectKeyWave", wid, err)
+	wid, err = c.SelectLabel("a", "env=prod")
+	add("SelectLabel", wid, err)
+	labels, err := c.SelectLabels("a")
+	add("SelectLabels", labels, err)
+	stats, err := c.SelectWaveStats([]int64{1, 2, 3, 4})
+	add("SelectWaveStats", stats, err)
+	wid, err = c.SelectDanglingWid()
+	add("SelectDanglingWid", wid, err)
+	setting, err := c.SelectSetting("unit")
+	add("SelectSetting", setting, err)
+	usage, err := c.SelectUsage("a")
+	add("SelectUsage", usage, err)
+	ns, err := c.SelectNamespace()
+	add("SelectNamespace", ns, err)
+	max, err := c.MaxWid()
+	add("MaxWid", max, err)
+
+	return state
+}
+
+func TestCatalogReopen(t *testing.T) {
+	for _, kind := range []string{JournalCatalog, SqliteCatalog} {
+		t.Run(kind, func(t *testing.T) {
+			dir := t.TempDir()
+			c, err := OpenCatalog(dir, kind)
+			if err != nil {
+				t.Skipf("OpenCatalog() error = %v", err)
+			}
+
+			for _, f := range []func() error{
+				func() error { return c.InsertWave(Wave{ID: 1, Namespace: "a", Beg: 100, End: 200, Size: 10}) },
+				func() error { return c.InsertWave(Wave{ID: 2, Namespace: "a", Beg: 200, End: 300, Size: 20}) },
+				func() error { return c.InsertWave(Wave{ID: 3, Namespace: "b", Beg: 100, End: 300, Size: 30}) },
+				func() error { return c.InsertWaveLoc("/k", []string{"x"}, 1) },
+				func() error { return c.InsertWaveLoc("/k", []string{"y"}, 2) },
+				func() error { return c.InsertWaveKey([]string{"/k"}, 1) },
+				func() error { return c.InsertWaveKey([]string{"/k"}, 2) },
+				func() error { return c.InsertWaveLabel([]string{"env=prod"}, 1) },
+				func() error { return c.InsertWaveLabel([]string{"env=test"}, 3) },
+				func() error { return c.InsertWaveStats("{}", 2) },
+				func() error { return c.InsertSetting("unit", "ms") },
+				// keys of replacement are inserted beforehand
+				func() error { return c.InsertWaveLoc("/k", []string{"y"}, 4) },
+				func() error { return c.InsertWaveKey([]string{"/k"}, 4) },
+				func() error { return c.InsertWaveStats(`{"a":1}`, 4) },
+				func() error {
+					return c.ReplaceWave(Wave{ID: 4, Namespace: "a", Beg: 200, End: 300, Size: 40}, []int64{2})
+				},
+				func() error { return c.RemoveWave([]int64{3}) },
+				func() error { return c.InsertWaveLabel([]string{"env=dev"}, 9) },
+			} {
+				if err = f(); err != nil {
+					t.Fatalf("catalog error = %v", err)
+				}
+			}
+
+			want := map[string]interface{}{
+				"SelectWaveInfo": []Wave{
+					{ID: 1, Namespace: "a", Beg: 100, End: 200, Size: 10},
+					{ID: 4, Namespace: "a", Beg: 200, End: 300, Size: 40},
+				},
+				"SelectKeys":        []int64{1, 4},
+				"SelectKeyPath":     []string{"/k"},
+				"SelectKeyWave":     []int64{1, 4},
+				"SelectLabel":       []int64{1},
+				"SelectLabels":      []string{"env=prod"},
+				"SelectWaveStats":   map[int64]string{4: `{"a":1}`},
+				"SelectDanglingWid": []int64{9},
+				"SelectSetting":     "ms",
+				"SelectUsage":       int64(50),
+				"SelectNamespace":   []string{"a"},
+				"MaxWid":            int64(4),
+			}
+			if got := catalogState(t, c); !reflect.DeepEqual(got, want) {
+				t.Errorf("catalog = %v, want %v", got, want)
+			}
+
+			if err = c.Close(); err != nil {
+				t.Fatalf("Close() error = %v", err)
+			}
+			if c, err = OpenCatalog(dir, ""); err != nil {
+				t.Fatalf("OpenCatalog() again error = %v", err)
+			}
+			defer c.Close()
+
+			if got := catalogState(t, c); !reflect.DeepEqual(got, want) {
+				t.Errorf("catalog reopened = %v, want %v", got, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,299 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package embed
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"github.com/chronowave/chronowave/ssd"
+	"github.com/chronowave/chronowave/ssd/codec"
+	ssdexec "github.com/chronowave/chronowave/ssd/exec"
+	ssdidx "github.com/chronowave/chronowave/ssd/index"
+	"github.com/chronowave/chronowave/ssql"
+
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	// CompactSize is size of index block file in bytes, smaller blocks are merged by compaction
+	CompactSize int64 = 4 << 20
+	// CompactTarget is max total size of index block files merged by compaction
+	CompactTarget int64 = 64 << 20
+
+	// restores documents of index block
+	restore = &ssql.Statement{
+		Find:  []*ssql.Attribute{{Name: "doc"}},
+		Where: []*ssql.Expr{{Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{Name: "doc", Path: "/"}}}},
+	}
+)
+
+// Compact merges small index blocks of every namespace in the default store
+func Compact(ctx context.Context) error {
+	return std.Compact(ctx)
+}
+
+// Compact merges small index blocks of every namespace
+func (s *Store) Compact(ctx context.Context) error {
+	names, err := s.Namespaces()
+	if err != nil {
+		return err
+	}
+
+	for _, name := range append([]string{""}, names...) {
+		n, err := s.Namespace(name)
+		if err != nil {
+			return err
+		}
+		if _, err = n.Compact(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Compact merges adjacent index blocks smaller than CompactSize, of the same labels and keys, into
+// blocks up to CompactTarget and ssd.MaxDoc documents. It returns number of merged blocks.
+func (n *Namespace) Compact(ctx context.Context) (int, error) {
+	// index blocks are not written while data directory is repaired
+	n.store.writing.RLock()
+	defer n.store.writing.RUnlock()
+	n.compacting.Lock()
+	defer n.compacting.Unlock()
+
+	groups, err := n.compactGroups()
+	if err != nil {
+		return 0, err
+	}
+
+	merged := 0
+	for _, g := range groups {
+		if err = ctx.Err(); err != nil {
+			return merged, err
+		}
+
+		cnt, err := n.merge(g)
+		if err != nil {
+			// blocks are left as they are, e.g. one of them is purged meanwhile
+			log.Err(err).Msgf("compacting %v blocks of namespace [%v]", len(g.waves), n.name)
+			continue
+		}
+		merged += cnt
+	}
+
+	return merged, nil
+}
+
+// compactGroup is adjacent index blocks to be merged into one
+type compactGroup struct {
+	waves  []Wave
+	keys   []string
+	labels []string
+}
+
+// compactGroups groups small index blocks by their labels and keys, and then by id
+func (n *Namespace) compactGroups() ([]*compactGroup, error) {
+	c := n.store.catalog
+	waves, err := c.SelectWaveInfo(n.name)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := map[int64][]string{}
+	paths, err := c.SelectKeyPath(n.name)
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		wid, err := c.SelectKeyWave(n.name, path)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range wid {
+			keys[id] = append(keys[id], path)
+		}
+	}
+
+	labels := map[int64][]string{}
+	pairs, err := c.SelectLabels(n.name)
+	if err != nil {
+		return nil, err
+	}
+	for _, pair := range pairs {
+		wid, err := c.SelectLabel(n.name, pair)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range wid {
+			labels[id] = append(labels[id], pair)
+		}
+	}
+
+	var (
+		groups []*compactGroup
+		open   = map[string]*compactGroup{}
+		size   = map[string]int64{}
+	)
+	for _, w := range waves {
+		if w.Size >= CompactSize {
+			continue
+		}
+
+		sort.Strings(keys[w.ID])
+		sort.Strings(labels[w.ID])
+		sig := strings.Join(labels[w.ID], ",") + "\n" + strings.Join(keys[w.ID], ",")
+
+		g, ok := open[sig]
+		if !ok || size[sig]+w.Size > CompactTarget {
+			g = &compactGroup{keys: keys[w.ID], labels: labels[w.ID]}
+			groups = append(groups, g)
+			open[sig] = g
+			size[sig] = 0
+		}
+		g.waves = append(g.waves, w)
+		size[sig] += w.Size
+	}
+
+	j := 0
+	for _, g := range groups {
+		if len(g.waves) > 1 {
+			groups[j] = g
+			j++
+		}
+	}
+
+	return groups[:j], nil
+}
+
+// merge rebuilds documents of group into one index block, and swaps it for the group in catalog.
+// blocks beyond ssd.MaxDoc documents are left for next compaction. It returns number of merged blocks.
+func (n *Namespace) merge(g *compactGroup) (int, error) {
+	var (
+		docs  int
+		size  int64
+		beg   = int64(math.MaxInt64)
+		end   = int64(math.MinInt64)
+		wid   []int64
+		files []string
+		w     bytes.Buffer
+	)
+
+	for _, wave := range g.waves {
+		f := n.blockPath(wave.ID)
+		data, err := ioutil.ReadFile(f)
+		if err != nil {
+			return 0, err
+		}
+
+		idx, err := ssdidx.DecodeIndexBlock(data)
+		if err != nil {
+			return 0, err
+		}
+
+		if docs+len(idx.EntityID) > ssd.MaxDoc {
+			break
+		}
+
+		rs := ssdexec.Exec(idx, restore)
+		if rs == nil || len(rs.RowId) != len(idx.EntityID) {
+			return 0, errors.New("unable to restore documents of " + f)
+		}
+		for i := range rs.RowId {
+			w.Write(rs.Json[rs.Column[0].Value[i]])
+			w.WriteByte('\n')
+		}
+
+		docs += len(idx.EntityID)
+		size += wave.Size
+		if wave.Beg < beg {
+			beg = wave.Beg
+		}
+		if wave.End > end {
+			end = wave.End
+		}
+		wid = append(wid, wave.ID)
+		files = append(files, f)
+	}
+
+	if len(wid) < 2 {
+		return 0, nil
+	}
+
+	parsed, err := codec.ParseJson(w.Bytes())
+	if err != nil {
+		return 0, err
+	}
+
+	indexed, err := ssdidx.Build(parsed)
+	if err != nil {
+		return 0, err
+	}
+
+	encoded, err := ssdidx.EncodeIndexBlock(indexed)
+	if err != nil {
+		return 0, err
+	}
+
+	nid, err := n.writeBlock(atomic.AddInt64(&n.store.seq, 1), encoded)
+	if err != nil {
+		return 0, err
+	}
+
+	c := n.store.catalog
+	err = c.InsertWaveLabel(g.labels, nid)
+	if err == nil {
+		err = insertStats(c, nid, indexed)
+	}
+	if err == nil && len(g.keys) > 0 {
+		ki := newKeyIndex(g.keys, 0)
+		attributes, expr := ki.tuples()
+		err = ki.insert(c, nid, ssdexec.Exec(indexed, &ssql.Statement{Find: attributes, Where: expr}))
+	}
+	if err == nil {
+		err = c.ReplaceWave(Wave{ID: nid, Namespace: n.name, Beg: beg, End: end, Size: int64(len(encoded))}, wid)
+	}
+	if err != nil {
+		// keys, labels and stats of new block are not left dangling
+		if rerr := c.RemoveWave([]int64{nid}); rerr != nil {
+			log.Err(rerr).Msgf("removing index block %v of failed compaction", nid)
+		}
+		os.Remove(n.blockPath(nid))
+		return 0, err
+	}
+
+	atomic.AddInt64(&n.usage, int64(len(encoded))-size)
+
+	for _, f := range files {
+		n.store.cache.evict(f)
+		if err = os.Remove(f); err != nil && !os.IsNotExist(err) {
+			log.Info().Msgf("removing compacted %v has err: %v", f, err)
+		}
+	}
+
+	return len(wid), nil
+}
@@ -0,0 +1,119 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package embed
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCompact(t *testing.T) {
+	docs := []string{
+		`{"ts":1,"b":true,"n":null,"o":{},"a":[],"s":"x"}`,
+		`{"ts":2,"f":1.5,"i":-3,"nested":{"a":[1,{"b":false}],"o":{"n":null}}}`,
+		`{"ts":3,"a":[[],{}],"b":false,"s":""}`,
+		`{"ts":4,"o":{"a":[],"b":{}},"e":[null,true]}`,
+	}
+
+	testStores(t, func(t *testing.T, s *Store) {
+		for _, doc := range docs {
+			buildTestBlock(t, s.root, doc)
+		}
+
+		export := func() []byte {
+			var w bytes.Buffer
+			if _, err := s.Export(context.Background(), &w, ExportOptions{Timestamp: "/ts"}); err != nil {
+				t.Fatalf("Export() error = %v", err)
+			}
+			return w.Bytes()
+		}
+
+		before := export()
+		want := []byte(docs[0] + "\n" + docs[1] + "\n" + docs[2] + "\n" + docs[3] + "\n")
+		if !bytes.Equal(before, want) {
+			t.Errorf("Export() before compaction = %s, want %s", before, want)
+		}
+
+		merged, err := s.root.Compact(context.Background())
+		if err != nil || merged != len(docs) {
+			t.Fatalf("Compact() = %v, %v, want %v", merged, err, len(docs))
+		}
+		if waves, _ := s.catalog.SelectWaveInfo(""); len(waves) != 1 {
+			t.Errorf("SelectWaveInfo() = %v, want one block", waves)
+		}
+
+		if after := export(); !bytes.Equal(after, before) {
+			t.Errorf("Export() after compaction = %s, want %s", after, before)
+		}
+	})
+}
+
+// replaceFailure is catalog failing to replace index blocks
+type replaceFailure struct {
+	Catalog
+}
+
+func (c replaceFailure) ReplaceWave(Wave, []int64) error {
+	return errors.New("replace wave")
+}
+
+func TestCompactFailure(t *testing.T) {
+	testStores(t, func(t *testing.T, s *Store) {
+		if err := s.root.AddKey("/k"); err != nil {
+			t.Fatalf("AddKey() error = %v", err)
+		}
+		buildTestBlock(t, s.root, `{"ts":1,"k":1,"v":1}`)
+		buildTestBlock(t, s.root, `{"ts":2,"k":2,"v":2}`)
+		waves, err := s.catalog.SelectWaveInfo(s.root.name)
+		if err != nil || len(waves) != 2 {
+			t.Fatalf("SelectWaveInfo() = %v, %v, want two blocks", waves, err)
+		}
+
+		c := s.catalog
+		s.catalog = replaceFailure{c}
+		merged, err := s.root.Compact(context.Background())
+		s.catalog = c
+		if err != nil || merged != 0 {
+			t.Fatalf("Compact() = %v, %v, want none merged", merged, err)
+		}
+
+		// merged block is removed along with its keys, labels and stats
+		if dangling, err := c.SelectDanglingWid(); err != nil || len(dangling) > 0 {
+			t.Errorf("SelectDanglingWid() = %v, %v, want none", dangling, err)
+		}
+		if after, err := c.SelectWaveInfo(s.root.name); err != nil || len(after) != len(waves) {
+			t.Errorf("SelectWaveInfo() = %v, %v, want %v", after, err, waves)
+		}
+		blocks := 0
+		filepath.Walk(filepath.Join(s.root.dir, index), func(_ string, info os.FileInfo, err error) error {
+			if err == nil && !info.IsDir() {
+				blocks++
+			}
+			return err
+		})
+		if blocks != len(waves) {
+			t.Errorf("index block files = %v, want %v", blocks, len(waves))
+		}
+	})
+}
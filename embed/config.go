@@ -20,29 +20,54 @@
 package embed
 
 import (
-	"os"
-	"path/filepath"
+	"github.com/chronowave/chronowave/ssql/parser"
 )
 
 var (
+	// Directory is data directory of the default store opened by Verify
 	Directory string
+
+	// std is the default store used by package level functions
+	std *Store
 )
 
 var (
-	wal       = "wal"
-	index     = "index"
-	donothing = func() {}
+	wal        = "wal"
+	index      = "index"
+	deadletter = "deadletter"
+	spill      = "spill"
+	lockFile   = "lock"
+	donothing  = func() {}
 )
 
+// Verify opens the default store of Directory with TimeUnit and CatalogType
 func Verify() (func(), error) {
-	err := os.MkdirAll(filepath.Join(Directory, wal), os.ModePerm)
-	if err != nil {
-		return donothing, err
-	}
-	err = os.MkdirAll(filepath.Join(Directory, index), os.ModePerm)
+	return VerifyCatalog(nil)
+}
+
+// VerifyCatalog opens the default store of Directory with the given catalog, nil catalog opens
+// the one of CatalogType in data directory. the returned func closes the store.
+func VerifyCatalog(c Catalog) (func(), error) {
+	s, err := OpenStore(Directory, Options{TimeUnit: TimeUnit, CatalogType: CatalogType, Catalog: c})
 	if err != nil {
 		return donothing, err
 	}
 
-	return func() { closeSqlite() }, openSqlite(Directory)
+	setDefault(s)
+	return func() { s.Close() }, nil
+}
+
+// setDefault makes s the default store used by package level functions
+func setDefault(s *Store) {
+	// time expressions in TIMEFRAME resolve to the unit of data directory
+	parser.EpochTime = s.fromTime
+	TimeUnit = s.unit
+	Directory = s.dir
+
+	std = s
+}
+
+// Default returns the default store opened by Verify
+func Default() *Store {
+	return std
 }
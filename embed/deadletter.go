@@ -0,0 +1,221 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package embed
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/chronowave/chronowave/ssd"
+
+	"github.com/buger/jsonparser"
+	"github.com/rs/zerolog/log"
+)
+
+var (
+	dlseq uint64
+
+	// ErrDeadLetter is returned when every document is moved to dead letter directory
+	ErrDeadLetter = errors.New("no valid document")
+)
+
+// rejection is document failed validation and the reason
+type rejection struct {
+	doc    []byte
+	reason string
+}
+
+// validate checks every document in data against timestamp path and normalizes timestamp to
+// canonical int64, documents failed validation and items of top level array other than objects
+// are moved to dead letter directory. It returns remaining valid documents as JSON array, nil if
+// there is none, and number of rejected and all documents.
+func (n *Namespace) validate(data []byte, timestamp string) ([]byte, int, int) {
+	valid, rejected, total := n.normalize(data, timestamp)
+	for _, r := range rejected {
+		if err := n.deadLetter(r.doc, r.reason); err != nil {
+			log.Err(err).Msg("writing dead letter")
+		}
+	}
+
+	if len(rejected) > 0 {
+		log.Warn().Msgf("moved %d of %d documents to %v", len(rejected), total, filepath.Join(n.dir, deadletter))
+	}
+
+	return valid, len(rejected), total
+}
+
+// normalize normalizes timestamp of every document in data, and returns valid documents as JSON
+// array, nil if there is none, along with rejected documents and number of all documents
+func (n *Namespace) normalize(data []byte, timestamp string) ([]byte, []rejection, int) {
+	docs, others := splitDocuments(data)
+	path := splitPath(timestamp)
+
+	rejected := make([]rejection, 0, len(others))
+	for _, doc := range others {
+		rejected = append(rejected, rejection{doc, "not a JSON object"})
+	}
+
+	valid := bytes.NewBuffer(make([]byte, 0, len(data)+len(docs)+2))
+	valid.WriteByte('[')
+	for _, doc := range docs {
+		normalized, reason := n.validateTimestamp(doc, timestamp, path)
+		if len(reason) > 0 {
+			rejected = append(rejected, rejection{doc, reason})
+			continue
+		}
+		if valid.Len() > 1 {
+			valid.WriteByte(',')
+		}
+		valid.Write(normalized)
+	}
+
+	total := len(docs) + len(others)
+	if len(rejected) == total {
+		return nil, rejected, total
+	}
+
+	valid.WriteByte(']')
+	return valid.Bytes(), rejected, total
+}
+
+// validateTimestamp returns document with canonical int64 timestamp, or reason if document
+// doesn't have valid timestamp. timestamp of other value is kept in originalTimestamp of document,
+// so that document is restored as it is ingested.
+func (n *Namespace) validateTimestamp(doc []byte, timestamp string, path []string) ([]byte, string) {
+	value, vt, _, err := jsonparser.Get(doc, path...)
+	if err != nil {
+		if err == jsonparser.KeyPathNotFoundError {
+			return nil, "missing timestamp path [" + timestamp + "]"
+		}
+		return nil, "malformed document: " + err.Error()
+	}
+
+	ts, err := n.store.normalizeTimestamp(value, vt)
+	if err != nil {
+		return nil, fmt.Sprintf("invalid timestamp value %v at path [%v]: %v", string(value), timestamp, err)
+	}
+
+	canonical := strconv.FormatInt(ts, 10)
+	if vt == jsonparser.Number && canonical == string(value) {
+		return doc, ""
+	}
+
+	normalized, err := jsonparser.Set(doc, []byte(canonical), path...)
+	if err == nil {
+		normalized, err = jsonparser.Set(normalized, keepTimestamp(timestamp, value, vt), originalTimestamp)
+	}
+	if err != nil {
+		return nil, "malformed document: " + err.Error()
+	}
+
+	return normalized, ""
+}
+
+// deadLetter writes rejected document and its reason side by side in dead letter directory,
+// {name}.json can be replayed by index command once fixed.
+func (n *Namespace) deadLetter(doc []byte, reason string) error {
+	name := fmt.Sprintf("%016X-%06d", time.Now().UnixNano(), atomic.AddUint64(&dlseq, 1))
+	path := filepath.Join(n.dir, deadletter)
+	if err := os.MkdirAll(path, os.ModePerm); err != nil {
+		return err
+	}
+
+	if err := ioutil.WriteFile(filepath.Join(path, name+".json"), doc, os.ModePerm); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filepath.Join(path, name+".reason"), []byte(reason+"\n"), os.ModePerm)
+}
+
+// splitPath splits JSON path, ie /a/"b/c", into keys
+func splitPath(path string) []string {
+	var keys []string
+	for _, k := range ssd.SplitPath(path) {
+		keys = append(keys, string(k))
+	}
+	return keys
+}
+
+// splitDocuments splits data into top level JSON objects, objects in top level array are
+// treated as individual documents, and the other items of the array are returned as others.
+func splitDocuments(data []byte) (docs [][]byte, others [][]byte) {
+	for i := 0; i < len(data); i++ {
+		switch data[i] {
+		case '{':
+			end := endOfValue(data, i)
+			docs = append(docs, data[i:end])
+			i = end - 1
+		case '[':
+			end := endOfValue(data, i)
+			jsonparser.ArrayEach(data[i:end], func(value []byte, vt jsonparser.ValueType, _ int, _ error) {
+				switch vt {
+				case jsonparser.Object:
+					docs = append(docs, value)
+				case jsonparser.String:
+					// value of string is without quotes
+					others = append(others, append(append([]byte{'"'}, value...), '"'))
+				default:
+					others = append(others, value)
+				}
+			})
+			i = end - 1
+		}
+	}
+
+	return docs, others
+}
+
+// endOfValue returns offset after matching } or ] of the object or array starts at beg
+func endOfValue(data []byte, beg int) int {
+	depth, quoted := 0, false
+	for i := beg; i < len(data); i++ {
+		b := data[i]
+		if quoted {
+			switch b {
+			case '\\':
+				i++
+			case '"':
+				quoted = false
+			}
+			continue
+		}
+
+		switch b {
+		case '"':
+			quoted = true
+		case '{', '[':
+			depth++
+		case '}', ']':
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+
+	return len(data)
+}
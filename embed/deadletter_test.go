@@ -0,0 +1,106 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package embed
+
+import (
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestSplitDocuments(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   string
+		docs   []string
+		others []string
+	}{
+		{"objects", `{"a":1} {"b":"}"}`, []string{`{"a":1}`, `{"b":"}"}`}, nil},
+		{"array", `[{"a":1}, "x", 2, null, true, [1], {"b":[]}]`, []string{`{"a":1}`, `{"b":[]}`},
+			[]string{`"x"`, `2`, `null`, `true`, `[1]`}},
+		{"mixed", `{"a":1} ["y"] {"c":{}}`, []string{`{"a":1}`, `{"c":{}}`}, []string{`"y"`}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			docs, others := splitDocuments([]byte(tt.data))
+			if got := toStrings(docs); !reflect.DeepEqual(got, tt.docs) {
+				t.Errorf("splitDocuments() docs = %v, want %v", got, tt.docs)
+			}
+			if got := toStrings(others); !reflect.DeepEqual(got, tt.others) {
+				t.Errorf("splitDocuments() others = %v, want %v", got, tt.others)
+			}
+		})
+	}
+}
+
+func TestDeadLetter(t *testing.T) {
+	s := openTestStore(t, JournalCatalog)
+
+	buildTestBlock(t, s.root, `[{"ts":1,"v":1}, "x", 2, [3], {"v":4}]`)
+	if waves, _ := s.catalog.SelectWaveInfo(""); len(waves) != 1 {
+		t.Errorf("SelectWaveInfo() = %v, want one block", waves)
+	}
+
+	// every document is rejected
+	f := filepath.Join(t.TempDir(), "docs.json")
+	if err := ioutil.WriteFile(f, []byte(`[null, {"v":5}]`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.root.Build(f, "/ts", nil); !errors.Is(err, ErrDeadLetter) {
+		t.Errorf("Build() error = %v, want %v", err, ErrDeadLetter)
+	}
+
+	dir := filepath.Join(s.root.dir, deadletter)
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, f := range files {
+		doc, _ := ioutil.ReadFile(f)
+		reason, _ := ioutil.ReadFile(strings.TrimSuffix(f, ".json") + ".reason")
+		got = append(got, string(doc)+" "+strings.TrimSpace(string(reason)))
+	}
+	sort.Strings(got)
+
+	want := []string{
+		`"x" not a JSON object`,
+		`2 not a JSON object`,
+		`[3] not a JSON object`,
+		`null not a JSON object`,
+		`{"v":4} missing timestamp path [/ts]`,
+		`{"v":5} missing timestamp path [/ts]`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("dead letter = %q, want %q", got, want)
+	}
+}
+
+func toStrings(values [][]byte) []string {
+	var s []string
+	for _, v := range values {
+		s = append(s, string(v))
+	}
+	return s
+}
@@ -0,0 +1,160 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package embed
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/chronowave/chronowave/ssd"
+	ssdexec "github.com/chronowave/chronowave/ssd/exec"
+	"github.com/chronowave/chronowave/ssd/operator"
+	"github.com/chronowave/chronowave/ssql"
+
+	"github.com/rs/zerolog/log"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Explain describes how statement is executed, it is returned by Query as JSON instead of result
+// set if statement is EXPLAIN
+type Explain struct {
+	Statement json.RawMessage `json:"statement"`
+	Namespace string          `json:"namespace"`
+	// Lookups are catalog lookups selecting index blocks, blocks are searched only in WAL if none
+	Lookups []Lookup     `json:"lookups"`
+	Blocks  BlockSummary `json:"blocks"`
+	// Searches are evaluations of statement in WAL and selected index blocks
+	Searches []SearchTrace `json:"searches"`
+	// Merge is merging result sets of searches, and ordering them by ORDER-BY. Result sets of
+	// aggregate statement are merged by groups during searches, Merge is the rest of it.
+	Merge ssdexec.OperatorTrace `json:"merge"`
+	// Spilled is true if groups of aggregate statement are spilled to disk
+	Spilled  bool          `json:"spilled,omitempty"`
+	Rows     int           `json:"rows"`
+	Error    string        `json:"error,omitempty"`
+	Duration time.Duration `json:"duration_ns"`
+}
+
+// BlockSummary counts index blocks of namespace pruned by catalog and opened by search
+type BlockSummary struct {
+	Registered int `json:"registered"`
+	Selected   int `json:"selected"`
+	Pruned     int `json:"pruned"`
+	Opened     int `json:"opened"`
+	// Rejected are not opened as their Bloom filters exclude WHERE
+	Rejected int `json:"rejected"`
+	Skipped  int `json:"skipped"`
+	WAL      int `json:"wal"`
+}
+
+// SearchTrace is evaluation of statement in WAL or index block file, Skipped if it is not opened
+type SearchTrace struct {
+	Source  string `json:"source"`
+	Skipped bool   `json:"skipped,omitempty"`
+	*ssdexec.Trace
+}
+
+// explain executes statement as query, and returns Explain in JSON
+func (n *Namespace) explain(ctx context.Context, stmt *ssql.Statement) []byte {
+	start := time.Now()
+	e := &Explain{Namespace: n.name, Lookups: []Lookup{}, Searches: []SearchTrace{}}
+
+	if b, err := protojson.Marshal(stmt); err == nil {
+		e.Statement = b
+	}
+
+	wid, err := n.explainSelect(stmt, e)
+	if err != nil {
+		e.Error = err.Error()
+	}
+
+	var agg *operator.Aggregation
+	if operator.IsAggregate(stmt) {
+		agg = n.store.aggregation(stmt)
+		defer agg.Close()
+	}
+
+	rss, searches := n.search(ctx, stmt, wid, true, agg)
+	for _, s := range searches {
+		switch {
+		case s.Source == wal:
+			e.Blocks.WAL++
+		case s.Skipped:
+			e.Blocks.Skipped++
+		case s.Rejected:
+			e.Blocks.Rejected++
+		default:
+			e.Blocks.Opened++
+		}
+	}
+	e.Searches = append(e.Searches, searches...)
+
+	if len(rss) > 0 || agg != nil {
+		began := time.Now()
+		rs := &ssd.ResultSet{}
+		if agg == nil {
+			rs = operator.Merge(rss)
+		} else if merged, err := agg.Result(0); err == nil {
+			rs = merged
+		} else {
+			e.Error = err.Error()
+		}
+		if len(stmt.OrderBy) > 0 {
+			operator.OrderBy(rs, stmt)
+		}
+		e.Merge = ssdexec.OperatorTrace{Rows: len(rs.RowId), Duration: time.Since(began)}
+		e.Spilled = agg != nil && agg.Spilled()
+
+		e.Rows = len(rs.RowId)
+		if limit := int(stmt.GetLimit()); limit > 0 && limit < e.Rows {
+			e.Rows = limit
+		}
+	}
+
+	e.Duration = time.Since(start)
+	b, err := json.Marshal(e)
+	if err != nil {
+		log.Err(err).Msg("marshal explain")
+		return empty
+	}
+	return b
+}
+
+// explainSelect selects index blocks as query, and records catalog lookups and pruned blocks
+func (n *Namespace) explainSelect(stmt *ssql.Statement, e *Explain) ([]int64, error) {
+	waves, err := n.store.catalog.SelectWaveInfo(n.name)
+	if err != nil {
+		return []int64{}, err
+	}
+	e.Blocks.Registered = len(waves)
+
+	sel, err := newSelector(n.store.catalog, n.name)
+	if err != nil {
+		return []int64{}, err
+	}
+
+	wid, err := sel.selectIds(stmt)
+	e.Lookups = append(e.Lookups, sel.lookups...)
+	e.Blocks.Selected = len(wid)
+	e.Blocks.Pruned = e.Blocks.Registered - e.Blocks.Selected
+
+	return wid, err
+}
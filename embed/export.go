@@ -0,0 +1,242 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package embed
+
+import (
+	"bufio"
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/chronowave/chronowave/ssd"
+	ssdexec "github.com/chronowave/chronowave/ssd/exec"
+	"github.com/chronowave/chronowave/ssql"
+
+	"github.com/rs/zerolog/log"
+)
+
+// ExportOptions selects documents written by Export
+type ExportOptions struct {
+	// Timestamp is JSON path to timestamp field of documents, required
+	Timestamp string
+	// From and To bound timestamp of documents inclusively, zero value is unbounded
+	From, To time.Time
+	// Query filters documents by its FROM and WHERE, the rest of statement is ignored
+	Query *ssql.Statement
+}
+
+// Export writes documents of the default store to w
+func Export(ctx context.Context, w io.Writer, opts ExportOptions) (int64, error) {
+	return std.Export(ctx, w, opts)
+}
+
+// Export writes documents of namespace in FROM of query, or the default namespace, to w
+func (s *Store) Export(ctx context.Context, w io.Writer, opts ExportOptions) (int64, error) {
+	var name string
+	if opts.Query != nil {
+		name = statementNamespace(opts.Query)
+	}
+
+	n, err := s.namespace(name, false)
+	if err != nil || n == nil {
+		return 0, err
+	}
+
+	return n.Export(ctx, w, opts)
+}
+
+// Export restores documents from WAL and index blocks, and writes them to w as NDJSON in timestamp
+// order, returns number of written documents. Index blocks are read in order of their first
+// timestamp, so only documents of blocks overlapping in time are kept in memory.
+func (n *Namespace) Export(ctx context.Context, w io.Writer, opts ExportOptions) (written int64, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("runtime error in export %v", r)
+		}
+	}()
+
+	if len(opts.Timestamp) == 0 {
+		return 0, errors.New("timestamp field must not be empty")
+	}
+
+	stmt := n.exportStatement(opts)
+	wid, err := selectIndexIds(n.store.catalog, n.name, stmt)
+	if err != nil {
+		return 0, err
+	}
+
+	waves, err := n.store.catalog.SelectWaveInfo(n.name)
+	if err != nil {
+		return 0, err
+	}
+
+	selected := toWidSet(wid)
+	blocks := waves[:0]
+	for _, wave := range waves {
+		if _, ok := selected[wave.ID]; ok {
+			blocks = append(blocks, wave)
+		}
+	}
+	sort.SliceStable(blocks, func(i, j int) bool { return blocks[i].Beg < blocks[j].Beg })
+
+	out := &exportWriter{w: bufio.NewWriter(w)}
+	for _, t := range n.matchTails(statementLabels(stmt)) {
+		if indexed := t.index; indexed != nil {
+			out.push(restoreTimestamps(ssdexec.Exec(indexed, stmt)))
+		}
+	}
+
+	for _, wave := range blocks {
+		if err = ctx.Err(); err != nil {
+			return out.written, err
+		}
+
+		// documents before the block are not in any of the following blocks
+		if err = out.flush(wave.Beg); err != nil {
+			return out.written, err
+		}
+
+		f := n.blockPath(wave.ID)
+		b, err := n.store.cache.acquire(f)
+		if err != nil {
+			log.Info().Msgf("skipped index file %v due to %v\n", f, err)
+			continue
+		}
+		out.push(restoreTimestamps(ssdexec.Exec(b.block, stmt)))
+		n.store.cache.release(b)
+	}
+
+	if err = out.flush(math.MaxInt64); err == nil {
+		err = out.w.Flush()
+	}
+
+	return out.written, err
+}
+
+// exportStatement finds whole documents with timestamp between From and To, filtered by WHERE of
+// query
+func (n *Namespace) exportStatement(opts ExportOptions) *ssql.Statement {
+	beg, end := int64(math.MinInt64), int64(math.MaxInt64)
+	if !opts.From.IsZero() {
+		beg = n.store.fromTime(opts.From)
+	}
+	if !opts.To.IsZero() {
+		end = n.store.fromTime(opts.To)
+	}
+
+	stmt := &ssql.Statement{
+		Find: []*ssql.Attribute{{Name: "doc"}, {Name: "ts"}},
+		Where: []*ssql.Expr{
+			{Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{Name: "doc", Path: "/"}}},
+			{Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
+				Name: "ts",
+				Path: opts.Timestamp,
+				Predicate: &ssql.Tuple_Timeframe{Timeframe: &ssql.Binary{
+					First:  &ssql.Operand{Value: &ssql.Operand_Int{Int: beg}},
+					Second: &ssql.Operand{Value: &ssql.Operand_Int{Int: end}},
+				}},
+			}}},
+		},
+	}
+
+	if opts.Query != nil {
+		stmt.From = opts.Query.From
+		stmt.Where = append(stmt.Where, opts.Query.Where...)
+	}
+
+	return stmt
+}
+
+// exported is a restored document waiting for documents of earlier timestamp
+type exported struct {
+	ts  int64
+	seq int
+	doc []byte
+}
+
+// exportWriter orders restored documents by timestamp, documents of the same timestamp are kept
+// in order of restoring
+type exportWriter struct {
+	w       *bufio.Writer
+	pending []exported
+	seq     int
+	written int64
+}
+
+func (e *exportWriter) Len() int { return len(e.pending) }
+func (e *exportWriter) Less(i, j int) bool {
+	if e.pending[i].ts == e.pending[j].ts {
+		return e.pending[i].seq < e.pending[j].seq
+	}
+	return e.pending[i].ts < e.pending[j].ts
+}
+func (e *exportWriter) Swap(i, j int)      { e.pending[i], e.pending[j] = e.pending[j], e.pending[i] }
+func (e *exportWriter) Push(x interface{}) { e.pending = append(e.pending, x.(exported)) }
+func (e *exportWriter) Pop() interface{} {
+	last := e.pending[len(e.pending)-1]
+	e.pending = e.pending[:len(e.pending)-1]
+	return last
+}
+
+// push adds documents of result set, a document is restored once even if its timestamp repeats
+func (e *exportWriter) push(rs *ssd.ResultSet) {
+	if rs == nil || len(rs.Column) < 2 {
+		return
+	}
+
+	seen := map[uint64]void{}
+	for i, row := range rs.RowId {
+		if rs.Column[0].RowIdx[i] == 0 || rs.Column[1].RowIdx[i] == 0 {
+			continue
+		}
+		if _, ok := seen[row]; ok {
+			continue
+		}
+		seen[row] = void{}
+
+		e.seq++
+		heap.Push(e, exported{
+			ts:  int64(rs.Column[1].Value[i]),
+			seq: e.seq,
+			doc: rs.Json[rs.Column[0].Value[i]],
+		})
+	}
+}
+
+// flush writes pending documents before timestamp, all of them if it is math.MaxInt64
+func (e *exportWriter) flush(before int64) error {
+	for len(e.pending) > 0 && (e.pending[0].ts < before || before == math.MaxInt64) {
+		doc := heap.Pop(e).(exported)
+		if _, err := e.w.Write(doc.doc); err != nil {
+			return err
+		}
+		if err := e.w.WriteByte('\n'); err != nil {
+			return err
+		}
+		e.written++
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package embed
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// openTestStore opens store of temporary data directory in unit of seconds
+func openTestStore(t *testing.T, catalog string) *Store {
+	s, err := OpenStore(t.TempDir(), Options{TimeUnit: "s", CatalogType: catalog})
+	if err != nil {
+		t.Fatalf("OpenStore() error = %v", err)
+	}
+	t.Cleanup(func() { s.Close() })
+
+	return s
+}
+
+// testStores runs test against store of journal catalog, and of sqlite catalog if it is built
+func testStores(t *testing.T, test func(t *testing.T, s *Store)) {
+	for _, kind := range []string{JournalCatalog, SqliteCatalog} {
+		t.Run(kind, func(t *testing.T) {
+			s, err := OpenStore(t.TempDir(), Options{TimeUnit: "s", CatalogType: kind})
+			if err != nil {
+				t.Skipf("OpenStore() error = %v", err)
+			}
+			defer s.Close()
+
+			test(t, s)
+		})
+	}
+}
+
+// buildTestBlock indexes documents into an index block of namespace, timestamp is /ts
+func buildTestBlock(t *testing.T, n *Namespace, docs string) {
+	f := filepath.Join(t.TempDir(), "docs.json")
+	if err := ioutil.WriteFile(f, []byte(docs), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := n.Build(f, "/ts", nil); err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+}
+
+func TestExport(t *testing.T) {
+	s := openTestStore(t, JournalCatalog)
+	buildTestBlock(t, s.root, `{"ts":100,"v":1} {"ts":400,"v":4} {"ts":200,"v":2} {"ts":300,"v":3}`)
+	buildTestBlock(t, s.root, `{"ts":600,"v":6} {"ts":500,"v":5}`)
+
+	tests := []struct {
+		name     string
+		from, to int64
+		want     string
+	}{
+		{"all", 0, 0, "1 2 3 4 5 6"},
+		{"block wider than range", 150, 350, "2 3"},
+		{"across blocks", 300, 550, "3 4 5"},
+		{"none", 410, 490, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := ExportOptions{Timestamp: "/ts"}
+			if tt.from > 0 {
+				opts.From, opts.To = time.Unix(tt.from, 0), time.Unix(tt.to, 0)
+			}
+
+			var w bytes.Buffer
+			written, err := s.Export(context.Background(), &w, opts)
+			if err != nil {
+				t.Fatalf("Export() error = %v", err)
+			}
+
+			var got []byte
+			for _, line := range bytes.Split(bytes.TrimSpace(w.Bytes()), []byte("\n")) {
+				if i := bytes.Index(line, []byte(`"v":`)); i >= 0 {
+					got = append(append(got, ' '), line[i+4:len(line)-1]...)
+				}
+			}
+			if string(bytes.TrimSpace(got)) != tt.want {
+				t.Errorf("Export() = %s, want %v", w.Bytes(), tt.want)
+			}
+			if want := len(bytes.Fields([]byte(tt.want))); written != int64(want) {
+				t.Errorf("Export() written = %v, want %v", written, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,393 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package embed
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	ssdexec "github.com/chronowave/chronowave/ssd/exec"
+	ssdidx "github.com/chronowave/chronowave/ssd/index"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	quarantine = "quarantine"
+)
+
+// FsckOptions controls Fsck, zero value only checks
+type FsckOptions struct {
+	// Repair unregisters missing and corrupted blocks, moves corrupted and orphaned index files
+	// to quarantine, drops dangling catalog entries and resolves WAL leftovers
+	Repair bool
+	// Timestamp is JSON path to timestamp field, orphaned index files are registered again with
+	// Timestamp and Keys instead of moved to quarantine when it is set
+	Timestamp string
+	Keys      []string
+}
+
+// FsckReport lists inconsistencies between catalog and files of data directory
+type FsckReport struct {
+	// Blocks is number of checked index blocks
+	Blocks int
+	// Missing are index files of registered blocks not found
+	Missing []string
+	// Corrupted are index files unable to be decoded or restored
+	Corrupted []string
+	// Orphaned are index files not registered in catalog
+	Orphaned []string
+	// Leftovers are WAL files suffixed with block id, left by interrupted indexing
+	Leftovers []string
+	// Dangling are block ids having keys or labels in catalog without registered block
+	Dangling []int64
+	// Repaired describes changes made by repair
+	Repaired []string
+}
+
+// Healthy tells whether no inconsistency is found
+func (r *FsckReport) Healthy() bool {
+	return len(r.Missing)+len(r.Corrupted)+len(r.Orphaned)+len(r.Leftovers)+len(r.Dangling) == 0
+}
+
+func (r *FsckReport) repaired(format string, args ...interface{}) {
+	r.Repaired = append(r.Repaired, fmt.Sprintf(format, args...))
+}
+
+// Fsck checks every namespace of the default store
+func Fsck(ctx context.Context, opts FsckOptions) (*FsckReport, error) {
+	return std.Fsck(ctx, opts)
+}
+
+// Fsck checks catalog against files of every namespace, namespaces only known to catalog are
+// checked as well
+func (s *Store) Fsck(ctx context.Context, opts FsckOptions) (*FsckReport, error) {
+	if opts.Repair {
+		release, err := s.exclusive()
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+	}
+
+	names, err := s.Namespaces()
+	if err != nil {
+		return nil, err
+	}
+
+	registered, err := s.catalog.SelectNamespace()
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]void{}
+	for _, name := range append(append([]string{""}, names...), registered...) {
+		seen[name] = void{}
+	}
+	names = names[:0]
+	for name := range seen {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	report := &FsckReport{}
+	for _, name := range names {
+		n, err := s.Namespace(name)
+		if err != nil {
+			return report, err
+		}
+		if err = n.fsck(ctx, opts, report); err != nil {
+			return report, err
+		}
+	}
+
+	if report.Dangling, err = s.catalog.SelectDanglingWid(); err != nil {
+		return report, err
+	}
+	if opts.Repair && len(report.Dangling) > 0 {
+		if err = s.catalog.RemoveWave(report.Dangling); err != nil {
+			return report, err
+		}
+		report.repaired("dropped catalog entries of %d unregistered blocks", len(report.Dangling))
+	}
+
+	return report, nil
+}
+
+// Fsck checks catalog against files of namespace
+func (n *Namespace) Fsck(ctx context.Context, opts FsckOptions) (*FsckReport, error) {
+	if opts.Repair {
+		release, err := n.store.exclusive()
+		if err != nil {
+			return nil, err
+		}
+		defer release()
+	}
+
+	report := &FsckReport{}
+	return report, n.fsck(ctx, opts, report)
+}
+
+func (n *Namespace) fsck(ctx context.Context, opts FsckOptions, report *FsckReport) error {
+	// blocks are not replaced by compaction while checked
+	n.compacting.Lock()
+	defer n.compacting.Unlock()
+
+	c := n.store.catalog
+	waves, err := c.SelectWaveInfo(n.name)
+	if err != nil {
+		return err
+	}
+
+	var remove []int64
+	registered := map[int64]void{}
+	for _, wave := range waves {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
+		registered[wave.ID] = void{}
+		path := n.blockPath(wave.ID)
+		report.Blocks++
+
+		data, err := ioutil.ReadFile(path)
+		if os.IsNotExist(err) {
+			report.Missing = append(report.Missing, path)
+			remove = append(remove, wave.ID)
+			continue
+		}
+
+		if err == nil {
+			err = verifyBlock(data)
+		}
+		if err != nil {
+			log.Info().Msgf("index block %v has err: %v", path, err)
+			report.Corrupted = append(report.Corrupted, path)
+			if opts.Repair {
+				if err = n.quarantine(path); err != nil {
+					return err
+				}
+				report.repaired("moved corrupted %v to quarantine", path)
+			}
+			remove = append(remove, wave.ID)
+		}
+	}
+
+	if opts.Repair && len(remove) > 0 {
+		if err = c.RemoveWave(remove); err != nil {
+			return err
+		}
+		report.repaired("unregistered %d missing or corrupted blocks of namespace [%v]", len(remove), n.name)
+
+		usage, err := c.SelectUsage(n.name)
+		if err != nil {
+			return err
+		}
+		atomic.StoreInt64(&n.usage, usage)
+	}
+
+	if err = n.fsckOrphans(ctx, opts, registered, report); err != nil {
+		return err
+	}
+
+	return n.fsckLeftovers(opts, registered, report)
+}
+
+// fsckOrphans finds index files not registered in catalog, including temporary files of
+// interrupted writes
+func (n *Namespace) fsckOrphans(ctx context.Context, opts FsckOptions, registered map[int64]void, report *FsckReport) error {
+	var orphans []string
+	err := filepath.Walk(filepath.Join(n.dir, index), func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		if wid, err := strconv.ParseInt(info.Name(), 16, 64); err == nil && len(info.Name()) == 16 {
+			if _, ok := registered[wid]; ok && path == n.blockPath(wid) {
+				return nil
+			}
+		}
+		orphans = append(orphans, path)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, path := range orphans {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+
+		report.Orphaned = append(report.Orphaned, path)
+		if !opts.Repair {
+			continue
+		}
+
+		if len(opts.Timestamp) > 0 {
+			if err = n.reregister(path, opts.Timestamp, opts.Keys); err == nil {
+				report.repaired("registered orphaned %v", path)
+				continue
+			}
+			log.Info().Msgf("registering orphaned %v has err: %v", path, err)
+		}
+
+		if err = n.quarantine(path); err != nil {
+			return err
+		}
+		report.repaired("moved orphaned %v to quarantine", path)
+	}
+
+	return nil
+}
+
+// reregister registers orphaned index file under its block id, or a new id if its name is not one
+func (n *Namespace) reregister(path string, timestamp string, keys []string) error {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	indexed, err := ssdidx.DecodeIndexBlock(data)
+	if err != nil {
+		return err
+	}
+
+	rs := ssdexec.Exec(indexed, restore)
+	if rs == nil || len(rs.RowId) != len(indexed.EntityID) {
+		return errors.New("unable to restore documents")
+	}
+	size := int64(len(data))
+
+	wid, err := strconv.ParseInt(filepath.Base(path), 16, 64)
+	if err != nil || path != n.blockPath(wid) {
+		// written into a new block file, the orphan is removed once registered
+		if wid, err = n.writeBlock(0, data); err != nil {
+			return err
+		}
+	}
+
+	if err = n.register(wid, indexed, size, timestamp, keys, nil); err != nil {
+		if path != n.blockPath(wid) {
+			os.Remove(n.blockPath(wid))
+		}
+		return err
+	}
+
+	if path != n.blockPath(wid) {
+		os.Remove(path)
+	}
+
+	return nil
+}
+
+// fsckLeftovers finds WAL files renamed with block id suffix, they are removed if the block is
+// registered, otherwise renamed back to be indexed again
+func (n *Namespace) fsckLeftovers(opts FsckOptions, registered map[int64]void, report *FsckReport) error {
+	return filepath.Walk(filepath.Join(n.dir, wal), func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		ext := filepath.Ext(path)
+		if len(ext) < 2 {
+			return nil
+		}
+		wid, err := strconv.ParseInt(ext[1:], 10, 64)
+		if err != nil {
+			return nil
+		}
+
+		report.Leftovers = append(report.Leftovers, path)
+		if !opts.Repair {
+			return nil
+		}
+
+		if _, ok := registered[wid]; ok {
+			if err = os.Remove(path); err != nil {
+				return err
+			}
+			report.repaired("removed %v indexed in block %016X", path, wid)
+		} else {
+			original := strings.TrimSuffix(path, ext)
+			if err = os.Rename(path, original); err != nil {
+				return err
+			}
+			report.repaired("renamed %v back to %v", path, original)
+		}
+
+		return nil
+	})
+}
+
+// quarantine moves file out of index directory into quarantine of namespace
+func (n *Namespace) quarantine(path string) error {
+	dir := filepath.Join(n.dir, quarantine)
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+
+	n.store.cache.evict(path)
+	err := os.Rename(path, filepath.Join(dir, filepath.Base(path)))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+// verifyBlock decodes index block and restores its first, middle and last documents
+func verifyBlock(data []byte) (err error) {
+	indexed, err := ssdidx.DecodeIndexBlock(data)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("restoring documents panics: %v", r)
+		}
+	}()
+
+	count := len(indexed.EntityID)
+	if count == 0 {
+		return errors.New("index block has no document")
+	}
+
+	sample := []uint16{0, uint16(count / 2), uint16(count - 1)}
+	docs := ssdexec.Restore(indexed, sample)
+	if len(docs) != len(sample) {
+		return fmt.Errorf("restored %d of %d documents", len(docs), len(sample))
+	}
+	for i, doc := range docs {
+		if !json.Valid(doc) {
+			return fmt.Errorf("restored document %d is not valid JSON", sample[i])
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package embed
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestFsckRepairLocked(t *testing.T) {
+	s := openTestStore(t, JournalCatalog)
+	buildTestBlock(t, s.root, `{"ts":100,"v":1} {"ts":200,"v":2}`)
+
+	other, err := OpenStore(s.dir, Options{})
+	if err != nil {
+		t.Fatalf("OpenStore() error = %v", err)
+	}
+
+	// checking is allowed while data directory is open elsewhere
+	if report, err := s.Fsck(context.Background(), FsckOptions{}); err != nil || !report.Healthy() {
+		t.Errorf("Fsck() = %v, %v, want healthy", report, err)
+	}
+	if _, err = s.Fsck(context.Background(), FsckOptions{Repair: true}); !errors.Is(err, ErrLocked) {
+		t.Errorf("Fsck() repair error = %v, want %v", err, ErrLocked)
+	}
+	if _, err = s.root.Fsck(context.Background(), FsckOptions{Repair: true}); !errors.Is(err, ErrLocked) {
+		t.Errorf("Namespace.Fsck() repair error = %v, want %v", err, ErrLocked)
+	}
+
+	other.Close()
+	if report, err := s.Fsck(context.Background(), FsckOptions{Repair: true}); err != nil || !report.Healthy() {
+		t.Errorf("Fsck() repair = %v, %v, want healthy", report, err)
+	}
+
+	release, err := s.exclusive()
+	if err != nil {
+		t.Fatalf("exclusive() error = %v", err)
+	}
+	if _, err = OpenStore(s.dir, Options{}); !errors.Is(err, ErrLocked) {
+		t.Errorf("OpenStore() error = %v while repaired, want %v", err, ErrLocked)
+	}
+	release()
+
+	// the store is still usable after repair
+	buildTestBlock(t, s.root, `{"ts":300,"v":3}`)
+}
@@ -20,6 +20,7 @@
 package embed
 
 import (
+	encjson "encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -27,6 +28,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"sync/atomic"
 
 	"github.com/chronowave/chronowave/ssd"
@@ -36,16 +38,45 @@ import (
 	"github.com/chronowave/chronowave/ssql"
 )
 
+var errTimestamp = errors.New("invalid timestamp path")
+
+// Build indexes JSON file in the default store
 func Build(json string, timestamp string, keys []string) error {
+	return std.Build(json, timestamp, keys)
+}
+
+// Build indexes JSON file in the default namespace, timestamp and keys are JSON paths
+func (s *Store) Build(json string, timestamp string, keys []string) error {
+	return s.root.Build(json, timestamp, keys)
+}
+
+// Build indexes JSON file, timestamp and keys are JSON paths
+func (n *Namespace) Build(json string, timestamp string, keys []string) error {
+	return n.BuildWithLabels(json, timestamp, keys, nil)
+}
+
+// BuildWithLabels indexes JSON file into index block tagged with labels
+func (n *Namespace) BuildWithLabels(json string, timestamp string, keys []string, labels Labels) error {
 	data, err := ioutil.ReadFile(json)
 	if err != nil {
 		return err
 	}
 
-	return createIndex(0, data, timestamp, keys)
+	n.store.writing.RLock()
+	defer n.store.writing.RUnlock()
+
+	return n.createIndex(0, data, timestamp, keys, labels)
 }
 
-func createIndex(nid int64, data []byte, timestamp string, keys []string) error {
+func (n *Namespace) createIndex(nid int64, data []byte, timestamp string, keys []string, labels Labels) error {
+	data, rejected, total := n.validate(data, timestamp)
+	if len(data) == 0 {
+		if total == 0 {
+			return nil
+		}
+		return fmt.Errorf("%w, %d of %d documents moved to %v", ErrDeadLetter, rejected, total, filepath.Join(n.dir, deadletter))
+	}
+
 	parsed, err := codec.ParseJson(data)
 	if err != nil {
 		return err
@@ -56,58 +87,41 @@ func createIndex(nid int64, data []byte, timestamp string, keys []string) error
 		return err
 	}
 
-	dir := filepath.Join(Directory, index)
-	tmp, err := ioutil.TempFile(dir, "tmp")
+	encoded, err := ssdidx.EncodeIndexBlock(indexed)
 	if err != nil {
 		return err
 	}
-	defer tmp.Close()
 
-	data, err = ssdidx.EncodeIndexBlock(indexed)
-	if err != nil {
-		return err
+	size := int64(len(encoded))
+	if n.exceeds(size) {
+		return fmt.Errorf("%w [%v], %v of %v bytes used", ErrQuota, n.name, n.Usage(), n.Quota())
 	}
 
-	_, err = tmp.Write(data)
+	nid, err = n.writeBlock(nid, encoded)
 	if err != nil {
 		return err
 	}
-	if err = tmp.Close(); err != nil {
-		return err
+
+	if err = n.register(nid, indexed, size, timestamp, keys, labels); errors.Is(err, errTimestamp) {
+		os.Remove(n.blockPath(nid))
 	}
+	return err
+}
 
-	for {
-		if nid == 0 {
-			nid = atomic.AddInt64(&seq, 1)
-		}
-		name := fmt.Sprintf("%016X", nid)
-		path := filepath.Join(Directory, index, name[:4], name[8:12])
-		os.MkdirAll(path, os.ModePerm)
-		if f, err := os.OpenFile(filepath.Join(path, name), os.O_CREATE|os.O_EXCL, os.ModePerm); err == nil {
-			f.Close()
-			os.Rename(tmp.Name(), f.Name())
-			break
-		} else if !os.IsExist(err) {
-			panic(err)
-		}
+// register inserts index block written to file of block id into catalog with its time range,
+// labels and keys, size is bytes of index block file
+func (n *Namespace) register(nid int64, indexed *ssd.IndexedBlock, size int64, timestamp string, keys []string, labels Labels) error {
+	keys, err := n.withKeys(keys)
+	if err != nil {
+		return err
 	}
 
-	attributes := make([]*ssql.Attribute, len(keys)+1)
-	expr := make([]*ssql.Expr, len(keys)+1)
-	attributes[0] = &ssql.Attribute{Name: "ts"}
-	expr[0] = &ssql.Expr{Field: &ssql.Expr_Tuple{
+	ki := newKeyIndex(keys, 1)
+	attributes, expr := ki.tuples()
+	attributes = append([]*ssql.Attribute{{Name: "ts"}}, attributes...)
+	expr = append([]*ssql.Expr{{Field: &ssql.Expr_Tuple{
 		Tuple: &ssql.Tuple{Name: "ts", Path: timestamp},
-	}}
-
-	inverted := make([]map[string]bool, len(keys))
-	for i, key := range keys {
-		inverted[i] = map[string]bool{}
-		name := strconv.FormatInt(int64(i), 10)
-		attributes[i+1] = &ssql.Attribute{Name: name}
-		expr[i+1] = &ssql.Expr{Field: &ssql.Expr_Tuple{
-			Tuple: &ssql.Tuple{Name: name, Path: key},
-		}}
-	}
+	}}}, expr...)
 
 	stmt := &ssql.Statement{Find: attributes, Where: expr}
 
@@ -131,52 +145,175 @@ func createIndex(nid int64, data []byte, timestamp string, keys []string) error
 	}
 
 	if min == math.MinInt64 || max == math.MinInt64 {
-		os.Remove(tmp.Name())
-		return errors.New("invalid timestamp path [" + timestamp + "]")
+		return fmt.Errorf("%w [%v]", errTimestamp, timestamp)
+	}
+
+	err = n.store.catalog.InsertWave(Wave{ID: nid, Namespace: n.name, Beg: min, End: max, Size: size})
+	if err != nil {
+		return err
 	}
+	atomic.AddInt64(&n.usage, size)
 
-	err = insertWave(nid, min, max)
+	if err = n.store.catalog.InsertWaveLabel(labels.pairs(), nid); err != nil {
+		return err
+	}
+
+	if err = insertStats(n.store.catalog, nid, indexed); err != nil {
+		return err
+	}
+
+	return ki.insert(n.store.catalog, nid, rs)
+}
+
+// insertStats records stats of index block nid in catalog, queries skip the block without reading
+// its file when its stats prove WHERE can't match
+func insertStats(c Catalog, nid int64, indexed *ssd.IndexedBlock) error {
+	stats, err := encjson.Marshal(ssdexec.BlockStats(indexed))
 	if err != nil {
 		return err
 	}
 
-	for i := 1; i < len(rs.ColumnType); i++ {
-		var onValue func(v uint64)
+	return c.InsertWaveStats(string(stats), nid)
+}
+
+// writeBlock writes encoded index block into file of block id, a new id is assigned if nid is 0
+func (n *Namespace) writeBlock(nid int64, data []byte) (int64, error) {
+	dir := filepath.Join(n.dir, index)
+	tmp, err := ioutil.TempFile(dir, "tmp")
+	if err != nil {
+		return 0, err
+	}
+	defer tmp.Close()
+
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Close()
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return 0, err
+	}
+
+	for {
+		if nid == 0 {
+			nid = atomic.AddInt64(&n.store.seq, 1)
+		}
+		name := fmt.Sprintf("%016X", nid)
+		path := filepath.Join(n.dir, index, name[:4], name[8:12])
+		os.MkdirAll(path, os.ModePerm)
+		if f, err := os.OpenFile(filepath.Join(path, name), os.O_CREATE|os.O_EXCL, os.ModePerm); err == nil {
+			f.Close()
+			os.Rename(tmp.Name(), f.Name())
+			return nid, nil
+		} else if !os.IsExist(err) {
+			panic(err)
+		}
+	}
+}
+
+// keyIndex extracts key values of index block, composite key joins its JSON paths with +, each
+// distinct path is a column of result set starting from offset
+type keyIndex struct {
+	offset    int
+	columns   []string
+	component [][]int
+	declared  []string
+}
+
+func newKeyIndex(keys []string, offset int) *keyIndex {
+	ki := &keyIndex{
+		offset:    offset,
+		component: make([][]int, len(keys)),
+		declared:  make([]string, len(keys)),
+	}
+
+	column := map[string]int{}
+	for i, key := range keys {
+		paths := splitComposite(key)
+		ki.declared[i] = canonicalKey(key)
+		for _, p := range paths {
+			c, ok := column[p]
+			if !ok {
+				c = len(ki.columns)
+				column[p] = c
+				ki.columns = append(ki.columns, p)
+			}
+			ki.component[i] = append(ki.component[i], c+offset)
+		}
+	}
+
+	return ki
+}
+
+// tuples returns attributes and expressions selecting key columns
+func (ki *keyIndex) tuples() ([]*ssql.Attribute, []*ssql.Expr) {
+	attributes := make([]*ssql.Attribute, len(ki.columns))
+	expr := make([]*ssql.Expr, len(ki.columns))
+	for i, path := range ki.columns {
+		name := strconv.FormatInt(int64(i), 10)
+		attributes[i] = &ssql.Attribute{Name: name}
+		expr[i] = &ssql.Expr{Field: &ssql.Expr_Tuple{
+			Tuple: &ssql.Tuple{Name: name, Path: path},
+		}}
+	}
+
+	return attributes, expr
+}
+
+// insert registers key values of result set and declared keys of index block nid in catalog
+func (ki *keyIndex) insert(c Catalog, nid int64, rs *ssd.ResultSet) error {
+	// value of column i at row j, integer is in hex
+	value := func(i, j int) (string, bool) {
+		if rs.Column[i].RowIdx[j] == 0 {
+			return "", false
+		}
+
+		v := rs.Column[i].Value[j]
 		switch rs.ColumnType[i] {
 		case ssd.TEXT:
-			onValue = func(v uint64) {
-				b := rs.Text[v]
-				if len(b) > 0 {
-					inverted[i-1][string(rs.Text[v])] = true
-				}
+			if b := rs.Text[v]; len(b) > 0 {
+				return string(b), true
 			}
 		case ssd.INT64:
-			onValue = func(v uint64) {
-				inverted[i-1][strconv.FormatUint(v, 16)] = true
-			}
-		default:
-			continue
+			return keyInt(int64(v)), true
 		}
 
-		for j := range rs.RowId {
-			if rs.Column[i].RowIdx[j] > 0 {
-				onValue(rs.Column[i].Value[j])
+		return "", false
+	}
+
+	inverted := make([]map[string]bool, len(ki.declared))
+	for i := range ki.declared {
+		inverted[i] = map[string]bool{}
+	}
+
+	parts := make([]string, 0, len(ki.columns))
+	for j := 0; rs != nil && j < len(rs.RowId); j++ {
+	key:
+		for i, cols := range ki.component {
+			parts = parts[:0]
+			for _, col := range cols {
+				v, ok := value(col, j)
+				if !ok {
+					continue key
+				}
+				parts = append(parts, v)
 			}
+			inverted[i][strings.Join(parts, compositeValueSeparator)] = true
 		}
 	}
 
-	for i, path := range keys {
+	for i, path := range ki.declared {
 		key := make([]string, len(inverted[i]))
 		j := 0
 		for k := range inverted[i] {
 			key[j] = k
 			j++
 		}
-		err = insertWaveLoc(path, key, nid)
+		err := c.InsertWaveLoc(path, key, nid)
 		if err != nil {
 			return err
 		}
 	}
 
-	return nil
+	return c.InsertWaveKey(ki.declared, nid)
 }
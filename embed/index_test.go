@@ -0,0 +1,61 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package embed
+
+import (
+	"context"
+	"os"
+	"testing"
+)
+
+func TestWaveSize(t *testing.T) {
+	testStores(t, func(t *testing.T, s *Store) {
+		buildTestBlock(t, s.root, `{"ts":1,"s":"a"} {"ts":2,"s":"b"}`)
+		buildTestBlock(t, s.root, `{"ts":3,"v":[1,2,3]}`)
+
+		check := func(want int) {
+			waves, err := s.catalog.SelectWaveInfo("")
+			if err != nil || len(waves) != want {
+				t.Fatalf("SelectWaveInfo() = %v, %v, want %v blocks", waves, err, want)
+			}
+
+			var total int64
+			for _, w := range waves {
+				info, err := os.Stat(s.root.blockPath(w.ID))
+				if err != nil {
+					t.Fatalf("Stat() error = %v", err)
+				}
+				if w.Size != info.Size() {
+					t.Errorf("Size of block %v = %v, want file size %v", w.ID, w.Size, info.Size())
+				}
+				total += info.Size()
+			}
+			if usage := s.root.Usage(); usage != total {
+				t.Errorf("Usage() = %v, want %v", usage, total)
+			}
+		}
+
+		check(2)
+		if _, err := s.root.Compact(context.Background()); err != nil {
+			t.Fatalf("Compact() error = %v", err)
+		}
+		check(1)
+	})
+}
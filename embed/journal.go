@@ -0,0 +1,684 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package embed
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	journalFile = "catalog"
+)
+
+// journalCatalog is a pure Go catalog, it keeps everything in memory and appends every change to
+// a journal file of JSON lines. the journal is rewritten with remaining entries on purge.
+type journalCatalog struct {
+	lock    sync.RWMutex
+	path    string
+	file    *os.File
+	wave    map[int64]*journalWave
+	waveloc map[string]map[string]map[int64]void
+	wavekey map[string]map[int64]void
+	// label key=value to index blocks
+	wavelabel map[string]map[int64]void
+	// JSON stats of index blocks
+	wavestats map[int64]string
+	setting   map[string]string
+}
+
+type journalWave struct {
+	ns       string
+	beg, end int64
+	size     int64
+	created  time.Time
+}
+
+type journalRecord struct {
+	Op      string    `json:"op"`
+	Wid     int64     `json:"wid,omitempty"`
+	Ns      string    `json:"ns,omitempty"`
+	Beg     int64     `json:"beg,omitempty"`
+	End     int64     `json:"end,omitempty"`
+	Size    int64     `json:"size,omitempty"`
+	Path    string    `json:"path,omitempty"`
+	Keys    []string  `json:"keys,omitempty"`
+	Wids    []int64   `json:"wids,omitempty"`
+	Name    string    `json:"name,omitempty"`
+	Value   string    `json:"value,omitempty"`
+	Created time.Time `json:"created"`
+}
+
+func openJournalCatalog(dir string) (Catalog, error) {
+	c := &journalCatalog{
+		path:      filepath.Join(dir, journalFile),
+		wave:      map[int64]*journalWave{},
+		waveloc:   map[string]map[string]map[int64]void{},
+		wavekey:   map[string]map[int64]void{},
+		wavelabel: map[string]map[int64]void{},
+		wavestats: map[int64]string{},
+		setting:   map[string]string{},
+	}
+
+	f, err := os.OpenFile(c.path, os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = c.replay(f); err != nil {
+		f.Close()
+		return nil, err
+	}
+
+	c.file = f
+	return c, nil
+}
+
+// replay loads journal, incomplete record at the end of journal is left by crash and truncated
+func (c *journalCatalog) replay(f *os.File) error {
+	r := bufio.NewReader(f)
+	var offset int64
+	for {
+		line, err := r.ReadBytes('\n')
+		if err == io.EOF {
+			if len(bytes.TrimSpace(line)) > 0 {
+				if err = f.Truncate(offset); err != nil {
+					return err
+				}
+			}
+			break
+		} else if err != nil {
+			return err
+		}
+
+		var rec journalRecord
+		if err = json.Unmarshal(line, &rec); err != nil {
+			return fmt.Errorf("corrupted catalog %v at offset %v: %v", c.path, offset, err)
+		}
+		c.apply(&rec)
+		offset += int64(len(line))
+	}
+
+	_, err := f.Seek(offset, io.SeekStart)
+	return err
+}
+
+func (c *journalCatalog) apply(rec *journalRecord) {
+	switch rec.Op {
+	case "wave":
+		c.wave[rec.Wid] = &journalWave{ns: rec.Ns, beg: rec.Beg, end: rec.End, size: rec.Size, created: rec.Created}
+	case "waveloc":
+		loc, ok := c.waveloc[rec.Path]
+		if !ok {
+			loc = map[string]map[int64]void{}
+			c.waveloc[rec.Path] = loc
+		}
+		for _, key := range rec.Keys {
+			wid, ok := loc[key]
+			if !ok {
+				wid = map[int64]void{}
+				loc[key] = wid
+			}
+			wid[rec.Wid] = void{}
+		}
+	case "wavekey":
+		addWid(c.wavekey, rec.Keys, rec.Wid)
+	case "wavelabel":
+		addWid(c.wavelabel, rec.Keys, rec.Wid)
+	case "wavestats":
+		c.wavestats[rec.Wid] = rec.Value
+	case "replace":
+		// the new wave takes created time of the newest replaced wave
+		var (
+			newest  int64
+			created = rec.Created
+			removed = map[int64]void{}
+		)
+		for _, id := range rec.Wids {
+			if w, ok := c.wave[id]; ok && id > newest {
+				newest, created = id, w.created
+			}
+			removed[id] = void{}
+		}
+		c.remove(removed)
+		c.wave[rec.Wid] = &journalWave{ns: rec.Ns, beg: rec.Beg, end: rec.End, size: rec.Size, created: created}
+	case "remove":
+		removed := map[int64]void{}
+		for _, id := range rec.Wids {
+			removed[id] = void{}
+		}
+		c.remove(removed)
+	case "removekey":
+		// index blocks of namespace
+		removed := map[int64]void{}
+		for id, w := range c.wave {
+			if w.ns == rec.Ns {
+				removed[id] = void{}
+			}
+		}
+		if wid, ok := c.wavekey[rec.Path]; ok {
+			for id := range removed {
+				delete(wid, id)
+			}
+			if len(wid) == 0 {
+				delete(c.wavekey, rec.Path)
+			}
+		}
+		if loc, ok := c.waveloc[rec.Path]; ok {
+			removeWid(loc, removed)
+			if len(loc) == 0 {
+				delete(c.waveloc, rec.Path)
+			}
+		}
+	case "setting":
+		c.setting[rec.Name] = rec.Value
+	}
+}
+
+// append applies records and writes them to journal
+func (c *journalCatalog) append(recs ...*journalRecord) error {
+	return c.appendIf(nil, recs...)
+}
+
+// appendIf applies records and writes them to journal if check passes, check is made under the
+// same lock, so records are not appended in between
+func (c *journalCatalog) appendIf(check func() error, recs ...*journalRecord) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, rec := range recs {
+		if err := enc.Encode(rec); err != nil {
+			return err
+		}
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if check != nil {
+		if err := check(); err != nil {
+			return err
+		}
+	}
+
+	if _, err := c.file.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	if err := c.file.Sync(); err != nil {
+		return err
+	}
+
+	for _, rec := range recs {
+		c.apply(rec)
+	}
+
+	return nil
+}
+
+func (c *journalCatalog) Close() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.file.Close()
+}
+
+func (c *journalCatalog) InsertWave(w Wave) error {
+	return c.appendIf(func() error {
+		if _, ok := c.wave[w.ID]; ok {
+			return fmt.Errorf("wave %v already exists", w.ID)
+		}
+		return nil
+	}, &journalRecord{Op: "wave", Wid: w.ID, Ns: w.Namespace, Beg: w.Beg, End: w.End, Size: w.Size, Created: time.Now().UTC()})
+}
+
+func (c *journalCatalog) InsertWaveLoc(path string, keys []string, wid int64) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	return c.append(&journalRecord{Op: "waveloc", Wid: wid, Path: path, Keys: keys, Created: time.Now().UTC()})
+}
+
+func (c *journalCatalog) InsertWaveKey(paths []string, wid int64) error {
+	if len(paths) == 0 {
+		return nil
+	}
+	return c.append(&journalRecord{Op: "wavekey", Wid: wid, Keys: paths, Created: time.Now().UTC()})
+}
+
+func (c *journalCatalog) InsertWaveLabel(labels []string, wid int64) error {
+	if len(labels) == 0 {
+		return nil
+	}
+	return c.append(&journalRecord{Op: "wavelabel", Wid: wid, Keys: labels, Created: time.Now().UTC()})
+}
+
+func (c *journalCatalog) InsertWaveStats(stats string, wid int64) error {
+	return c.append(&journalRecord{Op: "wavestats", Wid: wid, Value: stats, Created: time.Now().UTC()})
+}
+
+func (c *journalCatalog) InsertSetting(name, value string) error {
+	return c.append(&journalRecord{Op: "setting", Name: name, Value: value, Created: time.Now().UTC()})
+}
+
+func (c *journalCatalog) SelectWave(ns string, beg, end int64) ([]int64, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var wid []int64
+	for id, w := range c.wave {
+		if w.ns == ns && w.beg <= end && w.end >= beg {
+			wid = append(wid, id)
+		}
+	}
+
+	return sortWid(wid), nil
+}
+
+func (c *journalCatalog) SelectWaveInfo(ns string) ([]Wave, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var waves []Wave
+	for id, w := range c.wave {
+		if w.ns == ns {
+			waves = append(waves, Wave{ID: id, Namespace: ns, Beg: w.beg, End: w.end, Size: w.size})
+		}
+	}
+	sort.Slice(waves, func(i, j int) bool { return waves[i].ID < waves[j].ID })
+
+	return waves, nil
+}
+
+func (c *journalCatalog) SelectKeys(ns, path string, keys []string) ([]int64, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	set := map[int64]void{}
+	loc := c.waveloc[path]
+	for _, key := range keys {
+		for id := range loc[key] {
+			if w, ok := c.wave[id]; ok && w.ns == ns {
+				set[id] = void{}
+			}
+		}
+	}
+
+	return c.sorted(set), nil
+}
+
+func (c *journalCatalog) SelectKeyPath(ns string) ([]string, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var path []string
+	for p, wid := range c.wavekey {
+		for id := range wid {
+			if w, ok := c.wave[id]; ok && w.ns == ns {
+				path = append(path, p)
+				break
+			}
+		}
+	}
+	sort.Strings(path)
+
+	return path, nil
+}
+
+func (c *journalCatalog) SelectKeyWave(ns, path string) ([]int64, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	set := map[int64]void{}
+	for id := range c.wavekey[path] {
+		if w, ok := c.wave[id]; ok && w.ns == ns {
+			set[id] = void{}
+		}
+	}
+
+	return c.sorted(set), nil
+}
+
+func (c *journalCatalog) SelectLabels(ns string) ([]string, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var labels []string
+	for label, wid := range c.wavelabel {
+		for id := range wid {
+			if w, ok := c.wave[id]; ok && w.ns == ns {
+				labels = append(labels, label)
+				break
+			}
+		}
+	}
+	sort.Strings(labels)
+
+	return labels, nil
+}
+
+func (c *journalCatalog) SelectLabel(ns, label string) ([]int64, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	set := map[int64]void{}
+	for id := range c.wavelabel[label] {
+		if w, ok := c.wave[id]; ok && w.ns == ns {
+			set[id] = void{}
+		}
+	}
+
+	return c.sorted(set), nil
+}
+
+func (c *journalCatalog) SelectWaveStats(wid []int64) (map[int64]string, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	stats := map[int64]string{}
+	for _, id := range wid {
+		if s, ok := c.wavestats[id]; ok {
+			stats[id] = s
+		}
+	}
+
+	return stats, nil
+}
+
+func (c *journalCatalog) SelectSetting(name string) (string, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+	return c.setting[name], nil
+}
+
+func (c *journalCatalog) SelectWidBeforeTime(ns string, before time.Time) ([]int64, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var wid []int64
+	for id, w := range c.wave {
+		if w.ns == ns && w.created.Before(before) {
+			wid = append(wid, id)
+		}
+	}
+
+	return sortWid(wid), nil
+}
+
+func (c *journalCatalog) SelectUsage(ns string) (int64, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var size int64
+	for _, w := range c.wave {
+		if w.ns == ns {
+			size += w.size
+		}
+	}
+
+	return size, nil
+}
+
+func (c *journalCatalog) SelectNamespace() ([]string, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	set := map[string]void{}
+	for _, w := range c.wave {
+		set[w.ns] = void{}
+	}
+
+	ns := make([]string, 0, len(set))
+	for n := range set {
+		ns = append(ns, n)
+	}
+	sort.Strings(ns)
+
+	return ns, nil
+}
+
+func (c *journalCatalog) MaxWid() (int64, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	var max int64
+	for id := range c.wave {
+		if id > max {
+			max = id
+		}
+	}
+
+	return max, nil
+}
+
+func (c *journalCatalog) ReplaceWave(w Wave, wid []int64) error {
+	return c.appendIf(func() error {
+		if _, ok := c.wave[w.ID]; ok {
+			return fmt.Errorf("wave %v already exists", w.ID)
+		}
+		for _, id := range wid {
+			if old, ok := c.wave[id]; !ok || old.ns != w.Namespace {
+				return fmt.Errorf("wave %v no longer exists", id)
+			}
+		}
+		return nil
+	}, &journalRecord{Op: "replace", Wid: w.ID, Ns: w.Namespace, Beg: w.Beg, End: w.End, Size: w.Size, Wids: wid, Created: time.Now().UTC()})
+}
+
+func (c *journalCatalog) SelectDanglingWid() ([]int64, error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	set := map[int64]void{}
+	dangling := func(wid map[int64]void) {
+		for id := range wid {
+			if _, ok := c.wave[id]; !ok {
+				set[id] = void{}
+			}
+		}
+	}
+	for _, wid := range c.wavekey {
+		dangling(wid)
+	}
+	for _, wid := range c.wavelabel {
+		dangling(wid)
+	}
+	for id := range c.wavestats {
+		if _, ok := c.wave[id]; !ok {
+			set[id] = void{}
+		}
+	}
+	for _, loc := range c.waveloc {
+		for _, wid := range loc {
+			dangling(wid)
+		}
+	}
+
+	return c.sorted(set), nil
+}
+
+func (c *journalCatalog) RemoveWave(wid []int64) error {
+	if len(wid) == 0 {
+		return nil
+	}
+	return c.append(&journalRecord{Op: "remove", Wids: wid, Created: time.Now().UTC()})
+}
+
+func (c *journalCatalog) RemoveKey(ns, path string) error {
+	return c.append(&journalRecord{Op: "removekey", Ns: ns, Path: path, Created: time.Now().UTC()})
+}
+
+// PurgeBeforeTime drops index blocks of namespace registered before time, and rewrites journal
+// with the remaining entries
+func (c *journalCatalog) PurgeBeforeTime(ctx context.Context, ns string, before time.Time) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	purged := map[int64]void{}
+	for id, w := range c.wave {
+		if w.ns == ns && w.created.Before(before) {
+			purged[id] = void{}
+		}
+	}
+
+	if len(purged) == 0 {
+		return nil
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	tmp, err := os.Create(c.path + ".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	w := bufio.NewWriter(tmp)
+	enc := json.NewEncoder(w)
+	now := time.Now().UTC()
+	for name, value := range c.setting {
+		if err == nil {
+			err = enc.Encode(&journalRecord{Op: "setting", Name: name, Value: value, Created: now})
+		}
+	}
+	for id, wv := range c.wave {
+		if _, ok := purged[id]; !ok && err == nil {
+			err = enc.Encode(&journalRecord{Op: "wave", Wid: id, Ns: wv.ns, Beg: wv.beg, End: wv.end, Size: wv.size, Created: wv.created})
+		}
+	}
+	for path, wid := range c.wavekey {
+		for id := range wid {
+			if _, ok := purged[id]; !ok && err == nil {
+				err = enc.Encode(&journalRecord{Op: "wavekey", Wid: id, Keys: []string{path}, Created: now})
+			}
+		}
+	}
+	for label, wid := range c.wavelabel {
+		for id := range wid {
+			if _, ok := purged[id]; !ok && err == nil {
+				err = enc.Encode(&journalRecord{Op: "wavelabel", Wid: id, Keys: []string{label}, Created: now})
+			}
+		}
+	}
+	for id, stats := range c.wavestats {
+		if _, ok := purged[id]; !ok && err == nil {
+			err = enc.Encode(&journalRecord{Op: "wavestats", Wid: id, Value: stats, Created: now})
+		}
+	}
+	for path, loc := range c.waveloc {
+		for key, wid := range loc {
+			for id := range wid {
+				if _, ok := purged[id]; !ok && err == nil {
+					err = enc.Encode(&journalRecord{Op: "waveloc", Wid: id, Path: path, Keys: []string{key}, Created: now})
+				}
+			}
+		}
+	}
+	if err == nil {
+		err = w.Flush()
+	}
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = os.Rename(tmp.Name(), c.path); err != nil {
+		return err
+	}
+
+	f, err := os.OpenFile(c.path, os.O_RDWR|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	c.file.Close()
+	c.file = f
+
+	c.remove(purged)
+
+	return nil
+}
+
+// remove drops index blocks and their keys, labels and stats
+func (c *journalCatalog) remove(wid map[int64]void) {
+	for id := range wid {
+		delete(c.wave, id)
+		delete(c.wavestats, id)
+	}
+	removeWid(c.wavekey, wid)
+	removeWid(c.wavelabel, wid)
+	for path, loc := range c.waveloc {
+		removeWid(loc, wid)
+		if len(loc) == 0 {
+			delete(c.waveloc, path)
+		}
+	}
+}
+
+// addWid adds index block wid to each of names
+func addWid(m map[string]map[int64]void, names []string, wid int64) {
+	for _, name := range names {
+		set, ok := m[name]
+		if !ok {
+			set = map[int64]void{}
+			m[name] = set
+		}
+		set[wid] = void{}
+	}
+}
+
+// removeWid removes purged index blocks, and names left without any
+func removeWid(m map[string]map[int64]void, purged map[int64]void) {
+	for name, set := range m {
+		for id := range set {
+			if _, ok := purged[id]; ok {
+				delete(set, id)
+			}
+		}
+		if len(set) == 0 {
+			delete(m, name)
+		}
+	}
+}
+
+func (c *journalCatalog) sorted(set map[int64]void) []int64 {
+	wid := make([]int64, 0, len(set))
+	for id := range set {
+		wid = append(wid, id)
+	}
+	return sortWid(wid)
+}
+
+func sortWid(wid []int64) []int64 {
+	sort.Slice(wid, func(i, j int) bool { return wid[i] < wid[j] })
+	return wid
+}
@@ -0,0 +1,281 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package embed
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/chronowave/chronowave/ssd"
+	"github.com/chronowave/chronowave/ssql"
+)
+
+const (
+	// compositeSeparator joins JSON paths of composite key, ie /traceID+/spanID
+	compositeSeparator = "+"
+	// compositeValueSeparator joins values of composite key in waveloc
+	compositeValueSeparator = "\x1f"
+	// maxCompositeKeys bounds key combinations of a composite key lookup
+	maxCompositeKeys = 4096
+)
+
+type keyTuple struct {
+	path   string
+	values []string
+}
+
+// splitComposite splits key declaration into its canonical JSON paths, a plain key has only one
+// path. Separator inside quoted key of a path is part of the key.
+func splitComposite(key string) []string {
+	var paths []string
+	add := func(p string) {
+		if p = strings.TrimSpace(p); len(p) > 0 {
+			paths = append(paths, canonicalPath(p))
+		}
+	}
+
+	quoted, beg := false, 0
+	for i := 0; i < len(key); i++ {
+		switch {
+		case key[i] == '\\' && quoted:
+			i++
+		case key[i] == '"':
+			quoted = !quoted
+		case key[i] == compositeSeparator[0] && !quoted:
+			add(key[beg:i])
+			beg = i + 1
+		}
+	}
+	if beg < len(key) {
+		add(key[beg:])
+	}
+
+	return paths
+}
+
+// canonicalPath quotes keys of JSON path only if they are not plain names, ie /"a" is /a
+func canonicalPath(path string) string {
+	return ssd.JoinPath(ssd.SplitPath(path))
+}
+
+// keyValues returns key values in the format of waveloc, integer is in hex
+func keyValues(op *ssql.Tuple_Key) []string {
+	switch v := op.Key.First.Value.(type) {
+	case *ssql.Operand_Text:
+		return []string{v.Text}
+	case *ssql.Operand_Int:
+		return []string{keyInt(v.Int)}
+	case *ssql.Operand_List:
+		values := make([]string, 0, len(v.List.Text)+len(v.List.Int))
+		values = append(values, v.List.Text...)
+		for _, i := range v.List.Int {
+			values = append(values, keyInt(i))
+		}
+		return values
+	}
+
+	return nil
+}
+
+// keyInt returns integer key value in the format of waveloc, hex of its 64 bits, so negative
+// integer is the same as its two's complement
+func keyInt(v int64) string {
+	return strconv.FormatUint(uint64(v), 16)
+}
+
+// combineKeys returns all combinations of composite key values, nil if there are too many
+func combineKeys(values [][]string) []string {
+	n := 1
+	for _, v := range values {
+		if n *= len(v); n > maxCompositeKeys {
+			return nil
+		}
+	}
+
+	combined := []string{""}
+	for i, v := range values {
+		next := make([]string, 0, len(combined)*len(v))
+		for _, prefix := range combined {
+			for _, x := range v {
+				if i > 0 {
+					x = prefix + compositeValueSeparator + x
+				}
+				next = append(next, x)
+			}
+		}
+		combined = next
+	}
+
+	return combined
+}
+
+// keyTuples looks up blocks having all key tuples, tuples covered by a composite key are looked up
+// by the combinations of their values in one pass.
+func (sel *selector) keyTuples(keys []keyTuple) (map[int64]void, error) {
+	var (
+		set  map[int64]void
+		used = make([]bool, len(keys))
+	)
+
+	for _, composite := range sel.composites {
+		paths := splitComposite(composite)
+		idx := make([]int, 0, len(paths))
+		values := make([][]string, 0, len(paths))
+		for _, p := range paths {
+			for j, k := range keys {
+				if !used[j] && k.path == p {
+					used[j] = true
+					idx = append(idx, j)
+					values = append(values, k.values)
+					break
+				}
+			}
+		}
+
+		combined := combineKeys(values)
+		if len(idx) < len(paths) || combined == nil {
+			for _, j := range idx {
+				used[j] = false
+			}
+			continue
+		}
+
+		wid, err := sel.catalog.SelectKeys(sel.ns, composite, combined)
+		if err != nil {
+			return nil, err
+		}
+		sel.lookup("composite key", composite, combined, wid)
+		ids := toWidSet(wid)
+
+		// blocks indexed before composite key was declared are looked up by its paths
+		declared, err := sel.catalog.SelectKeyWave(sel.ns, composite)
+		if err != nil {
+			return nil, err
+		}
+
+		var rest map[int64]void
+		for _, j := range idx {
+			wid, err := sel.keyLookup(keys[j])
+			if err != nil {
+				return nil, err
+			}
+			rest = intersectWid(rest, wid)
+		}
+		for _, id := range declared {
+			delete(rest, id)
+		}
+		for id := range rest {
+			ids[id] = void{}
+		}
+
+		set = intersectWid(set, ids)
+	}
+
+	for j, k := range keys {
+		if used[j] {
+			continue
+		}
+
+		wid, err := sel.keyLookup(k)
+		if err != nil {
+			return nil, err
+		}
+		set = intersectWid(set, wid)
+	}
+
+	return set, nil
+}
+
+// keyLookup looks up blocks having key values at path. blocks indexed without the key declared
+// can't be looked up, they are kept and rejected by their Bloom filters when searched.
+func (sel *selector) keyLookup(k keyTuple) (map[int64]void, error) {
+	wid, err := sel.catalog.SelectKeys(sel.ns, k.path, k.values)
+	if err != nil {
+		return nil, err
+	}
+	sel.lookup("key", k.path, k.values, wid)
+	ids := toWidSet(wid)
+
+	undeclared, err := sel.undeclared(k.path)
+	if err != nil {
+		return nil, err
+	}
+	sel.lookup("undeclared key", k.path, nil, undeclared)
+	for _, id := range undeclared {
+		ids[id] = void{}
+	}
+
+	return ids, nil
+}
+
+// undeclared returns index blocks of namespace indexed without key path
+func (sel *selector) undeclared(path string) ([]int64, error) {
+	if sel.waves == nil {
+		waves, err := sel.catalog.SelectWaveInfo(sel.ns)
+		if err != nil {
+			return nil, err
+		}
+		sel.waves = make([]int64, len(waves))
+		for i, w := range waves {
+			sel.waves[i] = w.ID
+		}
+	}
+
+	declared, err := sel.catalog.SelectKeyWave(sel.ns, path)
+	if err != nil {
+		return nil, err
+	}
+	keyed := toWidSet(declared)
+
+	wid := make([]int64, 0, len(sel.waves))
+	for _, id := range sel.waves {
+		if _, ok := keyed[id]; !ok {
+			wid = append(wid, id)
+		}
+	}
+
+	return wid, nil
+}
+
+func toWidSet(wid []int64) map[int64]void {
+	set := make(map[int64]void, len(wid))
+	for _, id := range wid {
+		set[id] = void{}
+	}
+	return set
+}
+
+// intersectWid intersects two sets of block ids, nil set doesn't narrow down blocks
+func intersectWid(x, y map[int64]void) map[int64]void {
+	switch {
+	case x == nil:
+		return y
+	case y == nil:
+		return x
+	}
+
+	set := map[int64]void{}
+	for id := range x {
+		if _, ok := y[id]; ok {
+			set[id] = void{}
+		}
+	}
+	return set
+}
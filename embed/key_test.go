@@ -0,0 +1,80 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package embed
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestNegativeKey(t *testing.T) {
+	testStores(t, func(t *testing.T, s *Store) {
+		keys := []string{"/k", "/k+/s"}
+		for i, docs := range []string{`{"ts":100,"k":-5,"s":"a"}`, `{"ts":200,"k":5,"s":"a"}`, `{"ts":300,"k":-5,"s":"b"}`} {
+			f := filepath.Join(t.TempDir(), "docs.json")
+			if err := ioutil.WriteFile(f, []byte(docs), 0644); err != nil {
+				t.Fatal(err)
+			}
+			if err := s.root.Build(f, "/ts", keys); err != nil {
+				t.Fatalf("Build() of block %v error = %v", i+1, err)
+			}
+		}
+
+		tests := []struct {
+			name  string
+			query string
+			// blocks selected by keys
+			wid  []int64
+			want string
+		}{
+			{"negative", `find $k where [$k /k key(-5)]`, []int64{1, 3}, `[{"k":-5},{"k":-5}]`},
+			{"positive", `find $k where [$k /k key(5)]`, []int64{2}, `[{"k":5}]`},
+			{"list", `find $k where [$k /k key(-5, 5)] order-by $k`, []int64{1, 2, 3}, `[{"k":-5},{"k":-5},{"k":5}]`},
+			{"none", `find $k where [$k /k key(-6)]`, nil, `[]`},
+			{"composite", `find $k, $s where [$k /k key(-5)] [$s /s key('a')]`, []int64{1}, `[{"k":-5,"s":"a"}]`},
+			{"composite list", `find $k, $s where [$k /k key(-5, 5)] [$s /s key('b')]`, []int64{3}, `[{"k":-5,"s":"b"}]`},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				stmt, errs := s.Parse(tt.query)
+				if len(errs) > 0 {
+					t.Fatalf("Parse() error = %v", errs)
+				}
+
+				wid, err := selectIndexIds(s.catalog, s.root.name, stmt)
+				if err != nil {
+					t.Fatalf("selectIndexIds() error = %v", err)
+				}
+				sort.Slice(wid, func(i, j int) bool { return wid[i] < wid[j] })
+				if (len(wid) > 0 || len(tt.wid) > 0) && !reflect.DeepEqual(wid, tt.wid) {
+					t.Errorf("selectIndexIds() = %v, want %v", wid, tt.wid)
+				}
+
+				if got := s.Query(context.Background(), stmt); string(got) != tt.want {
+					t.Errorf("Query() = %s, want %s", got, tt.want)
+				}
+			})
+		}
+	})
+}
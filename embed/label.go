@@ -0,0 +1,102 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package embed
+
+import (
+	"errors"
+	"sort"
+	"strings"
+
+	"github.com/chronowave/chronowave/ssql"
+)
+
+// Labels tag index blocks at ingest, queries select index blocks by labels in FROM, as in
+// find $a from env=prod, region=us-east where ...
+type Labels map[string]string
+
+// ParseLabels parses labels in form of key=value
+func ParseLabels(pairs []string) (Labels, error) {
+	labels := Labels{}
+	for _, pair := range pairs {
+		kv := strings.SplitN(pair, "=", 2)
+		if len(kv) != 2 || len(kv[0]) == 0 {
+			return nil, errors.New("invalid label [" + pair + "], expect key=value")
+		}
+		labels[kv[0]] = kv[1]
+	}
+	return labels, nil
+}
+
+// String returns sorted key=value pairs joined by ,
+func (l Labels) String() string {
+	return strings.Join(l.pairs(), ",")
+}
+
+// pairs returns sorted key=value pairs
+func (l Labels) pairs() []string {
+	pairs := make([]string, 0, len(l))
+	for k, v := range l {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return pairs
+}
+
+// match tells whether labels have all, or any if or, of key=value pairs
+func (l Labels) match(pairs []string, or bool) bool {
+	for _, pair := range pairs {
+		kv := strings.SplitN(pair, "=", 2)
+		v, ok := l[kv[0]]
+		ok = ok && v == kv[1]
+		if ok && or {
+			return true
+		} else if !ok && !or {
+			return false
+		}
+	}
+	return !or || len(pairs) == 0
+}
+
+// statementNamespace returns namespace in FROM of statement, the one without =
+func statementNamespace(stmt *ssql.Statement) string {
+	if stmt.From != nil {
+		for _, label := range stmt.From.Labels {
+			if !strings.Contains(label, "=") {
+				return label
+			}
+		}
+	}
+	return ""
+}
+
+// statementLabels returns key=value labels in FROM of statement, and whether any of them is enough
+func statementLabels(stmt *ssql.Statement) ([]string, bool) {
+	if stmt.From == nil {
+		return nil, false
+	}
+
+	var labels []string
+	for _, label := range stmt.From.Labels {
+		if strings.Contains(label, "=") {
+			labels = append(labels, label)
+		}
+	}
+	return labels, stmt.From.Or
+}
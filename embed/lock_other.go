@@ -0,0 +1,42 @@
+//go:build !linux && !darwin && !freebsd && !netbsd && !openbsd
+// +build !linux,!darwin,!freebsd,!netbsd,!openbsd
+
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package embed
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// lockDir opens lock file of data directory, file locking is not supported, so only stores of the
+// same process are excluded from repair
+func lockDir(dir string) (*os.File, error) {
+	return os.OpenFile(filepath.Join(dir, lockFile), os.O_CREATE|os.O_RDONLY, 0644)
+}
+
+func lockExclusive(*os.File, string) error {
+	return nil
+}
+
+func unlockExclusive(*os.File) error {
+	return nil
+}
@@ -0,0 +1,61 @@
+//go:build linux || darwin || freebsd || netbsd || openbsd
+// +build linux darwin freebsd netbsd openbsd
+
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package embed
+
+import (
+	"os"
+	"path/filepath"
+	"syscall"
+)
+
+// lockDir takes shared lock of data directory, it fails if data directory is being repaired
+func lockDir(dir string) (*os.File, error) {
+	f, err := os.OpenFile(filepath.Join(dir, lockFile), os.O_CREATE|os.O_RDONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = syscall.Flock(int(f.Fd()), syscall.LOCK_SH|syscall.LOCK_NB); err != nil {
+		f.Close()
+		return nil, errLocked(dir, "being repaired", err)
+	}
+
+	return f, nil
+}
+
+// lockExclusive converts shared lock to exclusive, it fails if lock is shared by others. the shared
+// lock is taken again on failure, as conversion is not atomic.
+func lockExclusive(f *os.File, dir string) error {
+	err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB)
+	if err != nil {
+		syscall.Flock(int(f.Fd()), syscall.LOCK_SH|syscall.LOCK_NB)
+		return errLocked(dir, "open elsewhere", err)
+	}
+
+	return nil
+}
+
+// unlockExclusive converts exclusive lock back to shared
+func unlockExclusive(f *os.File) error {
+	return syscall.Flock(int(f.Fd()), syscall.LOCK_SH)
+}
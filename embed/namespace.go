@@ -0,0 +1,246 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package embed
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	namespaceDir = "namespace"
+)
+
+var (
+	// namespace name follows NAME of SSQL, so it can be used in FROM without quotes
+	namespacePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.-]*$`)
+
+	// ErrQuota is returned when namespace has no room for new data
+	ErrQuota = errors.New("namespace exceeds quota")
+)
+
+// Namespace is an isolated dataset in store, with its own WAL, index blocks, retention and quota.
+// The default namespace with empty name keeps data in data directory itself, and the others in
+// namespace/{name} under data directory.
+type Namespace struct {
+	store *Store
+	name  string
+	dir   string
+
+	// WAL tails by labels
+	lock  sync.Mutex
+	tails map[string]*tail
+
+	// one compaction at a time
+	compacting sync.Mutex
+	// one change of keys at a time, or backfill of them, taken before compacting
+	keying sync.Mutex
+
+	// accessed atomically
+	usage     int64
+	quota     int64
+	retention int64
+}
+
+// Namespace returns namespace of store, it is created on first use
+func (s *Store) Namespace(name string) (*Namespace, error) {
+	return s.namespace(name, true)
+}
+
+// namespace returns nil if namespace doesn't exist and create is false
+func (s *Store) namespace(name string, create bool) (*Namespace, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if n, ok := s.namespaces[name]; ok {
+		return n, nil
+	}
+
+	if len(name) > 0 && !namespacePattern.MatchString(name) {
+		return nil, errors.New("invalid namespace [" + name + "]")
+	}
+
+	dir := s.dir
+	if len(name) > 0 {
+		dir = filepath.Join(s.dir, namespaceDir, name)
+	}
+
+	if _, err := os.Stat(dir); os.IsNotExist(err) && !create {
+		return nil, nil
+	}
+
+	for _, sub := range []string{wal, index, deadletter} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), os.ModePerm); err != nil {
+			return nil, err
+		}
+	}
+
+	n := &Namespace{store: s, name: name, dir: dir, tails: map[string]*tail{}}
+	if err := n.load(); err != nil {
+		return nil, err
+	}
+
+	s.namespaces[name] = n
+	return n, nil
+}
+
+// Namespaces returns names of namespaces in store, the default namespace is not included
+func (s *Store) Namespaces() ([]string, error) {
+	files, err := ioutil.ReadDir(filepath.Join(s.dir, namespaceDir))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	var names []string
+	for _, f := range files {
+		if f.IsDir() && namespacePattern.MatchString(f.Name()) {
+			names = append(names, f.Name())
+		}
+	}
+	sort.Strings(names)
+
+	return names, nil
+}
+
+// Retain purges data of every namespace beyond its retention
+func (s *Store) Retain(ctx context.Context) error {
+	names, err := s.Namespaces()
+	if err != nil {
+		return err
+	}
+
+	for _, name := range append([]string{""}, names...) {
+		n, err := s.Namespace(name)
+		if err != nil {
+			return err
+		}
+		if err = n.Retain(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// load reads quota and retention settings and usage of namespace from catalog
+func (n *Namespace) load() error {
+	c := n.store.catalog
+
+	quota, err := c.SelectSetting("quota/" + n.name)
+	if err != nil {
+		return err
+	}
+	if len(quota) > 0 {
+		if n.quota, err = strconv.ParseInt(quota, 10, 64); err != nil {
+			return fmt.Errorf("invalid quota [%v] of namespace [%v]: %v", quota, n.name, err)
+		}
+	}
+
+	retention, err := c.SelectSetting("retention/" + n.name)
+	if err != nil {
+		return err
+	}
+	if len(retention) > 0 {
+		d, err := time.ParseDuration(retention)
+		if err != nil {
+			return fmt.Errorf("invalid retention [%v] of namespace [%v]: %v", retention, n.name, err)
+		}
+		n.retention = int64(d)
+	}
+
+	n.usage, err = c.SelectUsage(n.name)
+	return err
+}
+
+// Name returns name of namespace, empty for the default namespace
+func (n *Namespace) Name() string {
+	return n.name
+}
+
+// Usage returns total size of index blocks of namespace in bytes
+func (n *Namespace) Usage() int64 {
+	return atomic.LoadInt64(&n.usage)
+}
+
+// Quota returns max total size of index blocks of namespace in bytes, 0 is unlimited
+func (n *Namespace) Quota() int64 {
+	return atomic.LoadInt64(&n.quota)
+}
+
+// SetQuota persists quota of namespace, 0 is unlimited
+func (n *Namespace) SetQuota(bytes int64) error {
+	if bytes < 0 {
+		return fmt.Errorf("invalid quota %v", bytes)
+	}
+	if err := n.store.catalog.InsertSetting("quota/"+n.name, strconv.FormatInt(bytes, 10)); err != nil {
+		return err
+	}
+	atomic.StoreInt64(&n.quota, bytes)
+	return nil
+}
+
+// Retention returns how long data of namespace is kept, 0 keeps data until purged
+func (n *Namespace) Retention() time.Duration {
+	return time.Duration(atomic.LoadInt64(&n.retention))
+}
+
+// SetRetention persists retention of namespace, 0 keeps data until purged
+func (n *Namespace) SetRetention(d time.Duration) error {
+	if d < 0 {
+		return fmt.Errorf("invalid retention %v", d)
+	}
+	if err := n.store.catalog.InsertSetting("retention/"+n.name, d.String()); err != nil {
+		return err
+	}
+	atomic.StoreInt64(&n.retention, int64(d))
+	return nil
+}
+
+// Retain purges data of namespace beyond its retention
+func (n *Namespace) Retain(ctx context.Context) error {
+	d := n.Retention()
+	if d == 0 {
+		return nil
+	}
+
+	return n.Purge(ctx, time.Now().Add(-d))
+}
+
+// exceeds tells whether namespace has no room for size bytes
+func (n *Namespace) exceeds(size int64) bool {
+	quota := n.Quota()
+	return quota > 0 && n.Usage()+size > quota
+}
+
+// blockPath returns path of index block file
+func (n *Namespace) blockPath(wid int64) string {
+	name := fmt.Sprintf("%016X", wid)
+	return filepath.Join(n.dir, index, name[:4], name[8:12], name)
+}
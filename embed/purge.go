@@ -2,24 +2,49 @@ package embed
 
 import (
 	"context"
-	"fmt"
 	"os"
-	"path/filepath"
+	"sync/atomic"
 	"time"
 
 	"github.com/rs/zerolog/log"
 )
 
-// Purge purge data before time
+// Purge purge data before time in the default store
 func Purge(ctx context.Context, time time.Time) error {
-	wid, err := selectWidBeforeTime(time)
+	return std.Purge(ctx, time)
+}
+
+// Purge purge data before time in every namespace
+func (s *Store) Purge(ctx context.Context, time time.Time) error {
+	names, err := s.Namespaces()
 	if err != nil {
 		return err
 	}
 
+	for _, name := range append([]string{""}, names...) {
+		n, err := s.Namespace(name)
+		if err != nil {
+			return err
+		}
+		if err = n.Purge(ctx, time); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Purge purge data of namespace before time
+func (n *Namespace) Purge(ctx context.Context, time time.Time) error {
+	c := n.store.catalog
+	wid, err := c.SelectWidBeforeTime(n.name, time)
+	if err != nil || len(wid) == 0 {
+		return err
+	}
+
 	for _, id := range wid {
-		name := fmt.Sprintf("%016X", id)
-		path := filepath.Join(Directory, index, name[:4], name[8:12], name)
+		path := n.blockPath(id)
+		n.store.cache.evict(path)
 		if err = os.Remove(path); err != nil {
 			if !os.IsNotExist(err) {
 				log.Info().Msgf("purging %v has err: %v", path, err)
@@ -27,5 +52,15 @@ func Purge(ctx context.Context, time time.Time) error {
 		}
 	}
 
-	return purgeBeforeTime(ctx, time)
+	if err = c.PurgeBeforeTime(ctx, n.name, time); err != nil {
+		return err
+	}
+
+	usage, err := c.SelectUsage(n.name)
+	if err != nil {
+		return err
+	}
+	atomic.StoreInt64(&n.usage, usage)
+
+	return nil
 }
@@ -21,17 +21,16 @@ package embed
 
 import (
 	"context"
-	"fmt"
-	"io/ioutil"
+	"encoding/json"
 	"path/filepath"
 	"runtime"
 	"runtime/debug"
+	"sort"
 	"strconv"
 
 	"github.com/chronowave/chronowave/ssd"
 	"github.com/chronowave/chronowave/ssd/codec"
 	ssdexec "github.com/chronowave/chronowave/ssd/exec"
-	ssdidx "github.com/chronowave/chronowave/ssd/index"
 	"github.com/chronowave/chronowave/ssd/operator"
 	"github.com/chronowave/chronowave/ssql"
 
@@ -41,38 +40,136 @@ import (
 type void struct{}
 
 var (
-	empty    = []byte("[]")
-	walIndex *ssd.IndexedBlock
-	guard    = make(chan void, runtime.NumCPU())
+	empty = []byte("[]")
 )
 
+// Query executes statement in the default store
 func Query(ctx context.Context, stmt *ssql.Statement) []byte {
+	return std.Query(ctx, stmt)
+}
+
+// Query executes statement in namespace of FROM, or the default namespace without FROM
+func (s *Store) Query(ctx context.Context, stmt *ssql.Statement) []byte {
+	n, err := s.namespace(statementNamespace(stmt), false)
+	if err != nil {
+		log.Err(err).Msg("query namespace")
+		return empty
+	} else if n == nil {
+		return empty
+	}
+
+	return n.query(ctx, stmt)
+}
+
+// query executes statement against WAL and index blocks selected by catalog, returns JSON array
+func (n *Namespace) query(ctx context.Context, stmt *ssql.Statement) []byte {
 	defer func() {
 		if r := recover(); r != nil {
 			log.Error().Msgf("runtime error in query %v, trace: %v", r, string(debug.Stack()))
 		}
 	}()
 
-	wid, err := selectIndexIds(stmt)
+	if stmt.Explain {
+		return n.explain(ctx, stmt)
+	}
+
+	wid, err := selectIndexIds(n.store.catalog, n.name, stmt)
 	if err != nil {
 		log.Err(err).Msg("query for ids")
 	}
 
+	if operator.IsAggregate(stmt) {
+		return n.aggregate(ctx, stmt, wid)
+	}
+
+	rss, _ := n.search(ctx, stmt, wid, false, nil)
+	if len(rss) == 0 {
+		return empty
+	}
+
+	rs := operator.Merge(rss)
+
+	if len(stmt.OrderBy) > 0 {
+		operator.OrderBy(rs, stmt)
+	}
+
+	return codec.MarshalResultSet(rs, stmt.GetLimit())
+}
+
+// aggregate executes aggregate statement, result sets of WAL and index blocks are merged by groups
+// as they are searched, groups beyond Options.Groups are spilled to disk
+func (n *Namespace) aggregate(ctx context.Context, stmt *ssql.Statement, wid []int64) []byte {
+	agg := n.store.aggregation(stmt)
+	defer agg.Close()
+
+	n.search(ctx, stmt, wid, false, agg)
+
+	limit := stmt.GetLimit()
+	if len(stmt.OrderBy) > 0 {
+		limit = 0
+	}
+
+	rs, err := agg.Result(limit)
+	if err != nil {
+		log.Err(err).Msg("aggregate result sets")
+		return empty
+	}
+
+	if len(stmt.OrderBy) > 0 {
+		operator.OrderBy(rs, stmt)
+	}
+
+	return codec.MarshalResultSet(rs, stmt.GetLimit())
+}
+
+// aggregation merges result sets of aggregate statement, spilling groups under data directory
+func (s *Store) aggregation(stmt *ssql.Statement) *operator.Aggregation {
+	return operator.NewAggregation(stmt, s.groups, filepath.Join(s.dir, spill))
+}
+
+// search executes statement against WAL and index blocks of ids in parallel, returns result sets
+// of those having result. If agg is not nil, result sets are added to it as they are done instead.
+// Evaluation of WAL and blocks is traced in order if traced.
+func (n *Namespace) search(ctx context.Context, stmt *ssql.Statement, wid []int64, traced bool, agg *operator.Aggregation) ([]*ssd.ResultSet, []SearchTrace) {
 	files := make([]string, len(wid))
 	for i, id := range wid {
-		name := fmt.Sprintf("%016X", id)
-		files[i] = filepath.Join(Directory, index, name[:4], name[8:12], name)
+		files[i] = n.blockPath(id)
 	}
 
-	rss := make([]*ssd.ResultSet, len(wid)+1)
-	done := make(chan void, len(rss))
-	queryWAL(ctx, stmt, 0, rss, done)
-	for i, f := range files {
-		queryIndexFile(ctx, f, stmt, i+1, rss, done)
+	tails := n.matchTails(statementLabels(stmt))
+	rss := make([]*ssd.ResultSet, len(tails)+len(wid))
+	var traces []*ssdexec.Trace
+	if traced {
+		traces = make([]*ssdexec.Trace, len(rss))
 	}
 
+	done := make(chan int, len(rss))
+	go func() {
+		for i, t := range tails {
+			n.queryWAL(ctx, t, stmt, i, rss, traces, done)
+		}
+		for i, f := range files {
+			n.store.queryIndexFile(ctx, f, stmt, len(tails)+i, rss, traces, done)
+		}
+	}()
+
 	for range rss {
-		<-done
+		i := <-done
+		if i >= 0 && agg != nil && rss[i] != nil {
+			if err := agg.Add(rss[i]); err != nil {
+				log.Err(err).Msg("aggregate result set")
+			}
+			rss[i] = nil
+		}
+	}
+
+	var searched []SearchTrace
+	for i, trace := range traces {
+		st := SearchTrace{Source: wal, Trace: trace, Skipped: trace == nil}
+		if i >= len(tails) {
+			st.Source = files[i-len(tails)]
+		}
+		searched = append(searched, st)
 	}
 
 	j := 0
@@ -83,96 +180,263 @@ func Query(ctx context.Context, stmt *ssql.Statement) []byte {
 		}
 	}
 
-	if j == 0 {
-		return empty
+	return rss[:j], searched
+}
+
+// selector selects index blocks of namespace from catalog
+type selector struct {
+	catalog Catalog
+	ns      string
+	// composite keys declared in namespace
+	composites []string
+	// waves are all index blocks of namespace, loaded for keys not declared in some of them
+	waves []int64
+	// lookups are catalog lookups made to select blocks
+	lookups []Lookup
+}
+
+// Lookup is a catalog lookup selecting index blocks
+type Lookup struct {
+	// Kind is one of timeframe, key, undeclared key, which keeps blocks indexed without the key,
+	// composite key, label and stats, which skips blocks by their stats
+	Kind   string   `json:"kind"`
+	Path   string   `json:"path,omitempty"`
+	Values []string `json:"values,omitempty"`
+	// Blocks is number of index blocks found
+	Blocks int `json:"blocks"`
+}
+
+// selectIndexIds narrows down index blocks of namespace by labels in FROM, timeframe and key tuples,
+// top level expressions intersect and OR expressions union their block ids. Empty ids mean only
+// WAL is searched.
+func selectIndexIds(c Catalog, ns string, stmt *ssql.Statement) ([]int64, error) {
+	sel, err := newSelector(c, ns)
+	if err != nil {
+		return []int64{}, err
 	}
 
-	rs := operator.Merge(rss[:j])
+	return sel.selectIds(stmt)
+}
 
-	if len(stmt.OrderBy) > 0 {
-		operator.OrderBy(rs, stmt)
+func newSelector(c Catalog, ns string) (*selector, error) {
+	paths, err := c.SelectKeyPath(ns)
+	if err != nil {
+		return nil, err
 	}
 
-	return codec.MarshalResultSet(rs, stmt.GetLimit())
+	sel := &selector{catalog: c, ns: ns}
+	for _, path := range paths {
+		if len(splitComposite(path)) > 1 {
+			sel.composites = append(sel.composites, path)
+		}
+	}
+
+	return sel, nil
+}
+
+func (sel *selector) selectIds(stmt *ssql.Statement) ([]int64, error) {
+	set, err := sel.and(stmt.Where)
+	if err != nil {
+		return []int64{}, err
+	}
+
+	if labels, or := statementLabels(stmt); len(labels) > 0 {
+		ids, err := sel.labels(labels, or)
+		if err != nil {
+			return []int64{}, err
+		}
+		set = intersectWid(set, ids)
+	}
+
+	if set == nil {
+		return []int64{}, nil
+	}
+
+	wid := make([]int64, 0, len(set))
+	for id := range set {
+		wid = append(wid, id)
+	}
+	sort.Slice(wid, func(i, j int) bool { return wid[i] < wid[j] })
+
+	return sel.skipByStats(stmt, wid), nil
+}
+
+// skipByStats drops index blocks whose stats prove WHERE can't match, blocks without stats are
+// kept, so are all of blocks if stats can't be read
+func (sel *selector) skipByStats(stmt *ssql.Statement, wid []int64) []int64 {
+	if len(wid) == 0 {
+		return wid
+	}
+
+	stats, err := sel.catalog.SelectWaveStats(wid)
+	if err != nil {
+		log.Err(err).Msg("select block stats")
+		return wid
+	}
+
+	kept := make([]int64, 0, len(wid))
+	for _, id := range wid {
+		if s, ok := stats[id]; ok {
+			summary := &ssdexec.Stats{}
+			if err = json.Unmarshal([]byte(s), summary); err == nil && summary.Excludes(stmt) {
+				continue
+			}
+		}
+		kept = append(kept, id)
+	}
+	sel.lookup("stats", "", nil, kept)
+
+	return kept
+}
+
+// lookup records catalog lookup
+func (sel *selector) lookup(kind, path string, values []string, wid []int64) {
+	sel.lookups = append(sel.lookups, Lookup{Kind: kind, Path: path, Values: values, Blocks: len(wid)})
 }
 
-func selectIndexIds(stmt *ssql.Statement) ([]int64, error) {
-	for _, expr := range stmt.Where {
-		if expr.GetTuple() != nil && expr.GetTuple().GetPredicate() != nil {
-			pred := expr.GetTuple().GetPredicate()
-			switch pred.(type) {
+// and returns nil when none of expressions narrows down blocks
+func (sel *selector) and(exprs []*ssql.Expr) (map[int64]void, error) {
+	var (
+		set  map[int64]void
+		keys []keyTuple
+	)
+
+	for _, expr := range exprs {
+		switch field := expr.Field.(type) {
+		case *ssql.Expr_Or:
+			ids, err := sel.or(field.Or.Expr)
+			if err != nil {
+				return nil, err
+			}
+			set = intersectWid(set, ids)
+		case *ssql.Expr_Tuple:
+			switch pred := field.Tuple.Predicate.(type) {
 			case *ssql.Tuple_Timeframe:
-				beg := pred.(*ssql.Tuple_Timeframe).Timeframe.First.Value.(*ssql.Operand_Int).Int
-				end := pred.(*ssql.Tuple_Timeframe).Timeframe.Second.Value.(*ssql.Operand_Int).Int
-				return selectWave(beg, end)
-			case *ssql.Tuple_Key:
-				var key string
-				switch pred.(*ssql.Tuple_Key).Key.First.Value.(type) {
-				case *ssql.Operand_Int:
-					key = strconv.FormatInt(pred.(*ssql.Tuple_Key).Key.First.Value.(*ssql.Operand_Int).Int, 16)
-				case *ssql.Operand_Text:
-					key = pred.(*ssql.Tuple_Key).Key.First.Value.(*ssql.Operand_Text).Text
+				beg := pred.Timeframe.First.Value.(*ssql.Operand_Int).Int
+				end := pred.Timeframe.Second.Value.(*ssql.Operand_Int).Int
+				wid, err := sel.catalog.SelectWave(sel.ns, beg, end)
+				if err != nil {
+					return nil, err
 				}
-				return selectKey(expr.GetTuple().GetPath(), key)
+				sel.lookup("timeframe", field.Tuple.Path, []string{strconv.FormatInt(beg, 10), strconv.FormatInt(end, 10)}, wid)
+				set = intersectWid(set, toWidSet(wid))
+			case *ssql.Tuple_Key:
+				keys = append(keys, keyTuple{path: canonicalPath(field.Tuple.Path), values: keyValues(pred)})
+			}
+		}
+	}
+
+	ids, err := sel.keyTuples(keys)
+	if err != nil {
+		return nil, err
+	}
+
+	return intersectWid(set, ids), nil
+}
+
+// or returns nil when any of expressions doesn't narrow down blocks
+func (sel *selector) or(exprs []*ssql.Expr) (map[int64]void, error) {
+	set := map[int64]void{}
+	for _, expr := range exprs {
+		ids, err := sel.and([]*ssql.Expr{expr})
+		if err != nil || ids == nil {
+			return nil, err
+		}
+
+		for id := range ids {
+			set[id] = void{}
+		}
+	}
+
+	return set, nil
+}
+
+// labels returns index blocks tagged with all of labels, or any of them if or
+func (sel *selector) labels(labels []string, or bool) (map[int64]void, error) {
+	var set map[int64]void
+	if or {
+		set = map[int64]void{}
+	}
+
+	for _, label := range labels {
+		wid, err := sel.catalog.SelectLabel(sel.ns, label)
+		if err != nil {
+			return nil, err
+		}
+		sel.lookup("label", "", []string{label}, wid)
+
+		if or {
+			for _, id := range wid {
+				set[id] = void{}
 			}
+		} else {
+			set = intersectWid(set, toWidSet(wid))
 		}
 	}
 
-	return []int64{}, nil
+	return set, nil
 }
 
-func queryIndexFile(ctx context.Context, f string, stmt *ssql.Statement, i int, rss []*ssd.ResultSet, done chan void) {
+func (s *Store) queryIndexFile(ctx context.Context, f string, stmt *ssql.Statement, i int, rss []*ssd.ResultSet, traces []*ssdexec.Trace, done chan int) {
 	select {
-	case guard <- void{}:
+	case s.guard <- void{}:
 		go func() {
 			runtime.LockOSThread()
 			defer func() {
 				runtime.UnlockOSThread()
-				<-guard
-				done <- void{}
+				<-s.guard
+				done <- i
 				if err := recover(); err != nil {
 					log.Error().Msgf("worker has error '%v', trace %v", err, string(debug.Stack()))
 				}
 			}()
 
-			data, err := ioutil.ReadFile(f)
-			if err != nil {
-				log.Info().Msgf("skipped index file %v due to %v\n", f, err)
+			if bloom, err := s.cache.bloom(f); err == nil && ssdexec.BloomExcludes(bloom, stmt) {
+				if traces != nil {
+					traces[i] = &ssdexec.Trace{Rejected: true}
+				}
 				return
 			}
 
-			idx, err := ssdidx.DecodeIndexBlock(data)
+			b, err := s.cache.acquire(f)
 			if err != nil {
-				log.Info().Msgf("skipped index file %v, decode err %v\n", f, err)
+				log.Info().Msgf("skipped index file %v due to %v\n", f, err)
 				return
 			}
+			defer s.cache.release(b)
 
-			if rs := ssdexec.Exec(idx, stmt); rs != nil {
-				rss[i] = rs
+			if traces != nil {
+				rss[i], traces[i] = ssdexec.Explain(b.block, stmt)
+			} else if rs := ssdexec.Exec(b.block, stmt); rs != nil {
+				rss[i] = restoreTimestamps(rs)
 			}
 		}()
 	case <-ctx.Done():
+		done <- -1
 	}
 }
 
-func queryWAL(ctx context.Context, stmt *ssql.Statement, i int, rss []*ssd.ResultSet, done chan void) {
+func (n *Namespace) queryWAL(ctx context.Context, t *tail, stmt *ssql.Statement, i int, rss []*ssd.ResultSet, traces []*ssdexec.Trace, done chan int) {
 	select {
-	case guard <- void{}:
+	case n.store.guard <- void{}:
 		go func() {
 			runtime.LockOSThread()
 			defer func() {
 				runtime.UnlockOSThread()
-				<-guard
-				done <- void{}
+				<-n.store.guard
+				done <- i
 				if err := recover(); err != nil {
 					log.Error().Msgf("worker has error '%v', trace %v", err, string(debug.Stack()))
 				}
 			}()
-			indexed := walIndex
-			if indexed != nil {
-				rss[i] = ssdexec.Exec(indexed, stmt)
+			indexed := t.index
+			if indexed != nil && traces != nil {
+				rss[i], traces[i] = ssdexec.Explain(indexed, stmt)
+			} else if indexed != nil {
+				rss[i] = restoreTimestamps(ssdexec.Exec(indexed, stmt))
 			}
 		}()
 	case <-ctx.Done():
+		done <- -1
 	}
 }
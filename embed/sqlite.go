@@ -1,3 +1,6 @@
+//go:build cgo
+// +build cgo
+
 /*
  *  Copyright 2020 ChronoWave Authors
  *
@@ -22,22 +25,30 @@ package embed
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"path/filepath"
+	"strings"
 	"time"
 
 	_ "github.com/mattn/go-sqlite3"
 )
 
-var (
-	db  *sql.DB
-	seq int64
+// maxSqlVars stays below default SQLITE_MAX_VARIABLE_NUMBER of 999
+const maxSqlVars = 500
+
+const (
+	defaultCatalog = SqliteCatalog
+	sqliteFile     = "db"
 )
 
-func openSqlite(dir string) error {
-	var err error
-	db, err = sql.Open("sqlite3", filepath.Join(dir, "db"))
+type sqliteCatalog struct {
+	db *sql.DB
+}
+
+func openSqliteCatalog(dir string) (Catalog, error) {
+	db, err := sql.Open("sqlite3", filepath.Join(dir, sqliteFile))
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	create := []string{
@@ -56,35 +67,95 @@ func openSqlite(dir string) error {
            created TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
            PRIMARY KEY (path, key, wid)
          ) WITHOUT ROWID`,
+		`CREATE TABLE IF NOT EXISTS wavekey
+         (
+           path TEXT,
+           wid INTEGER,
+           PRIMARY KEY (path, wid)
+         ) WITHOUT ROWID`,
+		`CREATE TABLE IF NOT EXISTS wavelabel
+         (
+           label TEXT,
+           wid INTEGER,
+           PRIMARY KEY (label, wid)
+         ) WITHOUT ROWID`,
+		`CREATE TABLE IF NOT EXISTS wavestats
+         (
+           wid INTEGER PRIMARY KEY,
+           stats TEXT NOT NULL
+         )`,
+		`CREATE TABLE IF NOT EXISTS setting
+         (
+           name TEXT PRIMARY KEY,
+           value TEXT NOT NULL,
+           created TIMESTAMP DEFAULT CURRENT_TIMESTAMP
+         )`,
 	}
 
 	for _, qry := range create {
 		_, err = db.Exec(qry)
 		if err != nil {
-			return err
+			db.Close()
+			return nil, err
 		}
 	}
 
+	// columns added after wave table was created
+	columns := map[string]string{
+		"ns":   `ALTER TABLE wave ADD COLUMN ns TEXT NOT NULL DEFAULT ''`,
+		"size": `ALTER TABLE wave ADD COLUMN size INTEGER NOT NULL DEFAULT 0`,
+	}
+	if err = addColumns(db, "wave", columns); err != nil {
+		db.Close()
+		return nil, err
+	}
+
 	indices := []string{
 		`CREATE INDEX IF NOT EXISTS wave_time ON wave (beg, end)`,
+		`CREATE INDEX IF NOT EXISTS wave_ns ON wave (ns, created)`,
 	}
 
 	for _, qry := range indices {
 		_, err = db.Exec(qry)
 		if err != nil {
+			db.Close()
+			return nil, err
+		}
+	}
+
+	return &sqliteCatalog{db: db}, nil
+}
+
+// addColumns adds missing columns of table
+func addColumns(db *sql.DB, table string, columns map[string]string) error {
+	rows, err := db.Query(`SELECT name FROM pragma_table_info(?)`, table)
+	if err != nil {
+		return err
+	}
+
+	var name string
+	for rows.Next() {
+		if err = rows.Scan(&name); err == nil {
+			delete(columns, name)
+		}
+	}
+	rows.Close()
+
+	for _, qry := range columns {
+		if _, err = db.Exec(qry); err != nil {
 			return err
 		}
 	}
 
-	return resetSeq()
+	return nil
 }
 
-func closeSqlite() error {
-	return db.Close()
+func (c *sqliteCatalog) Close() error {
+	return c.db.Close()
 }
 
-func insertWaveLoc(path string, keys []string, wid int64) error {
-	tx, err := db.Begin()
+func (c *sqliteCatalog) InsertWaveLoc(path string, keys []string, wid int64) error {
+	tx, err := c.db.Begin()
 	if err != nil {
 		return err
 	}
@@ -93,6 +164,7 @@ func insertWaveLoc(path string, keys []string, wid int64) error {
 
 	for _, key := range keys {
 		if _, err = tx.Exec(qry, path, key, wid); err != nil {
+			tx.Rollback()
 			return err
 		}
 	}
@@ -100,78 +172,337 @@ func insertWaveLoc(path string, keys []string, wid int64) error {
 	return tx.Commit()
 }
 
-func insertWave(wid, beg, end int64) error {
-	qry := `INSERT INTO wave (wid, beg, end) VALUES (?, ?, ?)`
-	_, err := db.Exec(qry, wid, beg, end)
+func (c *sqliteCatalog) InsertWaveKey(paths []string, wid int64) error {
+	qry := `INSERT INTO wavekey (path, wid) VALUES (?, ?) ON CONFLICT DO NOTHING`
+	for _, path := range paths {
+		if _, err := c.db.Exec(qry, path, wid); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *sqliteCatalog) InsertWaveLabel(labels []string, wid int64) error {
+	qry := `INSERT INTO wavelabel (label, wid) VALUES (?, ?) ON CONFLICT DO NOTHING`
+	for _, label := range labels {
+		if _, err := c.db.Exec(qry, label, wid); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *sqliteCatalog) InsertWaveStats(stats string, wid int64) error {
+	qry := `INSERT INTO wavestats (wid, stats) VALUES (?, ?) ON CONFLICT (wid) DO UPDATE SET stats = excluded.stats`
+	_, err := c.db.Exec(qry, wid, stats)
 	return err
 }
 
-func resetSeq() error {
-	rs, err := db.Query(`SELECT MAX(wid) FROM wave`)
-	if err != nil {
-		return err
+func (c *sqliteCatalog) InsertWave(w Wave) error {
+	qry := `INSERT INTO wave (wid, ns, beg, end, size) VALUES (?, ?, ?, ?, ?)`
+	_, err := c.db.Exec(qry, w.ID, w.Namespace, w.Beg, w.End, w.Size)
+	return err
+}
+
+func (c *sqliteCatalog) InsertSetting(name, value string) error {
+	qry := `INSERT INTO setting (name, value) VALUES (?, ?) ON CONFLICT (name) DO UPDATE SET value = excluded.value`
+	_, err := c.db.Exec(qry, name, value)
+	return err
+}
+
+func (c *sqliteCatalog) SelectSetting(name string) (string, error) {
+	var value string
+	err := c.db.QueryRow(`SELECT value FROM setting WHERE name = ?`, name).Scan(&value)
+	if err == sql.ErrNoRows {
+		return "", nil
 	}
-	defer rs.Close()
 
-	if rs.Next() {
-		var id sql.NullInt64
-		if err = rs.Scan(&id); err != nil {
-			return err
+	return value, err
+}
+
+func (c *sqliteCatalog) MaxWid() (int64, error) {
+	var id sql.NullInt64
+	if err := c.db.QueryRow(`SELECT MAX(wid) FROM wave`).Scan(&id); err != nil {
+		return 0, err
+	}
+
+	return id.Int64, nil
+}
+
+func (c *sqliteCatalog) SelectWave(ns string, beg, end int64) ([]int64, error) {
+	qry := `SELECT wid FROM wave WHERE ns = ? AND beg <= ? AND end >= ?`
+	return c.selectWid(qry, ns, end, beg)
+}
+
+func (c *sqliteCatalog) SelectKeys(ns, path string, keys []string) ([]int64, error) {
+	var wid []int64
+	for len(keys) > 0 {
+		n := len(keys)
+		if n > maxSqlVars {
+			n = maxSqlVars
 		}
 
-		if id.Valid {
-			seq = id.Int64
+		args := make([]interface{}, n+2)
+		args[0], args[1] = ns, path
+		for i, k := range keys[:n] {
+			args[i+2] = k
 		}
+		keys = keys[n:]
+
+		qry := `SELECT DISTINCT l.wid FROM waveloc l JOIN wave w ON l.wid = w.wid
+                WHERE w.ns = ? AND l.path = ? AND l.key IN (?` + strings.Repeat(", ?", n-1) + `)`
+		ids, err := c.selectWid(qry, args...)
+		if err != nil {
+			return nil, err
+		}
+		wid = append(wid, ids...)
 	}
 
-	return nil
+	return wid, nil
+}
+
+func (c *sqliteCatalog) SelectKeyPath(ns string) ([]string, error) {
+	qry := `SELECT DISTINCT k.path FROM wavekey k JOIN wave w ON k.wid = w.wid WHERE w.ns = ?`
+	return c.selectText(qry, ns)
+}
+
+func (c *sqliteCatalog) SelectKeyWave(ns, path string) ([]int64, error) {
+	qry := `SELECT k.wid FROM wavekey k JOIN wave w ON k.wid = w.wid WHERE w.ns = ? AND k.path = ?`
+	return c.selectWid(qry, ns, path)
+}
+
+func (c *sqliteCatalog) SelectLabels(ns string) ([]string, error) {
+	qry := `SELECT DISTINCT l.label FROM wavelabel l JOIN wave w ON l.wid = w.wid WHERE w.ns = ?`
+	return c.selectText(qry, ns)
+}
+
+func (c *sqliteCatalog) SelectLabel(ns, label string) ([]int64, error) {
+	qry := `SELECT l.wid FROM wavelabel l JOIN wave w ON l.wid = w.wid WHERE w.ns = ? AND l.label = ?`
+	return c.selectWid(qry, ns, label)
+}
+
+func (c *sqliteCatalog) SelectWaveStats(wid []int64) (map[int64]string, error) {
+	stats := map[int64]string{}
+	for len(wid) > 0 {
+		n := len(wid)
+		if n > maxSqlVars {
+			n = maxSqlVars
+		}
+
+		args := make([]interface{}, n)
+		for i, id := range wid[:n] {
+			args[i] = id
+		}
+		wid = wid[n:]
+
+		rows, err := c.db.Query(`SELECT wid, stats FROM wavestats WHERE wid IN (?`+strings.Repeat(", ?", n-1)+`)`, args...)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			id int64
+			s  string
+		)
+		for rows.Next() {
+			if err = rows.Scan(&id, &s); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			stats[id] = s
+		}
+		err = rows.Err()
+		rows.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return stats, nil
+}
+
+func (c *sqliteCatalog) SelectWidBeforeTime(ns string, time time.Time) ([]int64, error) {
+	return c.selectWid(`SELECT wid FROM wave WHERE ns = ? AND created < ?`, ns, time)
+}
+
+func (c *sqliteCatalog) SelectUsage(ns string) (int64, error) {
+	var size sql.NullInt64
+	if err := c.db.QueryRow(`SELECT SUM(size) FROM wave WHERE ns = ?`, ns).Scan(&size); err != nil {
+		return 0, err
+	}
+
+	return size.Int64, nil
 }
 
-func selectWave(beg, end int64) ([]int64, error) {
-	qry := `SELECT wid FROM wave WHERE beg BETWEEN ? AND ? OR end BETWEEN ? AND ?`
-	rows, err := db.Query(qry, beg, end, beg, end)
+func (c *sqliteCatalog) SelectNamespace() ([]string, error) {
+	return c.selectText(`SELECT DISTINCT ns FROM wave`)
+}
+
+func (c *sqliteCatalog) SelectWaveInfo(ns string) ([]Wave, error) {
+	rows, err := c.db.Query(`SELECT wid, beg, end, size FROM wave WHERE ns = ? ORDER BY wid`, ns)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var (
-		tmp sql.NullInt64
-		wid []int64
-	)
+	var waves []Wave
 	for rows.Next() {
-		if err = rows.Scan(&tmp); err == nil && tmp.Valid {
-			wid = append(wid, tmp.Int64)
+		var (
+			w    = Wave{Namespace: ns}
+			size sql.NullInt64
+		)
+		if err = rows.Scan(&w.ID, &w.Beg, &w.End, &size); err != nil {
+			return nil, err
 		}
+		w.Size = size.Int64
+		waves = append(waves, w)
 	}
 
-	return wid, nil
+	return waves, rows.Err()
 }
 
-func selectKey(path, key string) ([]int64, error) {
-	qry := `SELECT DISTINCT wid FROM waveloc WHERE path = ? AND key = ?`
-	rows, err := db.Query(qry, path, key)
+func (c *sqliteCatalog) ReplaceWave(w Wave, wid []int64) error {
+	if len(wid) == 0 {
+		return c.InsertWave(w)
+	}
+
+	newest := wid[0]
+	for _, id := range wid {
+		if id > newest {
+			newest = id
+		}
+	}
+
+	tx, err := c.db.Begin()
 	if err != nil {
-		return nil, err
+		return err
 	}
-	defer rows.Close()
 
-	var (
-		tmp sql.NullInt64
-		wid []int64
-	)
-	for rows.Next() {
-		if err = rows.Scan(&tmp); err == nil && tmp.Valid {
-			wid = append(wid, tmp.Int64)
+	qry := `INSERT INTO wave (wid, ns, beg, end, size, created) SELECT ?, ?, ?, ?, ?, created FROM wave WHERE wid = ?`
+	res, err := tx.Exec(qry, w.ID, w.Namespace, w.Beg, w.End, w.Size, newest)
+	if err == nil {
+		err = affected(res, newest)
+	}
+
+	remove := []string{
+		`DELETE FROM waveloc WHERE wid = ?`,
+		`DELETE FROM wavekey WHERE wid = ?`,
+		`DELETE FROM wavelabel WHERE wid = ?`,
+		`DELETE FROM wavestats WHERE wid = ?`,
+	}
+	for _, id := range wid {
+		if err == nil {
+			res, err = tx.Exec(`DELETE FROM wave WHERE wid = ? AND ns = ?`, id, w.Namespace)
+		}
+		if err == nil {
+			err = affected(res, id)
+		}
+		for _, qry := range remove {
+			if err == nil {
+				_, err = tx.Exec(qry, id)
+			}
 		}
 	}
 
-	return wid, nil
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// affected returns error if statement changes no row of index block wid
+func affected(res sql.Result, wid int64) error {
+	n, err := res.RowsAffected()
+	if err == nil && n == 0 {
+		err = fmt.Errorf("wave %v no longer exists", wid)
+	}
+	return err
+}
+
+func (c *sqliteCatalog) SelectDanglingWid() ([]int64, error) {
+	qry := `SELECT wid FROM waveloc WHERE wid NOT IN (SELECT wid FROM wave)
+            UNION SELECT wid FROM wavekey WHERE wid NOT IN (SELECT wid FROM wave)
+            UNION SELECT wid FROM wavelabel WHERE wid NOT IN (SELECT wid FROM wave)
+            UNION SELECT wid FROM wavestats WHERE wid NOT IN (SELECT wid FROM wave)
+            ORDER BY wid`
+	return c.selectWid(qry)
+}
+
+func (c *sqliteCatalog) RemoveWave(wid []int64) error {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	remove := []string{
+		`DELETE FROM waveloc WHERE wid = ?`,
+		`DELETE FROM wavekey WHERE wid = ?`,
+		`DELETE FROM wavelabel WHERE wid = ?`,
+		`DELETE FROM wavestats WHERE wid = ?`,
+		`DELETE FROM wave WHERE wid = ?`,
+	}
+	for _, id := range wid {
+		for _, qry := range remove {
+			if _, err = tx.Exec(qry, id); err != nil {
+				tx.Rollback()
+				return err
+			}
+		}
+	}
+
+	return tx.Commit()
 }
 
-func selectWidBeforeTime(time time.Time) ([]int64, error) {
-	qry := `SELECT DISTINCT wid FROM wave WHERE created < ? UNION SELECT DISTINCT wid FROM waveloc WHERE created < ?`
-	rows, err := db.Query(qry, time, time)
+func (c *sqliteCatalog) RemoveKey(ns, path string) error {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	remove := []string{
+		`DELETE FROM waveloc WHERE path = ? AND wid IN (SELECT wid FROM wave WHERE ns = ?)`,
+		`DELETE FROM wavekey WHERE path = ? AND wid IN (SELECT wid FROM wave WHERE ns = ?)`,
+	}
+	for _, qry := range remove {
+		if _, err = tx.Exec(qry, path, ns); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (c *sqliteCatalog) PurgeBeforeTime(ctx context.Context, ns string, time time.Time) error {
+	tx, err := c.db.Begin()
+	if err != nil {
+		return err
+	}
+
+	purge := []string{
+		`DELETE FROM waveloc WHERE wid IN (SELECT wid FROM wave WHERE ns = ? AND created < ?)`,
+		`DELETE FROM wavekey WHERE wid IN (SELECT wid FROM wave WHERE ns = ? AND created < ?)`,
+		`DELETE FROM wavelabel WHERE wid IN (SELECT wid FROM wave WHERE ns = ? AND created < ?)`,
+		`DELETE FROM wavestats WHERE wid IN (SELECT wid FROM wave WHERE ns = ? AND created < ?)`,
+		`DELETE FROM wave WHERE ns = ? AND created < ?`,
+	}
+
+	for _, qry := range purge {
+		if _, err = tx.ExecContext(ctx, qry, ns, time); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (c *sqliteCatalog) selectWid(qry string, args ...interface{}) ([]int64, error) {
+	rows, err := c.db.Query(qry, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -190,23 +521,22 @@ func selectWidBeforeTime(time time.Time) ([]int64, error) {
 	return wid, nil
 }
 
-func purgeBeforeTime(ctx context.Context, time time.Time) error {
-	tx, err := db.Begin()
-	if err != nil {
-		return err
-	}
-
-	_, err = tx.ExecContext(ctx, `DELETE FROM wave WHERE created < ?`, time)
+func (c *sqliteCatalog) selectText(qry string, args ...interface{}) ([]string, error) {
+	rows, err := c.db.Query(qry, args...)
 	if err != nil {
-		tx.Rollback()
-		return err
+		return nil, err
 	}
+	defer rows.Close()
 
-	_, err = tx.ExecContext(ctx, `DELETE FROM waveloc WHERE created < ?`, time)
-	if err != nil {
-		tx.Rollback()
-		return err
+	var (
+		tmp  sql.NullString
+		text []string
+	)
+	for rows.Next() {
+		if err = rows.Scan(&tmp); err == nil && tmp.Valid {
+			text = append(text, tmp.String)
+		}
 	}
 
-	return tx.Commit()
+	return text, nil
 }
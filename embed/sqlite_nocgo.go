@@ -0,0 +1,34 @@
+//go:build !cgo
+// +build !cgo
+
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package embed
+
+import "errors"
+
+const (
+	defaultCatalog = JournalCatalog
+	sqliteFile     = "db"
+)
+
+func openSqliteCatalog(string) (Catalog, error) {
+	return nil, errors.New("sqlite catalog requires cgo build, use journal catalog instead")
+}
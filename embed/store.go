@@ -0,0 +1,181 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package embed
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"sync"
+
+	"github.com/chronowave/chronowave/ssql"
+	"github.com/chronowave/chronowave/ssql/parser"
+)
+
+// Options configures Store, zero value uses settings of data directory or defaults
+type Options struct {
+	// TimeUnit is unit of canonical int64 timestamp, see TimeUnit
+	TimeUnit string
+	// CatalogType is catalog of new data directory, see CatalogType
+	CatalogType string
+	// Catalog is used instead of opening the one of CatalogType
+	Catalog Catalog
+	// Workers bounds concurrent searches of index blocks, default to runtime.NumCPU()
+	Workers int
+	// CacheSize bounds bytes of memory mapped index blocks kept decoded between queries, default
+	// to 256MB, negative disables caching
+	CacheSize int64
+	// Groups bounds groups of aggregate query kept in memory while merging result sets of index
+	// blocks, groups beyond are spilled to disk, default to operator.DefaultGroups
+	Groups int
+}
+
+// ErrLocked is returned when data directory is being repaired, or repair finds it open elsewhere
+var ErrLocked = errors.New("data directory is locked")
+
+func errLocked(dir, reason string, err error) error {
+	return fmt.Errorf("%w, %v is %v: %v", ErrLocked, dir, reason, err)
+}
+
+// Store owns a data directory with its catalog, worker pool and namespaces, each namespace has its
+// own WAL and tail index of WAL. Stores of different data directories are independent of each other.
+type Store struct {
+	dir     string
+	unit    string
+	nanos   int64
+	catalog Catalog
+	seq     int64
+	guard   chan void
+	cache   *blockCache
+	groups  int
+
+	// shared lock of data directory, exclusive while repaired
+	dirLock *os.File
+	// index blocks are written under read lock, and repaired under write lock
+	writing sync.RWMutex
+
+	lock       sync.Mutex
+	root       *Namespace
+	namespaces map[string]*Namespace
+}
+
+// OpenStore prepares data directory and opens its catalog
+func OpenStore(dir string, opts Options) (*Store, error) {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	dirLock, err := lockDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	c := opts.Catalog
+	if c == nil {
+		if c, err = OpenCatalog(dir, opts.CatalogType); err != nil {
+			dirLock.Close()
+			return nil, err
+		}
+	}
+
+	unit, err := loadTimeUnit(c, dir, opts.TimeUnit)
+	if err != nil {
+		c.Close()
+		dirLock.Close()
+		return nil, err
+	}
+
+	seq, err := c.MaxWid()
+	if err != nil {
+		c.Close()
+		dirLock.Close()
+		return nil, err
+	}
+
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	s := &Store{
+		dir:        dir,
+		unit:       unit,
+		nanos:      units[unit],
+		catalog:    c,
+		seq:        seq,
+		guard:      make(chan void, workers),
+		cache:      newBlockCache(opts.CacheSize),
+		groups:     opts.Groups,
+		dirLock:    dirLock,
+		namespaces: map[string]*Namespace{},
+	}
+
+	if s.root, err = s.Namespace(""); err != nil {
+		c.Close()
+		dirLock.Close()
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Close closes catalog of store, unmaps cached index blocks and releases lock of data directory
+func (s *Store) Close() error {
+	s.cache.close()
+	err := s.catalog.Close()
+	s.dirLock.Close()
+	return err
+}
+
+// exclusive locks data directory for repair, it fails if data directory is open by another store.
+// no index block is written by store until the returned func releases the lock.
+func (s *Store) exclusive() (func(), error) {
+	s.writing.Lock()
+	if err := lockExclusive(s.dirLock, s.dir); err != nil {
+		s.writing.Unlock()
+		return nil, err
+	}
+
+	return func() {
+		unlockExclusive(s.dirLock)
+		s.writing.Unlock()
+	}, nil
+}
+
+// CacheStats returns usage of index block cache
+func (s *Store) CacheStats() CacheStats {
+	return s.cache.stats()
+}
+
+// Directory returns data directory of store
+func (s *Store) Directory() string {
+	return s.dir
+}
+
+// TimeUnit returns unit of canonical int64 timestamp of store
+func (s *Store) TimeUnit() string {
+	return s.unit
+}
+
+// Parse parses SSQL, time expressions in TIMEFRAME are in unit of store
+func (s *Store) Parse(query string) (*ssql.Statement, []parser.Error) {
+	return parser.ParseWithEpoch(query, s.fromTime)
+}
@@ -25,6 +25,7 @@ import (
 	"errors"
 	"io"
 	"io/ioutil"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strconv"
@@ -34,15 +35,18 @@ import (
 
 	hfmi "github.com/rleiwang/hfmi/ctor"
 
+	"github.com/chronowave/chronowave/ssd"
 	"github.com/chronowave/chronowave/ssd/codec"
 	ssdidx "github.com/chronowave/chronowave/ssd/index"
-	"github.com/chronowave/chronowave/ssql/parser"
 
 	"github.com/rs/zerolog/log"
 )
 
 const (
 	sz = 256
+
+	// small index blocks of stream are compacted in background
+	compactInterval = 5 * time.Minute
 )
 
 func init() {
@@ -50,7 +54,8 @@ func init() {
 }
 
 type WaveStream struct {
-	wal      string
+	ns       *Namespace
+	tail     *tail
 	cnt      uint64
 	batch    []string
 	c        chan []string
@@ -59,34 +64,75 @@ type WaveStream struct {
 	lock     sync.Mutex
 }
 
+// NewWave opens the default store of dir with TimeUnit and CatalogType, the store is closed with
+// WaveStream
 func NewWave(dir, ts string, keys []string) *WaveStream {
-	Directory = dir
-	closer, err := Verify()
+	store, err := OpenStore(dir, Options{TimeUnit: TimeUnit, CatalogType: CatalogType})
+	if err != nil {
+		panic(err)
+	}
+	setDefault(store)
+
+	w := store.NewWave(ts, keys)
+	w.dbcloser = func() { store.Close() }
+	return w
+}
+
+// NewWave streams documents into WAL of the default namespace
+func (s *Store) NewWave(ts string, keys []string) *WaveStream {
+	return s.root.NewWave(ts, keys)
+}
+
+// NewWave streams documents into WAL of namespace, and indexes them in batch of 256 documents.
+// data beyond retention of namespace is purged, keys added to namespace are backfilled, and small
+// index blocks are compacted in background.
+func (n *Namespace) NewWave(ts string, keys []string) *WaveStream {
+	return n.NewLabeledWave(ts, keys, nil)
+}
+
+// NewLabeledWave streams documents tagged with labels into WAL of namespace, index blocks built
+// from them are tagged with labels too.
+func (n *Namespace) NewLabeledWave(ts string, keys []string, labels Labels) *WaveStream {
+	t, err := n.tail(labels)
 	if err != nil {
 		panic(err)
 	}
 
-	buildWalIndex()
+	t.build(ts)
 	ticker := time.NewTicker(15 * time.Second)
 	go func() {
+		compacted := time.Now()
 		for range ticker.C {
-			buildWalIndex()
+			t.build(ts)
+			if err := n.Retain(context.Background()); err != nil {
+				log.Err(err).Msgf("retaining namespace [%v]", n.name)
+			}
+			if _, err := n.Backfill(context.Background()); err != nil {
+				log.Err(err).Msgf("backfilling keys of namespace [%v]", n.name)
+			}
+			if time.Since(compacted) >= compactInterval {
+				if _, err := n.Compact(context.Background()); err != nil {
+					log.Err(err).Msgf("compacting namespace [%v]", n.name)
+				}
+				compacted = time.Now()
+			}
 		}
 	}()
 
 	c := make(chan []string, 2048)
 	go func() {
 		for files := range c {
-			buildIndex(ts, keys, files)
+			n.buildIndex(t, ts, keys, files)
 		}
 	}()
 
 	return &WaveStream{
-		wal:      filepath.Join(dir, wal),
+		ns:       n,
+		tail:     t,
 		batch:    make([]string, 0, sz),
 		c:        c,
 		ticker:   ticker,
-		dbcloser: closer,
+		dbcloser: donothing,
 	}
 }
 
@@ -97,7 +143,11 @@ func (s *WaveStream) Close() {
 }
 
 func (s *WaveStream) OnNewDocument(json []byte) error {
-	n := filepath.Join(s.wal, strconv.FormatUint(atomic.AddUint64(&s.cnt, 1), 10))
+	if s.ns.exceeds(int64(len(json))) {
+		return ErrQuota
+	}
+
+	n := filepath.Join(s.tail.dir, strconv.FormatUint(atomic.AddUint64(&s.cnt, 1), 10))
 	err := ioutil.WriteFile(n, json, os.ModePerm)
 	if err != nil {
 		return err
@@ -116,26 +166,36 @@ func (s *WaveStream) OnNewDocument(json []byte) error {
 }
 
 func (s *WaveStream) Purge(ctx context.Context, time time.Time) error {
-	return Purge(ctx, time)
+	return s.ns.Purge(ctx, time)
 }
 
 func (s *WaveStream) Query(ctx context.Context, query string) ([]byte, error) {
-	stmt, errs := parser.Parse(query)
+	stmt, errs := s.ns.store.Parse(query)
 	if len(errs) > 0 {
 		return nil, errors.New("syntax error")
 	}
 
-	return Query(ctx, stmt), nil
+	// without namespace in FROM, query namespace of stream, with labels of FROM if any
+	if len(statementNamespace(stmt)) == 0 {
+		return s.ns.query(ctx, stmt), nil
+	}
+	return s.ns.store.Query(ctx, stmt), nil
 }
 
-func buildIndex(timestamp string, keys []string, files []string) {
+func (n *Namespace) buildIndex(t *tail, timestamp string, keys []string, files []string) {
 	defer func() {
 		if r := recover(); r != nil {
 			log.Error().Msgf("runtime error in building index %v", r)
 		}
 	}()
-	if id, err := BuildFromFiles(files, timestamp, keys); err == nil {
-		walIndex = nil
+
+	// WAL files renamed with block id are not repaired until indexed
+	n.store.writing.RLock()
+	defer n.store.writing.RUnlock()
+
+	// documents moved to dead letter are not indexed again
+	if id, err := n.buildFromFiles(files, timestamp, keys, t.labels); err == nil || errors.Is(err, ErrDeadLetter) {
+		t.index = nil
 		for _, f := range files {
 			os.Remove(f + "." + strconv.FormatInt(id, 10))
 		}
@@ -147,14 +207,64 @@ func buildIndex(timestamp string, keys []string, files []string) {
 	}
 }
 
-func buildWalIndex() {
+// tail is WAL of documents with the same labels, they are searched by in memory index until
+// built into index block
+type tail struct {
+	ns     *Namespace
+	labels Labels
+	dir    string
+	index  *ssd.IndexedBlock
+}
+
+// tail returns WAL tail of labels, WAL of unlabeled documents is wal, and the others are in
+// wal/{labels}
+func (n *Namespace) tail(labels Labels) (*tail, error) {
+	key := labels.String()
+
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	if t, ok := n.tails[key]; ok {
+		return t, nil
+	}
+
+	dir := filepath.Join(n.dir, wal)
+	if len(key) > 0 {
+		dir = filepath.Join(dir, url.PathEscape(key))
+	}
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+
+	t := &tail{ns: n, labels: labels, dir: dir}
+	n.tails[key] = t
+	return t, nil
+}
+
+// matchTails returns WAL tails having labels, all of them or any if or
+func (n *Namespace) matchTails(labels []string, or bool) []*tail {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	tails := make([]*tail, 0, len(n.tails))
+	for _, t := range n.tails {
+		if t.labels.match(labels, or) {
+			tails = append(tails, t)
+		}
+	}
+	return tails
+}
+
+// build indexes WAL documents in memory, timestamp is normalized the same as index block, and
+// documents failed validation are left to be moved to dead letter once built into index block
+func (t *tail) build(timestamp string) {
 	defer func() {
 		if r := recover(); r != nil {
 			log.Error().Msgf("runtime error in building index %v", r)
 		}
 	}()
 
-	files, err := ioutil.ReadDir(filepath.Join(Directory, wal))
+	files, err := ioutil.ReadDir(t.dir)
 	if err != nil {
 		log.Err(err).Msg("reading WAL files")
 		return
@@ -168,7 +278,7 @@ func buildWalIndex() {
 		if f.IsDir() || len(filepath.Ext(f.Name())) > 0 {
 			continue
 		}
-		if r, err := os.OpenFile(filepath.Join(Directory, wal, f.Name()), os.O_RDONLY, os.ModePerm); err == nil {
+		if r, err := os.OpenFile(filepath.Join(t.dir, f.Name()), os.O_RDONLY, os.ModePerm); err == nil {
 			io.Copy(w, r)
 			r.Close()
 		}
@@ -178,7 +288,13 @@ func buildWalIndex() {
 		return
 	}
 
-	parsed, err := codec.ParseJson(w.Bytes())
+	data, _, _ := t.ns.normalize(w.Bytes(), timestamp)
+	if len(data) == 0 {
+		t.index = nil
+		return
+	}
+
+	parsed, err := codec.ParseJson(data)
 	if err != nil {
 		log.Err(err).Msg("parsing WAL files")
 		return
@@ -188,6 +304,6 @@ func buildWalIndex() {
 	if err != nil {
 		log.Err(err).Msg("building index from WAL files")
 	} else {
-		walIndex = indexed
+		t.index = indexed
 	}
 }
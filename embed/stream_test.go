@@ -0,0 +1,94 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package embed
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chronowave/chronowave/ssql/parser"
+)
+
+func TestWaveStreamQuery(t *testing.T) {
+	s := openTestStore(t, JournalCatalog)
+	tenant, err := s.Namespace("tenantA")
+	if err != nil {
+		t.Fatalf("Namespace() error = %v", err)
+	}
+
+	// streams of both namespaces have the same label
+	streams := map[string]*WaveStream{}
+	for name, n := range map[string]*Namespace{"default": s.root, "tenantA": tenant} {
+		w := n.NewLabeledWave("/ts", nil, Labels{"env": "prod"})
+		defer w.Close()
+		if err := w.OnNewDocument([]byte(`{"ts":100,"s":"` + name + `"}`)); err != nil {
+			t.Fatalf("OnNewDocument() error = %v", err)
+		}
+		w.tail.build("/ts")
+		streams[name] = w
+	}
+
+	tests := []struct {
+		name   string
+		stream string
+		query  string
+		want   string
+	}{
+		{"no FROM", "tenantA", `find $s where [$s /s]`, `[{"s":"tenantA"}]`},
+		{"labels", "tenantA", `find $s from env=prod where [$s /s]`, `[{"s":"tenantA"}]`},
+		{"labels of default", "default", `find $s from env=prod where [$s /s]`, `[{"s":"default"}]`},
+		{"other label", "tenantA", `find $s from env=test where [$s /s]`, `[]`},
+		{"namespace", "default", `find $s from tenantA where [$s /s]`, `[{"s":"tenantA"}]`},
+		{"namespace and labels", "default", `find $s from tenantA, env=prod where [$s /s]`, `[{"s":"tenantA"}]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := streams[tt.stream].Query(context.Background(), tt.query)
+			if err != nil {
+				t.Fatalf("Query() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Query() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewWave(t *testing.T) {
+	unit, dir, s, epoch := TimeUnit, Directory, std, parser.EpochTime
+	defer func() { TimeUnit, Directory, std, parser.EpochTime = unit, dir, s, epoch }()
+
+	TimeUnit = "s"
+	w := NewWave(t.TempDir(), "/ts", nil)
+	defer w.Close()
+	if err := w.OnNewDocument([]byte(`{"ts":100,"s":"x"}`)); err != nil {
+		t.Fatalf("OnNewDocument() error = %v", err)
+	}
+	w.tail.build("/ts")
+
+	// package level functions use the store of stream
+	stmt, errs := parser.Parse(`find $s where [$s /s]`)
+	if len(errs) > 0 {
+		t.Fatalf("Parse() error = %v", errs)
+	}
+	if got, want := string(Query(context.Background(), stmt)), `[{"s":"x"}]`; got != want {
+		t.Errorf("Query() = %s, want %s", got, want)
+	}
+}
@@ -0,0 +1,191 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package embed
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/araddon/dateparse"
+	"github.com/buger/jsonparser"
+
+	"github.com/chronowave/chronowave/ssd"
+)
+
+const (
+	defaultTimeUnit = "us"
+
+	// originalTimestamp is key of document keeping JSON path and value of timestamp normalized to
+	// canonical int64, in form of [path, value]
+	originalTimestamp = "_chronowave_timestamp"
+)
+
+var (
+	// TimeUnit is unit of canonical int64 timestamp in data directory, one of s, ms, us and ns.
+	// empty TimeUnit uses the one persisted in data directory, or us for new data directory.
+	TimeUnit string
+
+	// nanoseconds per unit
+	units = map[string]int64{
+		"s":  int64(time.Second),
+		"ms": int64(time.Millisecond),
+		"us": int64(time.Microsecond),
+		"ns": int64(time.Nanosecond),
+	}
+)
+
+// loadTimeUnit reconciles unit with the one persisted in data directory, returns unit in effect
+func loadTimeUnit(c Catalog, dir, unit string) (string, error) {
+	if _, ok := units[unit]; len(unit) > 0 && !ok {
+		return "", errors.New("invalid timestamp unit [" + unit + "], expect one of s, ms, us, ns")
+	}
+
+	persisted, err := c.SelectSetting("unit")
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case len(persisted) > 0 && len(unit) == 0:
+		return persisted, nil
+	case len(persisted) > 0 && persisted != unit:
+		return "", fmt.Errorf("timestamp unit [%v] conflicts with [%v] of data directory %v", unit, persisted, dir)
+	case len(persisted) > 0:
+		return unit, nil
+	case len(unit) == 0:
+		unit = defaultTimeUnit
+	}
+
+	return unit, c.InsertSetting("unit", unit)
+}
+
+// fromTime converts time to canonical int64 timestamp
+func (s *Store) fromTime(t time.Time) int64 {
+	n := s.nanos
+	return t.Unix()*(int64(time.Second)/n) + int64(t.Nanosecond())/n
+}
+
+// fromSeconds converts float epoch seconds to canonical int64 timestamp
+func (s *Store) fromSeconds(sec float64) (int64, error) {
+	v := math.Round(sec * float64(int64(time.Second)/s.nanos))
+	if math.IsNaN(v) || v >= math.MaxInt64 || v <= math.MinInt64 {
+		return 0, fmt.Errorf("timestamp %v out of range", sec)
+	}
+	return int64(v), nil
+}
+
+// normalizeTimestamp converts timestamp value to canonical int64. integer is already in store unit,
+// float is epoch seconds, and string is either ISO-8601/RFC3339 date time or one of the numbers.
+func (s *Store) normalizeTimestamp(value []byte, vt jsonparser.ValueType) (int64, error) {
+	switch vt {
+	case jsonparser.Number:
+		if v, err := strconv.ParseInt(string(value), 10, 64); err == nil {
+			return v, nil
+		}
+		v, err := strconv.ParseFloat(string(value), 64)
+		if err != nil {
+			return 0, err
+		}
+		return s.fromSeconds(v)
+	case jsonparser.String:
+		text := string(value)
+		if v, err := strconv.ParseInt(text, 10, 64); err == nil {
+			return v, nil
+		}
+		if v, err := strconv.ParseFloat(text, 64); err == nil {
+			return s.fromSeconds(v)
+		}
+		if t, err := time.Parse(time.RFC3339Nano, text); err == nil {
+			return s.fromTime(t), nil
+		}
+		t, err := dateparse.ParseIn(text, time.UTC)
+		if err != nil {
+			return 0, err
+		}
+		return s.fromTime(t), nil
+	}
+
+	return 0, fmt.Errorf("expect number or string, got %v", vt)
+}
+
+// keepTimestamp returns value of originalTimestamp for timestamp path and its value
+func keepTimestamp(timestamp string, value []byte, vt jsonparser.ValueType) []byte {
+	path, _ := json.Marshal(timestamp)
+	kept := append(append([]byte{'['}, path...), ',')
+	if vt == jsonparser.String {
+		// value of string is without quotes
+		kept = append(append(append(kept, '"'), value...), '"')
+	} else {
+		kept = append(kept, value...)
+	}
+
+	return append(kept, ']')
+}
+
+// restoreTimestamp returns document with its original timestamp kept in originalTimestamp, the
+// document itself if its timestamp is not normalized
+func restoreTimestamp(doc []byte) []byte {
+	kept, vt, _, err := jsonparser.Get(doc, originalTimestamp)
+	if err != nil || vt != jsonparser.Array {
+		return doc
+	}
+
+	var (
+		path  string
+		value []byte
+		i     int
+	)
+	jsonparser.ArrayEach(kept, func(v []byte, vt jsonparser.ValueType, _ int, _ error) {
+		switch {
+		case i == 0 && vt == jsonparser.String:
+			path, _ = jsonparser.ParseString(v)
+		case i == 1 && vt == jsonparser.String:
+			value = append(append([]byte{'"'}, v...), '"')
+		case i == 1:
+			value = v
+		}
+		i++
+	})
+	if len(path) == 0 || len(value) == 0 {
+		return doc
+	}
+
+	restored, err := jsonparser.Set(jsonparser.Delete(doc, originalTimestamp), value, splitPath(path)...)
+	if err != nil {
+		return doc
+	}
+
+	return restored
+}
+
+// restoreTimestamps restores original timestamp of documents in result set
+func restoreTimestamps(rs *ssd.ResultSet) *ssd.ResultSet {
+	if rs != nil {
+		for i, doc := range rs.Json {
+			rs.Json[i] = restoreTimestamp(doc)
+		}
+	}
+
+	return rs
+}
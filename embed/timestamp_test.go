@@ -0,0 +1,231 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package embed
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/buger/jsonparser"
+)
+
+func TestNormalizeTimestamp(t *testing.T) {
+	tests := []struct {
+		name    string
+		unit    string
+		value   string
+		vt      jsonparser.ValueType
+		want    int64
+		wantErr bool
+	}{
+		{"int", "s", "1600000000", jsonparser.Number, 1600000000, false},
+		{"int of unit", "ns", "1600000000", jsonparser.Number, 1600000000, false},
+		{"negative int", "ms", "-5", jsonparser.Number, -5, false},
+		{"float seconds", "ms", "1600000000.5", jsonparser.Number, 1600000000500, false},
+		{"float seconds rounded", "s", "1.6", jsonparser.Number, 2, false},
+		{"float exponent", "us", "1.6e9", jsonparser.Number, 1600000000000000, false},
+		{"float out of range", "s", "1e300", jsonparser.Number, 0, true},
+		{"int string", "us", "42", jsonparser.String, 42, false},
+		{"float string", "us", "1.5", jsonparser.String, 1500000, false},
+		{"RFC3339", "s", "2020-09-13T12:26:40Z", jsonparser.String, 1600000000, false},
+		{"RFC3339 of offset", "s", "2020-09-13T14:26:40+02:00", jsonparser.String, 1600000000, false},
+		{"RFC3339 nanoseconds", "us", "2020-09-13T12:26:40.123456789Z", jsonparser.String, 1600000000123456, false},
+		{"RFC3339 nanoseconds of ns", "ns", "2020-09-13T12:26:40.123456789Z", jsonparser.String, 1600000000123456789, false},
+		{"RFC3339 before epoch", "ms", "1969-12-31T23:59:59.5Z", jsonparser.String, -500, false},
+		{"date time", "s", "2020-09-13 12:26:40", jsonparser.String, 1600000000, false},
+		{"US date time", "ms", "09/13/2020 12:26:40", jsonparser.String, 1600000000000, false},
+		{"month name", "s", "Sep 13, 2020 12:26:40", jsonparser.String, 1600000000, false},
+		{"date", "s", "2020-09-13", jsonparser.String, 1599955200, false},
+		{"invalid string", "s", "yesterday", jsonparser.String, 0, true},
+		{"boolean", "s", "true", jsonparser.Boolean, 0, true},
+		{"object", "s", "{}", jsonparser.Object, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Store{unit: tt.unit, nanos: units[tt.unit]}
+			got, err := s.normalizeTimestamp([]byte(tt.value), tt.vt)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("normalizeTimestamp() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("normalizeTimestamp() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLoadTimeUnit(t *testing.T) {
+	tests := []struct {
+		name string
+		// unit persisted in data directory, none if empty
+		persisted string
+		unit      string
+		want      string
+		wantErr   bool
+	}{
+		{"default", "", "", defaultTimeUnit, false},
+		{"new", "", "ms", "ms", false},
+		{"persisted", "ns", "", "ns", false},
+		{"same", "s", "s", "s", false},
+		{"conflict", "s", "ms", "", true},
+		{"invalid", "", "h", "", true},
+		{"invalid of persisted", "s", "h", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testCatalogs(t, func(t *testing.T, c Catalog) {
+				if len(tt.persisted) > 0 {
+					if err := c.InsertSetting("unit", tt.persisted); err != nil {
+						t.Fatalf("InsertSetting() error = %v", err)
+					}
+				}
+
+				got, err := loadTimeUnit(c, "test", tt.unit)
+				if (err != nil) != tt.wantErr {
+					t.Fatalf("loadTimeUnit() error = %v, wantErr %v", err, tt.wantErr)
+				}
+				if got != tt.want {
+					t.Errorf("loadTimeUnit() = %v, want %v", got, tt.want)
+				}
+
+				// unit in effect is persisted, and persisted unit is kept on error
+				want := tt.want
+				if tt.wantErr {
+					want = tt.persisted
+				}
+				if persisted, err := c.SelectSetting("unit"); err != nil || persisted != want {
+					t.Errorf("SelectSetting() = %v, %v, want %v", persisted, err, want)
+				}
+			})
+		})
+	}
+}
+
+func TestStoreTimeUnit(t *testing.T) {
+	testStores(t, func(t *testing.T, s *Store) {
+		dir := s.Directory()
+		buildTestBlock(t, s.root, `{"ts":"2020-09-13T12:26:40.5Z","v":1} {"ts":1600000001.5,"v":2}`)
+		s.Close()
+
+		// data directory of seconds can't be opened in another unit
+		if _, err := OpenStore(dir, Options{TimeUnit: "ms"}); err == nil || errors.Is(err, ErrLocked) {
+			t.Fatalf("OpenStore() of ms error = %v, want conflict", err)
+		}
+
+		s, err := OpenStore(dir, Options{})
+		if err != nil {
+			t.Fatalf("OpenStore() error = %v", err)
+		}
+		defer s.Close()
+
+		if got := s.TimeUnit(); got != "s" {
+			t.Errorf("TimeUnit() = %v, want s", got)
+		}
+
+		// date time is truncated to seconds, and float seconds are rounded
+		waves, err := s.catalog.SelectWaveInfo(s.root.name)
+		if err != nil || len(waves) != 1 || waves[0].Beg != 1600000000 || waves[0].End != 1600000002 {
+			t.Errorf("SelectWaveInfo() = %+v, %v, want a wave of [1600000000, 1600000002]", waves, err)
+		}
+	})
+}
+
+func TestRestoreTimestamp(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		path string
+		want string
+	}{
+		{"canonical", `{"ts":1600000000,"v":1}`, "/ts", `{"ts":1600000000,"v":1}`},
+		{"RFC3339", `{"ts":"2020-09-13T12:26:40Z","v":1}`, "/ts", `{"ts":1600000000,"v":1,"_chronowave_timestamp":["/ts","2020-09-13T12:26:40Z"]}`},
+		{"float", `{"v":1,"ts":1600000000.5}`, "/ts", `{"v":1,"ts":1600000001,"_chronowave_timestamp":["/ts",1600000000.5]}`},
+		{"nested", `{"m":{"at":"2020-09-13 12:26:40"}}`, "/m/at", `{"m":{"at":1600000000},"_chronowave_timestamp":["/m/at","2020-09-13 12:26:40"]}`},
+		{"escaped", `{"ts":"2020-09-13T12:26:40Z","s":"a\"b"}`, "/ts", `{"ts":1600000000,"s":"a\"b","_chronowave_timestamp":["/ts","2020-09-13T12:26:40Z"]}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := &Namespace{store: &Store{unit: "s", nanos: units["s"]}}
+			got, reason := n.validateTimestamp([]byte(tt.doc), tt.path, splitPath(tt.path))
+			if len(reason) > 0 || string(got) != tt.want {
+				t.Fatalf("validateTimestamp() = %s, %v, want %s", got, reason, tt.want)
+			}
+			if restored := restoreTimestamp(got); string(restored) != tt.doc {
+				t.Errorf("restoreTimestamp() = %s, want %s", restored, tt.doc)
+			}
+		})
+	}
+}
+
+func TestOriginalTimestamp(t *testing.T) {
+	s := openTestStore(t, JournalCatalog)
+	indexed := []string{`{"ts":"2020-09-13T12:26:40Z","v":1}`, `{"ts":1600000001.5,"v":2}`, `{"ts":1600000003,"v":3}`}
+	buildTestBlock(t, s.root, strings.Join(indexed, " "))
+
+	// documents of WAL are normalized the same as those of index block
+	w := s.root.NewLabeledWave("/ts", nil, nil)
+	defer w.Close()
+	wal := []string{`{"ts":"2020-09-13T12:26:44Z","v":4}`, `{"ts":1600000004.6,"v":5}`}
+	for _, doc := range wal {
+		if err := w.OnNewDocument([]byte(doc)); err != nil {
+			t.Fatalf("OnNewDocument() error = %v", err)
+		}
+	}
+	w.tail.build("/ts")
+
+	tests := []struct {
+		name  string
+		query string
+		want  string
+	}{
+		{"index block", `find $v where [$v /v][/ts timeframe(1600000000, 1600000002)]`, `[{"v":1},{"v":2}]`},
+		{"WAL", `find $v where [$v /v][/ts timeframe(1600000004, 1600000006)]`, `[{"v":4},{"v":5}]`},
+		{"WAL and index block", `find $v where [$v /v][/ts timeframe(1600000003, 1600000005)] order-by $v`, `[{"v":3},{"v":4},{"v":5}]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := w.Query(context.Background(), tt.query)
+			if err != nil {
+				t.Fatalf("Query() error = %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Query() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+
+	// exported documents are as they are ingested
+	var out bytes.Buffer
+	if _, err := s.Export(context.Background(), &out, ExportOptions{Timestamp: "/ts"}); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	got := strings.Split(strings.TrimSpace(out.String()), "\n")
+	want := append(append([]string{}, indexed...), wal...)
+	sort.Strings(got)
+	sort.Strings(want)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Export() = %q, want %q", got, want)
+	}
+}
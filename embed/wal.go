@@ -36,11 +36,29 @@ var (
 	}
 )
 
+// BuildFromFiles indexes JSON files in the default store
 func BuildFromFiles(files []string, timestamp string, keys []string) (int64, error) {
+	return std.BuildFromFiles(files, timestamp, keys)
+}
+
+// BuildFromFiles indexes JSON files into one index block of the default namespace
+func (s *Store) BuildFromFiles(files []string, timestamp string, keys []string) (int64, error) {
+	return s.root.BuildFromFiles(files, timestamp, keys)
+}
+
+// BuildFromFiles indexes JSON files into one index block, files are renamed with block id suffix
+func (n *Namespace) BuildFromFiles(files []string, timestamp string, keys []string) (int64, error) {
+	n.store.writing.RLock()
+	defer n.store.writing.RUnlock()
+
+	return n.buildFromFiles(files, timestamp, keys, nil)
+}
+
+func (n *Namespace) buildFromFiles(files []string, timestamp string, keys []string, labels Labels) (int64, error) {
 	buf := pool.Get().([]byte)
 	defer pool.Put(buf)
 
-	nid := atomic.AddInt64(&seq, 1)
+	nid := atomic.AddInt64(&n.store.seq, 1)
 	w := bytes.NewBuffer(buf[:0])
 	for _, f := range files {
 		r, err := os.OpenFile(f, os.O_RDONLY, os.ModePerm)
@@ -52,5 +70,5 @@ func BuildFromFiles(files []string, timestamp string, keys []string) (int64, err
 		os.Rename(f, f+"."+strconv.FormatInt(nid, 10))
 	}
 
-	return nid, createIndex(nid, w.Bytes(), timestamp, keys)
+	return nid, n.createIndex(nid, w.Bytes(), timestamp, keys, labels)
 }
@@ -31,7 +31,54 @@ type Aggregator interface {
 	Merge(Aggregator)
 }
 
+// BytesStepper is Aggregator stepping by TEXT, BOOL and JSON values in JSON, e.g. text in quotes,
+// which otherwise step by index of text, or 0. value is valid only during the call.
+type BytesStepper interface {
+	StepBytes(value []byte)
+}
+
+// TimeStepper is Aggregator of values by their timestamps, At is called with timestamp of the
+// next value stepped, values without timestamp are stepped without At
+type TimeStepper interface {
+	At(ts int64)
+}
+
+// New returns Aggregator of function
+func New(fn *ssql.Function) Aggregator {
+	switch fn.Name {
+	case ssql.Function_AVG:
+		return NewAverage()
+	case ssql.Function_SUM:
+		return NewSum()
+	case ssql.Function_MIN:
+		return NewMin()
+	case ssql.Function_MAX:
+		return NewMax()
+	case ssql.Function_COUNT:
+		return NewCount()
+	case ssql.Function_PCTL:
+		return NewPercentile()
+	case ssql.Function_COUNT_DISTINCT:
+		return NewDistinct(fn.GetInt())
+	case ssql.Function_STDDEV, ssql.Function_VARIANCE:
+		return NewVariance(fn.Name)
+	case ssql.Function_FIRST, ssql.Function_LAST:
+		return NewFirst(fn.Name == ssql.Function_LAST)
+	case ssql.Function_TOPK:
+		return NewTopK(fn.GetInt())
+	case ssql.Function_HISTOGRAM:
+		return NewHistogram(fn.GetInt())
+	}
+
+	return nil
+}
+
+// FromBytes decodes Aggregator of Bytes, returns nil if buf is truncated or of unknown function
 func FromBytes(buf []byte) Aggregator {
+	if len(buf) == 0 {
+		return nil
+	}
+
 	switch buf[0] {
 	case byte(ssql.Function_AVG):
 		return DecodeAverage(buf[1:])
@@ -45,6 +92,16 @@ func FromBytes(buf []byte) Aggregator {
 		return DecodeCount(buf[1:])
 	case byte(ssql.Function_PCTL):
 		return DecodePercentile(buf[1:])
+	case byte(ssql.Function_COUNT_DISTINCT):
+		return DecodeDistinct(buf[1:])
+	case byte(ssql.Function_STDDEV), byte(ssql.Function_VARIANCE):
+		return DecodeVariance(ssql.Function_Func(buf[0]), buf[1:])
+	case byte(ssql.Function_FIRST), byte(ssql.Function_LAST):
+		return DecodeFirst(buf[0] == byte(ssql.Function_LAST), buf[1:])
+	case byte(ssql.Function_TOPK):
+		return DecodeTopK(buf[1:])
+	case byte(ssql.Function_HISTOGRAM):
+		return DecodeHistogram(buf[1:])
 	case byte(ssql.Function_PART):
 	}
 
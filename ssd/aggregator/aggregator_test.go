@@ -0,0 +1,73 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package aggregator
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/chronowave/chronowave/ssql"
+)
+
+func TestFromBytes(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   ssql.Function_Func
+	}{
+		{"avg", ssql.Function_AVG},
+		{"sum", ssql.Function_SUM},
+		{"min", ssql.Function_MIN},
+		{"max", ssql.Function_MAX},
+		{"count", ssql.Function_COUNT},
+		{"pctl", ssql.Function_PCTL},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := New(&ssql.Function{Name: tt.fn})
+			for v := 0; v < 10; v++ {
+				a.StepFloat64(float64(v) / 2)
+			}
+
+			buf := a.Bytes()
+			got := FromBytes(buf)
+			if !reflect.DeepEqual(got.Bytes(), buf) {
+				t.Errorf("FromBytes() = %v, want %v", got.Bytes(), buf)
+			}
+			truncated(t, buf)
+		})
+	}
+
+	t.Run("unknown", func(t *testing.T) {
+		if got := FromBytes([]byte{byte(ssql.Function_PART), 0}); got != nil {
+			t.Errorf("FromBytes() = %v, want nil", got)
+		}
+	})
+}
+
+// truncated tests FromBytes of every prefix of buf returns nil
+func truncated(t *testing.T, buf []byte) {
+	t.Helper()
+
+	for i := 0; i < len(buf); i++ {
+		if got := FromBytes(buf[:i]); got != nil {
+			t.Fatalf("FromBytes() of %v bytes = %v, want nil", i, got)
+		}
+	}
+}
@@ -36,9 +36,13 @@ func NewAverage() Aggregator {
 }
 
 func DecodeAverage(data []byte) Aggregator {
+	if len(data) < 16 {
+		return nil
+	}
+
 	return &average{
-		sum:   math.Float64frombits(binary.LittleEndian.Uint64(data[1:9])),
-		count: binary.LittleEndian.Uint64(data[9:]),
+		sum:   math.Float64frombits(binary.LittleEndian.Uint64(data[:8])),
+		count: binary.LittleEndian.Uint64(data[8:16]),
 	}
 }
 
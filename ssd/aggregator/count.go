@@ -34,10 +34,11 @@ func NewCount() Aggregator {
 }
 
 func DecodeCount(data []byte) Aggregator {
-	var v int64
-	binary.LittleEndian.Uint64(data[:8])
+	if len(data) < 8 {
+		return nil
+	}
 
-	return &count{v}
+	return &count{int64(binary.LittleEndian.Uint64(data[:8]))}
 }
 
 func (c *count) StepInt64(int64) {
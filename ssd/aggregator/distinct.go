@@ -0,0 +1,234 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package aggregator
+
+import (
+	"encoding/binary"
+	"math"
+	"math/bits"
+
+	"github.com/chronowave/chronowave/ssql"
+
+	"github.com/cespare/xxhash/v2"
+)
+
+const (
+	// DefaultExact is number of distinct values counted exactly before switching to HyperLogLog
+	DefaultExact = 1 << 12
+
+	// HyperLogLog of 2^14 registers, standard error is 1.04/sqrt(2^14), about 0.8%
+	hllPrecision = 14
+	hllRegisters = 1 << hllPrecision
+
+	exactMode = 0
+	hllMode   = 1
+)
+
+// distinct counts distinct values by their 64 bits hash, exactly up to limit of values, and by
+// HyperLogLog beyond
+type distinct struct {
+	limit     uint32
+	exact     map[uint64]struct{}
+	registers []uint8
+}
+
+// NewDistinct counts up to limit distinct values exactly, default to DefaultExact if limit is not
+// positive
+func NewDistinct(limit int64) Aggregator {
+	if limit <= 0 || limit > math.MaxUint32 {
+		limit = DefaultExact
+	}
+
+	return &distinct{limit: uint32(limit), exact: map[uint64]struct{}{}}
+}
+
+// DecodeDistinct decodes limit (4) + mode (1) + number of hashes (4) and hashes (8) each in exact
+// mode, or registers in HyperLogLog mode, returns nil if data is truncated
+func DecodeDistinct(data []byte) Aggregator {
+	if len(data) < 5 {
+		return nil
+	}
+
+	d := &distinct{limit: binary.LittleEndian.Uint32(data[:4])}
+	if data[4] == hllMode {
+		if len(data) < 5+hllRegisters {
+			return nil
+		}
+		d.registers = make([]uint8, hllRegisters)
+		copy(d.registers, data[5:])
+		return d
+	}
+
+	if data[4] != exactMode || len(data) < 9 {
+		return nil
+	}
+	sz := binary.LittleEndian.Uint32(data[5:9])
+	if uint64(len(data)-9) < uint64(sz)*8 {
+		return nil
+	}
+	d.exact = make(map[uint64]struct{}, sz)
+	for data = data[9:]; sz > 0; sz-- {
+		d.exact[binary.LittleEndian.Uint64(data[:8])] = struct{}{}
+		data = data[8:]
+	}
+
+	return d
+}
+
+func (d *distinct) StepInt64(v int64) {
+	var buf [9]byte
+	buf[0] = 'i'
+	binary.LittleEndian.PutUint64(buf[1:], uint64(v))
+	d.add(xxhash.Sum64(buf[:]))
+}
+
+func (d *distinct) StepFloat64(v float64) {
+	if v == math.Trunc(v) && math.Abs(v) < 1<<63 {
+		// a real number of integer value is the same as the integer
+		d.StepInt64(int64(v))
+		return
+	}
+
+	var buf [9]byte
+	buf[0] = 'f'
+	binary.LittleEndian.PutUint64(buf[1:], math.Float64bits(v))
+	d.add(xxhash.Sum64(buf[:]))
+}
+
+// StepBytes counts text, boolean and JSON values
+func (d *distinct) StepBytes(v []byte) {
+	digest := xxhash.New()
+	digest.Write([]byte{'t'})
+	digest.Write(v)
+	d.add(digest.Sum64())
+}
+
+func (d *distinct) DoneInt() int64 {
+	return int64(math.Round(d.DoneFloat(0)))
+}
+
+func (d *distinct) DoneFloat(float64) float64 {
+	if d.registers == nil {
+		return float64(len(d.exact))
+	}
+
+	var (
+		sum   float64
+		zeros int
+		m     = float64(hllRegisters)
+	)
+	for _, r := range d.registers {
+		sum += 1 / float64(uint64(1)<<r)
+		if r == 0 {
+			zeros++
+		}
+	}
+
+	estimate := 0.7213 / (1 + 1.079/m) * m * m / sum
+	if estimate <= 2.5*m && zeros > 0 {
+		// linear counting of small cardinality
+		estimate = m * math.Log(m/float64(zeros))
+	}
+
+	return math.Round(estimate)
+}
+
+func (d *distinct) Bytes() []byte {
+	var buf []byte
+	if d.registers == nil {
+		buf = make([]byte, 10+len(d.exact)*8)
+		binary.LittleEndian.PutUint32(buf[6:10], uint32(len(d.exact)))
+		i := 10
+		for h := range d.exact {
+			binary.LittleEndian.PutUint64(buf[i:i+8], h)
+			i += 8
+		}
+	} else {
+		buf = make([]byte, 6+hllRegisters)
+		buf[5] = hllMode
+		copy(buf[6:], d.registers)
+	}
+
+	buf[0] = byte(ssql.Function_COUNT_DISTINCT)
+	binary.LittleEndian.PutUint32(buf[1:5], d.limit)
+	return buf
+}
+
+func (d *distinct) MergeBytes(data []byte) {
+	if data[0] == byte(ssql.Function_COUNT_DISTINCT) {
+		d.Merge(DecodeDistinct(data[1:]))
+	}
+}
+
+func (d *distinct) Merge(f Aggregator) {
+	o, ok := f.(*distinct)
+	if !ok {
+		return
+	}
+
+	if o.registers == nil {
+		for h := range o.exact {
+			d.add(h)
+		}
+		return
+	}
+
+	d.estimate()
+	for i, r := range o.registers {
+		if r > d.registers[i] {
+			d.registers[i] = r
+		}
+	}
+}
+
+func (d *distinct) add(h uint64) {
+	if d.registers != nil {
+		d.register(h)
+		return
+	}
+
+	d.exact[h] = struct{}{}
+	if uint32(len(d.exact)) > d.limit {
+		d.estimate()
+	}
+}
+
+// estimate switches to HyperLogLog
+func (d *distinct) estimate() {
+	if d.registers != nil {
+		return
+	}
+
+	d.registers = make([]uint8, hllRegisters)
+	for h := range d.exact {
+		d.register(h)
+	}
+	d.exact = nil
+}
+
+// register keeps max rank of hash in register of its first hllPrecision bits, rank is position of
+// the first 1 bit in the rest
+func (d *distinct) register(h uint64) {
+	idx := h >> (64 - hllPrecision)
+	rank := uint8(bits.LeadingZeros64(h<<hllPrecision|1<<(hllPrecision-1))) + 1
+	if rank > d.registers[idx] {
+		d.registers[idx] = rank
+	}
+}
@@ -0,0 +1,101 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package aggregator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDistinctBytes(t *testing.T) {
+	tests := []struct {
+		name   string
+		limit  int64
+		values int
+		hll    bool
+	}{
+		{"empty", 0, 0, false},
+		{"exact", 0, 100, false},
+		{"exact of limit", 10, 10, false},
+		{"hyperloglog", 10, 11, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDistinct(tt.limit)
+			for v := 0; v < tt.values; v++ {
+				d.StepInt64(int64(v))
+			}
+			if got := d.(*distinct).registers != nil; got != tt.hll {
+				t.Fatalf("HyperLogLog = %v, want %v", got, tt.hll)
+			}
+
+			buf := d.Bytes()
+			got := FromBytes(buf)
+			if !reflect.DeepEqual(got, d) {
+				t.Errorf("FromBytes() = %v, want %v", got, d)
+			}
+			truncated(t, buf)
+		})
+	}
+}
+
+func TestDistinctMerge(t *testing.T) {
+	tests := []struct {
+		name string
+		// values stepped by each aggregator, the same value may be stepped by both
+		from, to [2]int
+		limit    int64
+		hll      bool
+	}{
+		{"exact", [2]int{0, 6}, [2]int{3, 9}, 10, false},
+		{"exact to hyperloglog", [2]int{0, 6}, [2]int{6, 12}, 10, true},
+		{"hyperloglog to exact", [2]int{0, 11}, [2]int{11, 12}, 10, true},
+		{"hyperloglog", [2]int{0, 1000}, [2]int{500, 1500}, 10, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want := NewDistinct(tt.limit)
+			a, b := NewDistinct(tt.limit), NewDistinct(tt.limit)
+			for v := tt.from[0]; v < tt.from[1]; v++ {
+				a.StepInt64(int64(v))
+				want.StepInt64(int64(v))
+			}
+			for v := tt.to[0]; v < tt.to[1]; v++ {
+				b.StepInt64(int64(v))
+				want.StepInt64(int64(v))
+			}
+
+			// merged of the other way around is the same
+			other := FromBytes(b.Bytes())
+			other.MergeBytes(a.Bytes())
+			a.Merge(b)
+
+			if got := a.(*distinct).registers != nil; got != tt.hll {
+				t.Fatalf("HyperLogLog = %v, want %v", got, tt.hll)
+			}
+			if !reflect.DeepEqual(a, want) {
+				t.Errorf("Merge() = %v, want %v", a.DoneFloat(0), want.DoneFloat(0))
+			}
+			if !reflect.DeepEqual(other, want) {
+				t.Errorf("MergeBytes() = %v, want %v", other.DoneFloat(0), want.DoneFloat(0))
+			}
+		})
+	}
+}
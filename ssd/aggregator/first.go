@@ -0,0 +1,177 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package aggregator
+
+import (
+	"encoding/binary"
+	"math"
+	"strconv"
+
+	"github.com/chronowave/chronowave/ssql"
+)
+
+const (
+	intValue = iota
+	floatValue
+	jsonValue
+)
+
+// first keeps value of the earliest timestamp, or the latest if last. Of the same timestamp, the
+// value stepped or merged first is kept.
+type first struct {
+	last bool
+	// timestamp of next value
+	at    int64
+	timed bool
+
+	set  bool
+	ts   int64
+	kind byte
+	num  uint64
+	data []byte
+}
+
+// NewFirst returns FIRST, or LAST if last
+func NewFirst(last bool) Aggregator {
+	return &first{last: last}
+}
+
+// DecodeFirst decodes set (1) + timestamp (8) + kind of value (1) + number (8) + JSON size (4) + JSON,
+// returns nil if data is truncated
+func DecodeFirst(last bool, data []byte) Aggregator {
+	if len(data) == 0 {
+		return nil
+	}
+
+	f := &first{last: last, set: data[0] == 1}
+	if f.set {
+		if len(data) < 22 {
+			return nil
+		}
+		f.ts = int64(binary.LittleEndian.Uint64(data[1:9]))
+		f.kind = data[9]
+		f.num = binary.LittleEndian.Uint64(data[10:18])
+		sz := binary.LittleEndian.Uint32(data[18:22])
+		if uint64(len(data)-22) < uint64(sz) {
+			return nil
+		}
+		f.data = append([]byte(nil), data[22:22+sz]...)
+	}
+
+	return f
+}
+
+func (f *first) At(ts int64) {
+	f.at, f.timed = ts, true
+}
+
+func (f *first) StepInt64(v int64) {
+	f.step(intValue, uint64(v), nil)
+}
+
+func (f *first) StepFloat64(v float64) {
+	f.step(floatValue, math.Float64bits(v), nil)
+}
+
+func (f *first) StepBytes(v []byte) {
+	f.step(jsonValue, 0, v)
+}
+
+func (f *first) step(kind byte, num uint64, data []byte) {
+	if !f.timed {
+		return
+	}
+	f.timed = false
+
+	if f.keeps(f.at) {
+		f.set, f.ts, f.kind, f.num = true, f.at, kind, num
+		f.data = append(f.data[:0], data...)
+	}
+}
+
+// keeps returns true if value of timestamp ts replaces the current one
+func (f *first) keeps(ts int64) bool {
+	return !f.set || (f.last && ts > f.ts) || (!f.last && ts < f.ts)
+}
+
+func (f *first) DoneInt() int64 {
+	return int64(f.DoneFloat(0))
+}
+
+// DoneFloat returns NaN if value is not a number
+func (f *first) DoneFloat(float64) float64 {
+	switch {
+	case !f.set:
+	case f.kind == intValue:
+		return float64(int64(f.num))
+	case f.kind == floatValue:
+		return math.Float64frombits(f.num)
+	}
+
+	return math.NaN()
+}
+
+// MarshalJSON returns value in JSON, null if there is none
+func (f *first) MarshalJSON() ([]byte, error) {
+	switch {
+	case !f.set:
+	case f.kind == intValue:
+		return strconv.AppendInt(nil, int64(f.num), 10), nil
+	case f.kind == floatValue:
+		return strconv.AppendFloat(nil, math.Float64frombits(f.num), 'f', -1, 64), nil
+	default:
+		return f.data, nil
+	}
+
+	return []byte("null"), nil
+}
+
+func (f *first) Bytes() []byte {
+	fn := ssql.Function_FIRST
+	if f.last {
+		fn = ssql.Function_LAST
+	}
+
+	if !f.set {
+		return []byte{byte(fn), 0}
+	}
+
+	buf := make([]byte, 23+len(f.data))
+	buf[0], buf[1] = byte(fn), 1
+	binary.LittleEndian.PutUint64(buf[2:10], uint64(f.ts))
+	buf[10] = f.kind
+	binary.LittleEndian.PutUint64(buf[11:19], f.num)
+	binary.LittleEndian.PutUint32(buf[19:23], uint32(len(f.data)))
+	copy(buf[23:], f.data)
+	return buf
+}
+
+func (f *first) MergeBytes(data []byte) {
+	if (data[0] == byte(ssql.Function_FIRST) && !f.last) || (data[0] == byte(ssql.Function_LAST) && f.last) {
+		f.Merge(DecodeFirst(f.last, data[1:]))
+	}
+}
+
+func (f *first) Merge(m Aggregator) {
+	if o, ok := m.(*first); ok && o.last == f.last && o.set && f.keeps(o.ts) {
+		f.set, f.ts, f.kind, f.num = true, o.ts, o.kind, o.num
+		f.data = append(f.data[:0], o.data...)
+	}
+}
@@ -0,0 +1,109 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package aggregator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFirstBytes(t *testing.T) {
+	tests := []struct {
+		name string
+		last bool
+		step func(f Aggregator)
+	}{
+		{"unset", false, func(Aggregator) {}},
+		{"int", false, func(f Aggregator) { f.StepInt64(-7) }},
+		{"float", true, func(f Aggregator) { f.StepFloat64(1.5) }},
+		{"json", true, func(f Aggregator) { f.(BytesStepper).StepBytes([]byte(`{"a":"b"}`)) }},
+		{"empty json", false, func(f Aggregator) { f.(BytesStepper).StepBytes(nil) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewFirst(tt.last)
+			f.(TimeStepper).At(42)
+			tt.step(f)
+			// timestamp of the next value is not encoded
+			f.(*first).at, f.(*first).timed = 0, false
+
+			buf := f.Bytes()
+			got := FromBytes(buf)
+			if gotJSON, wantJSON := got.(*first).data, f.(*first).data; len(gotJSON) == 0 && len(wantJSON) == 0 {
+				// nil and empty JSON are the same
+				got.(*first).data = wantJSON
+			}
+			if !reflect.DeepEqual(got, f) {
+				t.Errorf("FromBytes() = %+v, want %+v", got, f)
+			}
+			truncated(t, buf)
+		})
+	}
+}
+
+func TestFirstMerge(t *testing.T) {
+	tests := []struct {
+		name string
+		last bool
+		// timestamps of values 1 and 2, 0 if unset
+		ts   [2]int64
+		want float64
+	}{
+		{"first", false, [2]int64{20, 10}, 2},
+		{"first kept", false, [2]int64{10, 20}, 1},
+		{"last", true, [2]int64{10, 20}, 2},
+		{"last kept", true, [2]int64{20, 10}, 1},
+		{"same timestamp", false, [2]int64{10, 10}, 1},
+		{"unset", false, [2]int64{0, 10}, 2},
+		{"to unset", true, [2]int64{10, 0}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := NewFirst(tt.last), NewFirst(tt.last)
+			for i, f := range []Aggregator{a, b} {
+				if tt.ts[i] > 0 {
+					f.(TimeStepper).At(tt.ts[i])
+					f.StepInt64(int64(i + 1))
+				}
+			}
+
+			other := FromBytes(a.Bytes())
+			other.MergeBytes(b.Bytes())
+			a.Merge(b)
+
+			for _, got := range []Aggregator{a, other} {
+				if got.DoneFloat(0) != tt.want {
+					t.Errorf("Merge() = %v, want %v", got.DoneFloat(0), tt.want)
+				}
+			}
+		})
+	}
+
+	t.Run("of last", func(t *testing.T) {
+		f, l := NewFirst(false), NewFirst(true)
+		l.(TimeStepper).At(1)
+		l.StepInt64(1)
+		f.MergeBytes(l.Bytes())
+		f.Merge(l)
+		if f.(*first).set {
+			t.Errorf("Merge() = %v, want unset", f.DoneFloat(0))
+		}
+	})
+}
@@ -0,0 +1,257 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package aggregator
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/chronowave/chronowave/ssql"
+)
+
+const (
+	// centroids of HISTOGRAM of n buckets are histogramFactor * n, and at least histogramMin
+	histogramFactor = 8
+	histogramMin    = 64
+	histogramMax    = 1 << 16
+)
+
+// histogram counts numbers in buckets of equal width between min and max. Numbers are kept in
+// centroids by streaming histogram of Ben-Haim and Tom-Tov, the closest two of them are merged
+// once there are capacity of them, and centroids are counted in buckets of their means.
+type histogram struct {
+	buckets   uint32
+	capacity  int
+	min, max  float64
+	centroids []centroid
+}
+
+type centroid struct {
+	mean  float64
+	count float64
+}
+
+// NewHistogram returns histogram of buckets
+func NewHistogram(buckets int64) Aggregator {
+	if buckets <= 0 || buckets > histogramMax {
+		buckets = 1
+	}
+
+	h := &histogram{buckets: uint32(buckets), capacity: int(buckets) * histogramFactor, min: math.Inf(1), max: math.Inf(-1)}
+	if h.capacity < histogramMin {
+		h.capacity = histogramMin
+	}
+
+	return h
+}
+
+// DecodeHistogram decodes buckets (4) + min (8) + max (8) + number of centroids (4) and mean (8) +
+// count (8) each, returns nil if data is truncated
+func DecodeHistogram(data []byte) Aggregator {
+	if len(data) < 24 {
+		return nil
+	}
+
+	h := NewHistogram(int64(binary.LittleEndian.Uint32(data[:4]))).(*histogram)
+	h.min = math.Float64frombits(binary.LittleEndian.Uint64(data[4:12]))
+	h.max = math.Float64frombits(binary.LittleEndian.Uint64(data[12:20]))
+	sz := binary.LittleEndian.Uint32(data[20:24])
+	if uint64(len(data)-24) < uint64(sz)*16 {
+		return nil
+	}
+	h.centroids = make([]centroid, sz)
+	for i, data := 0, data[24:]; i < int(sz); i, data = i+1, data[16:] {
+		h.centroids[i].mean = math.Float64frombits(binary.LittleEndian.Uint64(data[:8]))
+		h.centroids[i].count = math.Float64frombits(binary.LittleEndian.Uint64(data[8:16]))
+	}
+
+	return h
+}
+
+func (h *histogram) StepInt64(v int64) {
+	h.StepFloat64(float64(v))
+}
+
+func (h *histogram) StepFloat64(v float64) {
+	if v < h.min {
+		h.min = v
+	}
+	if v > h.max {
+		h.max = v
+	}
+
+	i := sort.Search(len(h.centroids), func(i int) bool { return h.centroids[i].mean >= v })
+	if i < len(h.centroids) && h.centroids[i].mean == v {
+		h.centroids[i].count++
+		return
+	}
+
+	h.centroids = append(h.centroids, centroid{})
+	copy(h.centroids[i+1:], h.centroids[i:])
+	h.centroids[i] = centroid{mean: v, count: 1}
+	h.shrink()
+}
+
+// StepBytes ignores values other than numbers
+func (h *histogram) StepBytes([]byte) {
+}
+
+// shrink merges the closest centroids until there are at most capacity of them
+func (h *histogram) shrink() {
+	for len(h.centroids) > h.capacity {
+		closest := 0
+		for i := 1; i < len(h.centroids)-1; i++ {
+			if h.centroids[i+1].mean-h.centroids[i].mean < h.centroids[closest+1].mean-h.centroids[closest].mean {
+				closest = i
+			}
+		}
+
+		x, y := h.centroids[closest], h.centroids[closest+1]
+		count := x.count + y.count
+		h.centroids[closest] = centroid{mean: (x.mean*x.count + y.mean*y.count) / count, count: count}
+		h.centroids = append(h.centroids[:closest+1], h.centroids[closest+2:]...)
+	}
+}
+
+// counts returns count of numbers in each bucket
+func (h *histogram) counts() []float64 {
+	counts := make([]float64, h.buckets)
+	width := (h.max - h.min) / float64(h.buckets)
+	for _, c := range h.centroids {
+		i := len(counts) - 1
+		if width > 0 && c.mean < h.max {
+			i = int((c.mean - h.min) / width)
+			if i >= len(counts) {
+				i = len(counts) - 1
+			}
+		}
+		counts[i] += c.count
+	}
+
+	return counts
+}
+
+func (h *histogram) DoneInt() int64 {
+	return int64(h.DoneFloat(0))
+}
+
+// DoneFloat returns count of numbers
+func (h *histogram) DoneFloat(float64) float64 {
+	total := 0.0
+	for _, c := range h.centroids {
+		total += c.count
+	}
+
+	return total
+}
+
+// MarshalJSON returns buckets with their lower and upper bounds and counts, a single bucket of min
+// and max if numbers are the same
+func (h *histogram) MarshalJSON() ([]byte, error) {
+	if len(h.centroids) == 0 {
+		return []byte("[]"), nil
+	}
+
+	counts := h.counts()
+	if h.max == h.min {
+		counts = counts[len(counts)-1:]
+	}
+
+	var w bytes.Buffer
+	w.WriteByte('[')
+	width := (h.max - h.min) / float64(len(counts))
+	for i, c := range counts {
+		if i > 0 {
+			w.WriteByte(',')
+		}
+		upper := h.min + float64(i+1)*width
+		if i == len(counts)-1 {
+			upper = h.max
+		}
+		w.WriteString(`{"lower":`)
+		w.WriteString(strconv.FormatFloat(h.min+float64(i)*width, 'f', -1, 64))
+		w.WriteString(`,"upper":`)
+		w.WriteString(strconv.FormatFloat(upper, 'f', -1, 64))
+		w.WriteString(`,"count":`)
+		w.WriteString(strconv.FormatFloat(math.Round(c), 'f', -1, 64))
+		w.WriteByte('}')
+	}
+	w.WriteByte(']')
+
+	return w.Bytes(), nil
+}
+
+func (h *histogram) Bytes() []byte {
+	buf := make([]byte, 25+len(h.centroids)*16)
+	buf[0] = byte(ssql.Function_HISTOGRAM)
+	binary.LittleEndian.PutUint32(buf[1:5], h.buckets)
+	binary.LittleEndian.PutUint64(buf[5:13], math.Float64bits(h.min))
+	binary.LittleEndian.PutUint64(buf[13:21], math.Float64bits(h.max))
+	binary.LittleEndian.PutUint32(buf[21:25], uint32(len(h.centroids)))
+	for i, c := range h.centroids {
+		j := 25 + i*16
+		binary.LittleEndian.PutUint64(buf[j:j+8], math.Float64bits(c.mean))
+		binary.LittleEndian.PutUint64(buf[j+8:j+16], math.Float64bits(c.count))
+	}
+
+	return buf
+}
+
+func (h *histogram) MergeBytes(data []byte) {
+	if data[0] == byte(ssql.Function_HISTOGRAM) {
+		h.Merge(DecodeHistogram(data[1:]))
+	}
+}
+
+func (h *histogram) Merge(f Aggregator) {
+	o, ok := f.(*histogram)
+	if !ok || len(o.centroids) == 0 {
+		return
+	}
+
+	if o.min < h.min {
+		h.min = o.min
+	}
+	if o.max > h.max {
+		h.max = o.max
+	}
+
+	merged := make([]centroid, 0, len(h.centroids)+len(o.centroids))
+	i, j := 0, 0
+	for i < len(h.centroids) || j < len(o.centroids) {
+		switch {
+		case j == len(o.centroids) || (i < len(h.centroids) && h.centroids[i].mean < o.centroids[j].mean):
+			merged = append(merged, h.centroids[i])
+			i++
+		case i == len(h.centroids) || o.centroids[j].mean < h.centroids[i].mean:
+			merged = append(merged, o.centroids[j])
+			j++
+		default:
+			merged = append(merged, centroid{mean: h.centroids[i].mean, count: h.centroids[i].count + o.centroids[j].count})
+			i, j = i+1, j+1
+		}
+	}
+
+	h.centroids = merged
+	h.shrink()
+}
@@ -0,0 +1,102 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package aggregator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestHistogramBytes(t *testing.T) {
+	tests := []struct {
+		name   string
+		values int
+	}{
+		{"empty", 0},
+		{"values", 10},
+		{"of capacity", 1000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := NewHistogram(4)
+			for v := 0; v < tt.values; v++ {
+				h.StepFloat64(float64(v%100) / 4)
+			}
+
+			buf := h.Bytes()
+			got := FromBytes(buf)
+			if len(h.(*histogram).centroids) == 0 {
+				// no centroid is the same as empty centroids
+				got.(*histogram).centroids = h.(*histogram).centroids
+			}
+			if !reflect.DeepEqual(got, h) {
+				t.Errorf("FromBytes() = %v, want %v", got, h)
+			}
+			truncated(t, buf)
+		})
+	}
+}
+
+func TestHistogramMerge(t *testing.T) {
+	tests := []struct {
+		name string
+		// values stepped by each aggregator
+		from, to [2]int
+		// number of centroids merged
+		centroids int
+	}{
+		{"same values", [2]int{0, 10}, [2]int{0, 10}, 10},
+		{"different values", [2]int{0, 10}, [2]int{10, 30}, 30},
+		{"empty", [2]int{0, 0}, [2]int{0, 10}, 10},
+		{"to empty", [2]int{0, 10}, [2]int{0, 0}, 10},
+		{"capacity", [2]int{0, histogramMin}, [2]int{histogramMin, 2 * histogramMin}, histogramMin},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := NewHistogram(1), NewHistogram(1)
+			for v := tt.from[0]; v < tt.from[1]; v++ {
+				a.StepInt64(int64(v))
+			}
+			for v := tt.to[0]; v < tt.to[1]; v++ {
+				b.StepInt64(int64(v))
+			}
+
+			other := FromBytes(b.Bytes())
+			other.MergeBytes(a.Bytes())
+			a.Merge(b)
+
+			count := float64(tt.from[1] - tt.from[0] + tt.to[1] - tt.to[0])
+			min, max := float64(tt.from[0]), float64(tt.to[1]-1)
+			if tt.from[1] == 0 {
+				min = float64(tt.to[0])
+			}
+			if tt.to[1] == 0 {
+				max = float64(tt.from[1] - 1)
+			}
+			for _, got := range []Aggregator{a, other} {
+				h := got.(*histogram)
+				if len(h.centroids) != tt.centroids || h.DoneFloat(0) != count || h.min != min || h.max != max {
+					t.Errorf("Merge() = %v centroids of %v values in [%v, %v], want %v of %v in [%v, %v]",
+						len(h.centroids), h.DoneFloat(0), h.min, h.max, tt.centroids, count, min, max)
+				}
+			}
+		})
+	}
+}
@@ -35,6 +35,10 @@ func NewMax() Aggregator {
 }
 
 func DecodeMax(data []byte) Aggregator {
+	if len(data) < 8 {
+		return nil
+	}
+
 	v := binary.LittleEndian.Uint64(data[:8])
 	return &max{math.Float64frombits(v)}
 }
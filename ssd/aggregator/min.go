@@ -35,6 +35,10 @@ func NewMin() Aggregator {
 }
 
 func DecodeMin(data []byte) Aggregator {
+	if len(data) < 8 {
+		return nil
+	}
+
 	v := binary.LittleEndian.Uint64(data[:8])
 	return &min{math.Float64frombits(v)}
 }
@@ -38,7 +38,7 @@ func NewPercentile() Aggregator {
 func DecodePercentile(data []byte) Aggregator {
 	hist, err := circonusllhist.Deserialize(bytes.NewReader(data))
 	if err != nil {
-		panic(err)
+		return nil
 	}
 
 	return &percentile{histogram: hist}
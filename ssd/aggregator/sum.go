@@ -35,6 +35,10 @@ func NewSum() Aggregator {
 }
 
 func DecodeSum(data []byte) Aggregator {
+	if len(data) < 8 {
+		return nil
+	}
+
 	v := binary.LittleEndian.Uint64(data[:8])
 	return &sum{math.Float64frombits(v)}
 }
@@ -0,0 +1,215 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package aggregator
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/chronowave/chronowave/ssql"
+)
+
+const (
+	// values counted by TOPK of k values are topKFactor * k, and at least topKMin
+	topKFactor = 10
+	topKMin    = 64
+)
+
+// topK counts the most frequent values by Space-Saving, a value of fewer occurrences is replaced
+// by a new value once there are capacity of them, so counts are upper bounds of occurrences
+type topK struct {
+	k        uint32
+	capacity int
+	counts   map[string]uint64
+}
+
+type valueCount struct {
+	value string
+	count uint64
+}
+
+// NewTopK returns the k most frequent values
+func NewTopK(k int64) Aggregator {
+	if k <= 0 || k > math.MaxUint32/topKFactor {
+		k = 1
+	}
+
+	t := &topK{k: uint32(k), capacity: int(k) * topKFactor}
+	if t.capacity < topKMin {
+		t.capacity = topKMin
+	}
+	t.counts = make(map[string]uint64, t.capacity)
+
+	return t
+}
+
+// DecodeTopK decodes k (4) + number of values (4) and count (8) + value size (4) + value in JSON
+// each, returns nil if data is truncated
+func DecodeTopK(data []byte) Aggregator {
+	if len(data) < 8 {
+		return nil
+	}
+
+	t := NewTopK(int64(binary.LittleEndian.Uint32(data[:4]))).(*topK)
+	sz := binary.LittleEndian.Uint32(data[4:8])
+	for data = data[8:]; sz > 0; sz-- {
+		if len(data) < 12 {
+			return nil
+		}
+		n := binary.LittleEndian.Uint32(data[8:12])
+		if uint64(len(data)-12) < uint64(n) {
+			return nil
+		}
+		t.counts[string(data[12:12+n])] = binary.LittleEndian.Uint64(data[:8])
+		data = data[12+n:]
+	}
+
+	return t
+}
+
+func (t *topK) StepInt64(v int64) {
+	t.step(strconv.FormatInt(v, 10))
+}
+
+func (t *topK) StepFloat64(v float64) {
+	t.step(strconv.FormatFloat(v, 'f', -1, 64))
+}
+
+func (t *topK) StepBytes(v []byte) {
+	t.step(string(v))
+}
+
+func (t *topK) step(value string) {
+	if _, ok := t.counts[value]; ok || len(t.counts) < t.capacity {
+		t.counts[value]++
+		return
+	}
+
+	// replace the least frequent value
+	var (
+		least string
+		min   = uint64(math.MaxUint64)
+	)
+	for v, c := range t.counts {
+		if c < min || (c == min && v < least) {
+			least, min = v, c
+		}
+	}
+	delete(t.counts, least)
+	t.counts[value] = min + 1
+}
+
+// top returns up to n values by count in descending order, then by value
+func (t *topK) top(n int) []valueCount {
+	top := make([]valueCount, 0, len(t.counts))
+	for v, c := range t.counts {
+		top = append(top, valueCount{v, c})
+	}
+	sort.Slice(top, func(i, j int) bool {
+		if top[i].count != top[j].count {
+			return top[i].count > top[j].count
+		}
+		return top[i].value < top[j].value
+	})
+
+	if len(top) > n {
+		top = top[:n]
+	}
+	return top
+}
+
+func (t *topK) DoneInt() int64 {
+	return int64(t.DoneFloat(0))
+}
+
+// DoneFloat returns count of the most frequent value
+func (t *topK) DoneFloat(float64) float64 {
+	if top := t.top(1); len(top) > 0 {
+		return float64(top[0].count)
+	}
+
+	return 0
+}
+
+// MarshalJSON returns the most frequent values with their counts
+func (t *topK) MarshalJSON() ([]byte, error) {
+	var w bytes.Buffer
+	w.WriteByte('[')
+	for i, vc := range t.top(int(t.k)) {
+		if i > 0 {
+			w.WriteByte(',')
+		}
+		w.WriteString(`{"value":`)
+		w.WriteString(vc.value)
+		w.WriteString(`,"count":`)
+		w.WriteString(strconv.FormatUint(vc.count, 10))
+		w.WriteByte('}')
+	}
+	w.WriteByte(']')
+
+	return w.Bytes(), nil
+}
+
+func (t *topK) Bytes() []byte {
+	sz := 9
+	for v := range t.counts {
+		sz += 12 + len(v)
+	}
+
+	buf := make([]byte, sz)
+	buf[0] = byte(ssql.Function_TOPK)
+	binary.LittleEndian.PutUint32(buf[1:5], t.k)
+	binary.LittleEndian.PutUint32(buf[5:9], uint32(len(t.counts)))
+	i := 9
+	for v, c := range t.counts {
+		binary.LittleEndian.PutUint64(buf[i:i+8], c)
+		binary.LittleEndian.PutUint32(buf[i+8:i+12], uint32(len(v)))
+		i += 12 + copy(buf[i+12:], v)
+	}
+
+	return buf
+}
+
+func (t *topK) MergeBytes(data []byte) {
+	if data[0] == byte(ssql.Function_TOPK) {
+		t.Merge(DecodeTopK(data[1:]))
+	}
+}
+
+// Merge adds counts of values, and keeps capacity of the most frequent values
+func (t *topK) Merge(f Aggregator) {
+	o, ok := f.(*topK)
+	if !ok {
+		return
+	}
+
+	for v, c := range o.counts {
+		t.counts[v] += c
+	}
+
+	if len(t.counts) > t.capacity {
+		for _, vc := range t.top(len(t.counts))[t.capacity:] {
+			delete(t.counts, vc.value)
+		}
+	}
+}
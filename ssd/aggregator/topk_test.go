@@ -0,0 +1,99 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package aggregator
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+func TestTopKBytes(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []string
+	}{
+		{"empty", nil},
+		{"values", []string{`"a"`, `"b"`, `"a"`, `1`, `""`}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := NewTopK(3)
+			for _, v := range tt.values {
+				k.(BytesStepper).StepBytes([]byte(v))
+			}
+
+			buf := k.Bytes()
+			if got := FromBytes(buf); !reflect.DeepEqual(got, k) {
+				t.Errorf("FromBytes() = %v, want %v", got, k)
+			}
+			truncated(t, buf)
+		})
+	}
+}
+
+func TestTopKMerge(t *testing.T) {
+	t.Run("counts", func(t *testing.T) {
+		a, b := NewTopK(2), NewTopK(2)
+		for _, v := range []int64{1, 1, 1, 2} {
+			a.StepInt64(v)
+		}
+		for _, v := range []int64{1, 1, 3} {
+			b.StepInt64(v)
+		}
+		a.MergeBytes(b.Bytes())
+
+		want := map[string]uint64{"1": 5, "2": 1, "3": 1}
+		if counts := a.(*topK).counts; !reflect.DeepEqual(counts, want) {
+			t.Errorf("MergeBytes() = %v, want %v", counts, want)
+		}
+	})
+
+	t.Run("capacity", func(t *testing.T) {
+		// capacity of TOPK of 1 is topKMin, each aggregator counts capacity of different values,
+		// value v of a is counted 2v+2 times, and value topKMin+v of b 2v+1 times
+		a, b := NewTopK(1), NewTopK(1)
+		for v := 0; v < topKMin; v++ {
+			for c := 0; c < 2*v+2; c++ {
+				a.StepInt64(int64(v))
+			}
+			for c := 0; c < 2*v+1; c++ {
+				b.StepInt64(int64(topKMin + v))
+			}
+		}
+
+		other := FromBytes(b.Bytes())
+		other.MergeBytes(a.Bytes())
+		a.Merge(b)
+
+		// the most frequent half of values of each are kept
+		want := map[string]uint64{}
+		for v := topKMin / 2; v < topKMin; v++ {
+			want[strconv.Itoa(v)] = uint64(2*v + 2)
+			want[strconv.Itoa(topKMin+v)] = uint64(2*v + 1)
+		}
+
+		for _, got := range []Aggregator{a, other} {
+			if counts := got.(*topK).counts; !reflect.DeepEqual(counts, want) {
+				t.Errorf("Merge() = %v, want %v", counts, want)
+			}
+		}
+	})
+}
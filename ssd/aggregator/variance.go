@@ -0,0 +1,117 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package aggregator
+
+import (
+	"encoding/binary"
+	"math"
+
+	"github.com/chronowave/chronowave/ssql"
+)
+
+// variance is sample variance, or standard deviation, by Welford's online algorithm, and merged
+// by Chan's parallel algorithm
+type variance struct {
+	fn    ssql.Function_Func
+	count uint64
+	mean  float64
+	m2    float64
+}
+
+// NewVariance returns variance of fn, either STDDEV or VARIANCE
+func NewVariance(fn ssql.Function_Func) Aggregator {
+	return &variance{fn: fn}
+}
+
+// DecodeVariance decodes count (8) + mean (8) + sum of squared differences from mean (8), returns
+// nil if data is truncated
+func DecodeVariance(fn ssql.Function_Func, data []byte) Aggregator {
+	if len(data) < 24 {
+		return nil
+	}
+
+	return &variance{
+		fn:    fn,
+		count: binary.LittleEndian.Uint64(data[:8]),
+		mean:  math.Float64frombits(binary.LittleEndian.Uint64(data[8:16])),
+		m2:    math.Float64frombits(binary.LittleEndian.Uint64(data[16:24])),
+	}
+}
+
+func (v *variance) StepInt64(x int64) {
+	v.StepFloat64(float64(x))
+}
+
+func (v *variance) StepFloat64(x float64) {
+	v.count++
+	delta := x - v.mean
+	v.mean += delta / float64(v.count)
+	v.m2 += delta * (x - v.mean)
+}
+
+// StepBytes ignores values other than numbers
+func (v *variance) StepBytes([]byte) {
+}
+
+func (v *variance) DoneInt() int64 {
+	return int64(v.DoneFloat(0))
+}
+
+// DoneFloat returns NaN of less than 2 values
+func (v *variance) DoneFloat(float64) float64 {
+	if v.count < 2 {
+		return math.NaN()
+	}
+
+	s2 := v.m2 / float64(v.count-1)
+	if v.fn == ssql.Function_STDDEV {
+		return math.Sqrt(s2)
+	}
+
+	return s2
+}
+
+func (v *variance) Bytes() []byte {
+	buf := make([]byte, 25)
+	buf[0] = byte(v.fn)
+	binary.LittleEndian.PutUint64(buf[1:9], v.count)
+	binary.LittleEndian.PutUint64(buf[9:17], math.Float64bits(v.mean))
+	binary.LittleEndian.PutUint64(buf[17:25], math.Float64bits(v.m2))
+	return buf
+}
+
+func (v *variance) MergeBytes(data []byte) {
+	if data[0] == byte(v.fn) && len(data) == 25 {
+		v.Merge(DecodeVariance(v.fn, data[1:]))
+	}
+}
+
+func (v *variance) Merge(f Aggregator) {
+	o, ok := f.(*variance)
+	if !ok || o.count == 0 {
+		return
+	}
+
+	count := v.count + o.count
+	delta := o.mean - v.mean
+	v.m2 += o.m2 + delta*delta*float64(v.count)*float64(o.count)/float64(count)
+	v.mean += delta * float64(o.count) / float64(count)
+	v.count = count
+}
@@ -0,0 +1,99 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package aggregator
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/chronowave/chronowave/ssql"
+)
+
+func TestVarianceBytes(t *testing.T) {
+	for _, fn := range []ssql.Function_Func{ssql.Function_STDDEV, ssql.Function_VARIANCE} {
+		t.Run(fn.String(), func(t *testing.T) {
+			v := NewVariance(fn)
+			for x := 0; x < 10; x++ {
+				v.StepFloat64(float64(x) / 3)
+			}
+
+			buf := v.Bytes()
+			if got := FromBytes(buf); !reflect.DeepEqual(got, v) {
+				t.Errorf("FromBytes() = %v, want %v", got, v)
+			}
+			truncated(t, buf)
+		})
+	}
+}
+
+func TestVarianceMerge(t *testing.T) {
+	tests := []struct {
+		name string
+		// numbers of values stepped by each aggregator
+		from, to int
+	}{
+		{"even", 50, 50},
+		{"uneven", 3, 97},
+		{"single", 1, 1},
+		{"empty", 0, 10},
+		{"to empty", 10, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// values of different means on each side
+			want, a, b := NewVariance(ssql.Function_VARIANCE), NewVariance(ssql.Function_VARIANCE), NewVariance(ssql.Function_VARIANCE)
+			for x := 0; x < tt.from; x++ {
+				a.StepFloat64(float64(x * x))
+				want.StepFloat64(float64(x * x))
+			}
+			for x := 0; x < tt.to; x++ {
+				b.StepFloat64(float64(1000 - x))
+				want.StepFloat64(float64(1000 - x))
+			}
+
+			other := FromBytes(b.Bytes())
+			other.MergeBytes(a.Bytes())
+			a.Merge(b)
+
+			w := want.(*variance)
+			for _, got := range []*variance{a.(*variance), other.(*variance)} {
+				if got.count != w.count || !equalFloat(got.mean, w.mean) || !equalFloat(got.m2, w.m2) {
+					t.Errorf("Merge() = %+v, want %+v", got, w)
+				}
+			}
+		})
+	}
+
+	t.Run("of other function", func(t *testing.T) {
+		v, s := NewVariance(ssql.Function_VARIANCE), NewVariance(ssql.Function_STDDEV)
+		s.StepFloat64(1)
+		s.StepFloat64(2)
+		v.MergeBytes(s.Bytes())
+		if got := v.(*variance).count; got != 0 {
+			t.Errorf("MergeBytes() count = %v, want 0", got)
+		}
+	})
+}
+
+// equalFloat returns true if x and y are equal within relative error of 1e-9
+func equalFloat(x, y float64) bool {
+	return math.Abs(x-y) <= 1e-9*math.Max(math.Abs(x), math.Abs(y))
+}
@@ -0,0 +1,125 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package ssd
+
+const (
+	// GramSize is length in bytes of n-grams of text added to Bloom filter
+	GramSize = 3
+
+	bloomBitsPerItem = 10
+	bloomHashes      = 7
+	// bloomMaxBits bounds filter to 128KB, false positive rate grows beyond it
+	bloomMaxBits = 1 << 20
+
+	bloomValue byte = 'v'
+	bloomGram  byte = 'g'
+)
+
+// Bloom is Bloom filter of text values of a JSON path and their n-grams
+type Bloom struct {
+	K    uint32
+	Bits []uint64
+}
+
+// NewBloom returns Bloom filter sized for n items, see BloomItems
+func NewBloom(n int) *Bloom {
+	m := n * bloomBitsPerItem
+	if m > bloomMaxBits {
+		m = bloomMaxBits
+	}
+
+	return &Bloom{K: bloomHashes, Bits: make([]uint64, m/64+1)}
+}
+
+// BloomItems returns number of items added to Bloom filter for text, the text and its n-grams
+func BloomItems(text []byte) int {
+	if len(text) < GramSize {
+		return 1
+	}
+
+	return len(text) - GramSize + 2
+}
+
+// AddText adds text value and its n-grams
+func (b *Bloom) AddText(text []byte) {
+	b.add(bloomValue, text)
+	for i := 0; i+GramSize <= len(text); i++ {
+		b.add(bloomGram, text[i:i+GramSize])
+	}
+}
+
+// HasValue tells whether text may be a value, false if it is not
+func (b *Bloom) HasValue(text []byte) bool {
+	return b.has(bloomValue, text)
+}
+
+// HasGrams tells whether text may be part of a value, false if any of its n-grams is not in filter.
+// text shorter than n-gram may be part of any value.
+func (b *Bloom) HasGrams(text []byte) bool {
+	for i := 0; i+GramSize <= len(text); i++ {
+		if !b.has(bloomGram, text[i:i+GramSize]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (b *Bloom) add(kind byte, data []byte) {
+	h1, h2 := bloomHash(kind, data)
+	m := uint32(len(b.Bits) * 64)
+	for i := uint32(0); i < b.K; i++ {
+		bit := (h1 + i*h2) % m
+		b.Bits[bit/64] |= 1 << (bit % 64)
+	}
+}
+
+func (b *Bloom) has(kind byte, data []byte) bool {
+	if len(b.Bits) == 0 {
+		return true
+	}
+
+	h1, h2 := bloomHash(kind, data)
+	m := uint32(len(b.Bits) * 64)
+	for i := uint32(0); i < b.K; i++ {
+		bit := (h1 + i*h2) % m
+		if b.Bits[bit/64]&(1<<(bit%64)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// bloomHash returns two hashes of FNV-1a of kind and data, item is hashed k times by combining them
+func bloomHash(kind byte, data []byte) (uint32, uint32) {
+	const (
+		offset = 14695981039346656037
+		prime  = 1099511628211
+	)
+
+	h := uint64(offset)
+	h = (h ^ uint64(kind)) * prime
+	for _, c := range data {
+		h = (h ^ uint64(c)) * prime
+	}
+
+	return uint32(h), uint32(h>>32) | 1
+}
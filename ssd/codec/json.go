@@ -41,13 +41,13 @@ func ParseJson(json []byte) (*ssd.ParsedBlock, error) {
 		// offset to json buffer, number of object,
 		var exec func(*ssd.ParsedBlock, [][]byte, []byte) (int, error)
 	open:
-		for _, b := range json {
+		for i, b := range json {
 			switch b {
 			case '{':
-				exec = parseObject
+				exec, json = parseObject, json[i:]
 				break open
 			case '[':
-				exec = parseArray
+				exec, json = parseArray, json[i:]
 				break open
 			}
 		}
@@ -82,10 +82,11 @@ func parseObject(f *ssd.ParsedBlock, path [][]byte, data []byte) (int, error) {
 	}
 
 	// eoo -> end of object, offset in json doc
-	eoo := 0
+	eoo, keys := 0, 0
 	handle := func(key []byte, value []byte, valueType jsonparser.ValueType, offset int) error {
 		eoo = offset
-		return parseObjectValue(f, valueType, append(path, replaceSlashWithUnderScore(key)), value)
+		keys++
+		return parseObjectValue(f, valueType, append(path, key), value)
 	}
 
 	err := jsonparser.ObjectEach(data, handle)
@@ -93,6 +94,16 @@ func parseObject(f *ssd.ParsedBlock, path [][]byte, data []byte) (int, error) {
 		return eoo, err
 	}
 
+	if keys == 0 {
+		// empty object has no attribute, it is kept as JSON value of its path
+		eoo = bytes.IndexByte(data, '}')
+		if f.Entity.Len() > 0 {
+			f.Entity.WriteByte(ssd.SOH)
+		}
+		f.Entity.Write(f.Meta.GenerateCode(path))
+		f.Entity.WriteByte(ssd.JSON)
+	}
+
 	return eoo, nil
 }
 
@@ -183,6 +194,10 @@ func parseObjectValue(f *ssd.ParsedBlock, valueType jsonparser.ValueType, path [
 				// encode as int64
 				f.Entity.WriteByte(ssd.INT64)
 				f.Columnar.Int64 = append(f.Columnar.Int64, v)
+			} else if v, e := strconv.ParseFloat(string(value), 64); e == nil {
+				// exponent or out of int64 range
+				f.Entity.WriteByte(ssd.FLT64)
+				f.Columnar.Float64 = append(f.Columnar.Float64, v)
 			} else {
 				err = e
 			}
@@ -209,15 +224,6 @@ func parseObjectValue(f *ssd.ParsedBlock, valueType jsonparser.ValueType, path [
 	return err
 }
 
-func replaceSlashWithUnderScore(key []byte) []byte {
-	for i, b := range key {
-		if b == '/' || b <= ssd.EOA {
-			key[i] = '_'
-		}
-	}
-	return key
-}
-
 func fragmentLongText(value []byte, fsz int) []byte {
 	i, fragments := len(value)-1, (len(value)-1)/fsz
 	if fragments > 0 {
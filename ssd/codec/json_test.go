@@ -39,8 +39,8 @@ func TestParseEmpty(t *testing.T) {
 		want result
 	}{
 		{"empty array", args{`[]`}, result{[]byte{}}},
-		{"empty object", args{`{}`}, result{[]byte{}}},
-		{"empty array object", args{`[{}]`}, result{[]byte{}}},
+		{"empty object", args{`{}`}, result{[]byte{ssd.JSON}}},
+		{"empty array object", args{`[{}]`}, result{[]byte{ssd.JSON}}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -41,6 +41,22 @@ func MarshalToJson(index *ssd.IndexedBlock, parent []byte, eav []internal.Attrib
 
 	attributes[0] = map[string]bool{}
 	for _, attr := range eav {
+		if attr.ValueType == ssd.AED {
+			// element delimiter of the innermost array
+			if depth > 0 {
+				for i := 0; i < len(prev[depth]); i++ {
+					w.WriteByte('}')
+				}
+				w.WriteByte(',')
+				diffs[depth] = 0
+				prev[depth] = nil
+				for k := range attributes[depth] {
+					delete(attributes[depth], k)
+				}
+			}
+			continue
+		}
+
 		path := index.Meta.GetPath(append(parent, attr.Code...))
 		path = path[len(parent):]
 		if depth > 0 {
@@ -69,15 +85,15 @@ func MarshalToJson(index *ssd.IndexedBlock, parent []byte, eav []internal.Attrib
 						w.WriteByte('}')
 					}
 					w.WriteByte(',')
+					prev[depth] = nil
+					for k := range attributes[depth] {
+						delete(attributes[depth], k)
+					}
 				}
 				diffs[depth] = attr.Offset
-				prev[depth] = nil
-				for k := range attributes[depth] {
-					delete(attributes[depth], k)
-				}
-			} else if attr.ValueType == ssd.SOA && len(prev[depth]) > 0 &&
-				bytes.Equal(prev[depth][len(prev[depth])-1], path[len(path)-1]) {
-				// nested
+			} else if attr.ValueType == ssd.SOA && len(prev[depth]) == len(path) && len(path) > 0 &&
+				align(prev[depth], path) == len(path) {
+				// nested, key repeats only in next element
 				for i := 0; i < len(prev[depth]); i++ {
 					w.WriteByte('}')
 				}
@@ -108,9 +124,7 @@ func MarshalToJson(index *ssd.IndexedBlock, parent []byte, eav []internal.Attrib
 		attributes[depth][key] = true
 		for ; aligned < len(path); aligned++ {
 			w.WriteByte(sep)
-			w.WriteByte('"')
-			w.Write(path[aligned])
-			w.WriteByte('"')
+			w.Write(ssd.AppendQuoted(nil, path[aligned]))
 			w.WriteByte(':')
 			sep = '{'
 		}
@@ -126,11 +140,21 @@ func MarshalToJson(index *ssd.IndexedBlock, parent []byte, eav []internal.Attrib
 			w.WriteByte('[')
 			continue
 		case ssd.TEXT:
-			w.WriteString(strconv.Quote(string(text[uint32(attr.Value)])))
+			// text is kept escaped as in JSON
+			w.WriteByte('"')
+			w.Write(text[uint32(attr.Value)])
+			w.WriteByte('"')
 		case ssd.FLT64:
 			w.WriteString(strconv.FormatFloat(math.Float64frombits(attr.Value), 'f', -1, 64))
 		case ssd.INT64:
 			w.WriteString(strconv.FormatInt(int64(attr.Value), 10))
+		case ssd.BOOL:
+			w.WriteString(strconv.FormatBool(attr.Value != 0))
+		case ssd.NULL:
+			w.WriteString("null")
+		case ssd.JSON:
+			// empty object
+			w.WriteString("{}")
 		}
 	}
 
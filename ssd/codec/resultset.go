@@ -21,6 +21,7 @@ package codec
 
 import (
 	"bytes"
+	"encoding/json"
 	"math"
 	"strconv"
 
@@ -65,6 +66,15 @@ func MarshalResultSet(rs *ssd.ResultSet, limit uint32) []byte {
 			} else {
 				switch rs.ColumnType[j] {
 				case 0:
+					if m, ok := rs.Aggregate[c.Value[i]].(json.Marshaler); ok {
+						// aggregate of values in JSON, e.g. FIRST and TOPK
+						if data, err := m.MarshalJSON(); err == nil {
+							w.Write(data)
+						} else {
+							w.WriteString("null")
+						}
+						break
+					}
 					v := math.Round(rs.Aggregate[c.Value[i]].DoneFloat(.5)*10000) / 10000
 					if math.IsNaN(v) {
 						w.WriteString("null")
@@ -22,6 +22,7 @@ package ssd
 import (
 	"bytes"
 	"encoding/gob"
+	"encoding/json"
 	"unicode/utf8"
 )
 
@@ -130,6 +131,119 @@ func (s *EntityMeta) GetPath(code []byte) [][]byte {
 	return path
 }
 
+// Walk visits every entity path with its UTF-8 code, parent before children
+func (s *EntityMeta) Walk(visit func(path [][]byte, code []byte)) {
+	var walk func(node *Node, path [][]byte, code []byte)
+	walk = func(node *Node, path [][]byte, code []byte) {
+		for _, child := range node.Children {
+			p, c := append(path[:len(path):len(path)], child.Name), append(code[:len(code):len(code)], child.Code...)
+			visit(p, c)
+			walk(child, p, c)
+		}
+	}
+	walk(&s.root, nil, nil)
+}
+
+// SplitPath splits JSON path, ie /a/"b/c", into keys of entity path. Key not being a plain name
+// is quoted as JSON string, escapes in the quotes are decoded.
+func SplitPath(path string) [][]byte {
+	var keys [][]byte
+	for i := 0; i < len(path); {
+		if path[i] == '/' {
+			i++
+			continue
+		}
+
+		j := i
+		if path[i] == '"' {
+			for j = i + 1; j < len(path) && path[j] != '"'; j++ {
+				if path[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(path) {
+				j = len(path) - 1
+			}
+
+			var key string
+			if err := json.Unmarshal([]byte(path[i:j+1]), &key); err != nil {
+				key = path[i+1 : j]
+			}
+			keys = append(keys, []byte(key))
+			i = j + 1
+			continue
+		}
+
+		for j < len(path) && path[j] != '/' {
+			j++
+		}
+		keys = append(keys, []byte(path[i:j]))
+		i = j
+	}
+
+	return keys
+}
+
+// JoinPath joins keys of entity path into JSON path, key not being a plain name is quoted so that
+// SplitPath returns the same keys
+func JoinPath(keys [][]byte) string {
+	if len(keys) == 0 {
+		return "/"
+	}
+
+	var path []byte
+	for _, k := range keys {
+		path = append(path, '/')
+		if isPlainKey(k) {
+			path = append(path, k...)
+		} else {
+			path = AppendQuoted(path, k)
+		}
+	}
+
+	return string(path)
+}
+
+// AppendQuoted appends key as JSON string in double quotes to dst
+func AppendQuoted(dst, key []byte) []byte {
+	const hex = "0123456789abcdef"
+
+	dst = append(dst, '"')
+	for _, b := range key {
+		switch {
+		case b == '"' || b == '\\':
+			dst = append(dst, '\\', b)
+		case b == '\n':
+			dst = append(dst, '\\', 'n')
+		case b == '\r':
+			dst = append(dst, '\\', 'r')
+		case b == '\t':
+			dst = append(dst, '\\', 't')
+		case b < 0x20:
+			dst = append(dst, '\\', 'u', '0', '0', hex[b>>4], hex[b&0xF])
+		default:
+			dst = append(dst, b)
+		}
+	}
+
+	return append(dst, '"')
+}
+
+// isPlainKey tells whether key is a NAME of SSQL
+func isPlainKey(key []byte) bool {
+	for i, b := range key {
+		letter := b >= 'a' && b <= 'z' || b >= 'A' && b <= 'Z' || b == '_'
+		if i == 0 && !letter {
+			return false
+		}
+		if !letter && !(b >= '0' && b <= '9') && b != '.' && b != '-' {
+			return false
+		}
+	}
+
+	return len(key) > 0
+}
+
 func matchPath(path []byte, nodes []*Node) *Node {
 	for _, n := range nodes {
 		if bytes.Compare(n.Name, path) == 0 {
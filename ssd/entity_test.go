@@ -116,3 +116,48 @@ func TestEntityMetaGetPath(t *testing.T) {
 		})
 	}
 }
+
+func TestSplitPath(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want [][]byte
+	}{
+		{"root", "/", nil},
+		{"plain", "/a/b", [][]byte{[]byte("a"), []byte("b")}},
+		{"quoted", `/"http/status"/code`, [][]byte{[]byte("http/status"), []byte("code")}},
+		{"escaped", `/"a\"/\u0001b"`, [][]byte{[]byte("a\"/\x01b")}},
+		{"empty key", `/""/a`, [][]byte{[]byte(""), []byte("a")}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := SplitPath(tt.path)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SplitPath() = %q, want %q", got, tt.want)
+			}
+			if back := SplitPath(JoinPath(got)); !reflect.DeepEqual(back, tt.want) {
+				t.Errorf("SplitPath(JoinPath()) = %q, want %q", back, tt.want)
+			}
+		})
+	}
+}
+
+func TestJoinPath(t *testing.T) {
+	tests := []struct {
+		name string
+		keys [][]byte
+		want string
+	}{
+		{"root", nil, "/"},
+		{"plain", [][]byte{[]byte("a_1"), []byte("b.c-d")}, "/a_1/b.c-d"},
+		{"slash", [][]byte{[]byte("http/status")}, `/"http/status"`},
+		{"space and digit", [][]byte{[]byte("a b"), []byte("1a")}, `/"a b"/"1a"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := JoinPath(tt.keys); got != tt.want {
+				t.Errorf("JoinPath() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
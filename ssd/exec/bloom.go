@@ -0,0 +1,146 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package exec
+
+import (
+	"bytes"
+
+	"github.com/chronowave/chronowave/ssql"
+
+	"github.com/chronowave/chronowave/ssd"
+	"github.com/chronowave/chronowave/ssd/operator"
+)
+
+// BloomExcludes tells whether WHERE of statement can't match any document of block by its Bloom
+// filters of text values, so that block is not decoded. It is the case when text of key, in or
+// contain predicate is in none of values of path. Block without filters is never excluded.
+func BloomExcludes(bloom map[string]*ssd.Bloom, stmt *ssql.Statement) bool {
+	if bloom == nil {
+		return false
+	}
+
+	return bloomExcludesAnd(bloom, stmt.Where)
+}
+
+func bloomExcludesAnd(bloom map[string]*ssd.Bloom, and []*ssql.Expr) bool {
+	for _, expr := range and {
+		if bloomExcludes(bloom, expr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func bloomExcludes(bloom map[string]*ssd.Bloom, expr *ssql.Expr) bool {
+	switch field := expr.Field.(type) {
+	case *ssql.Expr_Tuple:
+		return bloomExcludesTuple(bloom, field.Tuple)
+	case *ssql.Expr_Or:
+		for _, x := range field.Or.Expr {
+			if !bloomExcludes(bloom, x) {
+				return false
+			}
+		}
+		return len(field.Or.Expr) > 0
+	}
+
+	return false
+}
+
+func bloomExcludesTuple(bloom map[string]*ssd.Bloom, tuple *ssql.Tuple) bool {
+	if tuple.Predicate == nil {
+		// select only
+		return false
+	}
+
+	keys := ssd.SplitPath(tuple.Path)
+	if len(keys) == 0 {
+		return false
+	}
+
+	// path without filter has no text value
+	filter := bloom[ssd.JoinPath(keys)]
+	var patterns [][]byte
+	switch pred := tuple.Predicate.(type) {
+	case *ssql.Tuple_Key:
+		switch v := pred.Key.First.Value.(type) {
+		case *ssql.Operand_Text:
+			patterns = append(patterns, exactPattern(v.Text))
+		case *ssql.Operand_List:
+			if len(v.List.Int) > 0 {
+				return false
+			}
+			for _, t := range v.List.Text {
+				patterns = append(patterns, exactPattern(t))
+			}
+		}
+	case *ssql.Tuple_In:
+		// in matches only text values if list has text
+		if list, ok := pred.In.First.Value.(*ssql.Operand_List); ok {
+			for _, t := range list.List.Text {
+				patterns = append(patterns, []byte(t))
+			}
+		}
+	case *ssql.Tuple_Contain:
+		if text, ok := pred.Contain.First.Value.(*ssql.Operand_Text); ok {
+			patterns = append(patterns, []byte(text.Text))
+		}
+	}
+
+	if len(patterns) == 0 {
+		return false
+	}
+
+	for _, p := range patterns {
+		if mayContain(filter, p) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// mayContain tells whether a text value matching full text pattern may be in filter. anchored
+// pattern without wild card is looked up as value, otherwise every n-gram between wild cards must
+// be in filter.
+func mayContain(filter *ssd.Bloom, pattern []byte) bool {
+	sanitized, prefix, suffix := operator.SanitizePattern(pattern)
+	if len(sanitized) == 0 {
+		// empty pattern matches nothing
+		return false
+	}
+	if filter == nil {
+		return false
+	}
+
+	segments := bytes.Split(sanitized, []byte{ssd.SENTINEL})
+	if prefix && suffix && len(segments) == 1 {
+		return filter.HasValue(sanitized)
+	}
+
+	for _, s := range segments {
+		if !filter.HasGrams(s) {
+			return false
+		}
+	}
+
+	return true
+}
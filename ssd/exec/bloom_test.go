@@ -0,0 +1,98 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package exec
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/chronowave/chronowave/ssql/parser"
+
+	"github.com/chronowave/chronowave/ssd/codec"
+)
+
+func TestBloomExcludes(t *testing.T) {
+	long := strings.Repeat("abcdefghij", 30) + "tail"
+	parsed, err := codec.ParseJson([]byte(fmt.Sprintf(`{"traceID": "4bf92f3577b34da6", "msg": "GET /api/users took 12 ms", "n": 1}
+		{"traceID": "a3ce929d0e0e4736", "msg": "POST /api/orders", "long": "%v", "empty": ""}`, long)))
+	if err != nil {
+		t.Fatalf("ParseJson() error = %v", err)
+	}
+
+	index, err := buildTestIndex(parsed)
+	if err != nil {
+		t.Fatalf("buildTestIndex() error = %v", err)
+	}
+	if len(index.Bloom) != 4 {
+		t.Fatalf("Build() Bloom = %v, want filters of 4 paths", index.Bloom)
+	}
+
+	tests := []struct {
+		query    string
+		excludes bool
+	}{
+		{`find $a where [$a /traceID key("4bf92f3577b34da6")]`, false},
+		{`find $a where [$a /traceID key("00f067aa0ba902b7")]`, true},
+		{`find $a where [$a /traceID key("4bf92f3577b34da")]`, true},
+		{`find $a where [$a /traceID key("00f067aa0ba902b7", "a3ce929d0e0e4736")]`, false},
+		{`find $a where [$a /traceID key("00f067aa0ba902b7", "b7ad6b7169203331")]`, true},
+		{`find $a where [$a /traceID key(7, "b7ad6b7169203331")]`, false},
+		{`find $a where [$a /n key("1")]`, true},
+		{`find $a where [$a /n key(1)]`, false},
+		{`find $a where [$a /traceID] [/msg contain("users")]`, false},
+		{`find $a where [$a /traceID] [/msg contain("^GET*users")]`, false},
+		{`find $a where [$a /traceID] [/msg contain("DELETE*users")]`, true},
+		{`find $a where [$a /traceID] [/msg contain("^POST /api/orders$")]`, false},
+		{`find $a where [$a /traceID] [/msg contain("^POST /api/order$")]`, true},
+		{`find $a where [$a /traceID] [/msg contain("ms")]`, false},
+		{`find $a where [$a /traceID] [/long contain("jabc*tail$")]`, false},
+		{`find $a where [$a /traceID] [/long key("` + long + `")]`, false},
+		{`find $a where [$a /traceID] [/long contain("jabk")]`, true},
+		{`find $a where [$a /traceID] [/empty key("")]`, true},
+		{`find $a where [$a /traceID] [/msg in("PUT", "GET")]`, false},
+		{`find $a where [$a /traceID] [/msg in("PUT", "PATCH")]`, true},
+		{`find $a where [$a /traceID] [/none contain("x")]`, true},
+		{`find $a where [$a /traceID] {[/msg contain("PATCH")] [/traceID key("a3ce929d0e0e4736")]}`, false},
+		{`find $a where [$a /traceID] {[/msg contain("PATCH")] [/traceID key("b7ad6b7169203331")]}`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			stmt, e := parser.Parse(tt.query)
+			if len(e) > 0 {
+				t.Fatalf("ssql Parse() error = %v", e)
+			}
+
+			if got := BloomExcludes(index.Bloom, stmt); got != tt.excludes {
+				t.Errorf("BloomExcludes() = %v, want %v", got, tt.excludes)
+			}
+
+			// excluded block has no result
+			if rs := Exec(index, stmt); tt.excludes && len(rs.RowId) > 0 {
+				t.Errorf("Exec() = %v rows, excluded block has result", len(rs.RowId))
+			}
+		})
+	}
+
+	stmt, _ := parser.Parse(`find $a where [$a /traceID key("00f067aa0ba902b7")]`)
+	if BloomExcludes(nil, stmt) {
+		t.Errorf("BloomExcludes() = true, want block without filters is not excluded")
+	}
+}
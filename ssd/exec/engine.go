@@ -20,8 +20,9 @@
 package exec
 
 import (
-	"bytes"
+	"sort"
 	"sync"
+	"time"
 
 	ext "github.com/chronowave/ext/operator"
 
@@ -50,7 +51,7 @@ var (
 	empty []uint16
 )
 
-func eval(index *ssd.IndexedBlock, node map[string][]byte, stmt *ssql.Statement) []uint16 {
+func eval(index *ssd.IndexedBlock, node map[string][]byte, stmt *ssql.Statement, trace *Trace) []uint16 {
 	entity, ie := entityPool.Get().([]uint16), iePool.Get().([]uint32)
 	refEntity, refIE := entity, ie
 	defer func() {
@@ -63,7 +64,7 @@ func eval(index *ssd.IndexedBlock, node map[string][]byte, stmt *ssql.Statement)
 		entity[i] = uint16(i)
 	}
 
-	entity = evalAnd(index, node, stmt.Where, entity, ie)
+	entity = evalAnd(index, node, stmt.Where, entity, ie, trace)
 
 	clone := make([]uint16, len(entity))
 	copy(clone, entity)
@@ -72,7 +73,7 @@ func eval(index *ssd.IndexedBlock, node map[string][]byte, stmt *ssql.Statement)
 }
 
 func getKey(index *ssd.IndexedBlock, node map[string][]byte, tuple *ssql.Tuple) ([]byte, bool) {
-	path := bytes.Split([]byte(tuple.Path), []byte{'/'})
+	path := ssd.SplitPath(tuple.Path)
 	if len(tuple.Name) == 0 {
 		return index.Meta.GetCode(path)
 	}
@@ -91,9 +92,9 @@ func getKey(index *ssd.IndexedBlock, node map[string][]byte, tuple *ssql.Tuple)
 	return key, ok
 }
 
-func evalAnd(index *ssd.IndexedBlock, node map[string][]byte, and []*ssql.Expr, entity []uint16, ie []uint32) []uint16 {
-	// default is AND
-	for _, expr := range and {
+func evalAnd(index *ssd.IndexedBlock, node map[string][]byte, and []*ssql.Expr, entity []uint16, ie []uint32, trace *Trace) []uint16 {
+	// default is AND, in order of estimated cost
+	for _, expr := range planAnd(index, and) {
 		var selected []uint16
 		switch expr.Field.(type) {
 		case *ssql.Expr_Tuple:
@@ -103,12 +104,13 @@ func evalAnd(index *ssd.IndexedBlock, node map[string][]byte, and []*ssql.Expr,
 				// no predicate, select only
 				continue
 			} else if ok {
-				selected = evalTuple(index, node, key, expr.Field.(*ssql.Expr_Tuple).Tuple)
+				selected = evalTuple(index, node, key, expr.Field.(*ssql.Expr_Tuple).Tuple, trace)
 			} else {
 				selected = empty
+				trace.tuple(tuple, false, time.Now(), selected)
 			}
 		case *ssql.Expr_Or:
-			selected = evalOr(index, node, expr.Field.(*ssql.Expr_Or).Or.Expr)
+			selected = evalOr(index, node, expr.Field.(*ssql.Expr_Or).Or.Expr, trace)
 		}
 		cnt := ext.IntersectXUint16(entity, selected, ie)
 		if cnt == 0 {
@@ -124,7 +126,7 @@ func evalAnd(index *ssd.IndexedBlock, node map[string][]byte, and []*ssql.Expr,
 	return entity
 }
 
-func evalOr(index *ssd.IndexedBlock, node map[string][]byte, or []*ssql.Expr) []uint16 {
+func evalOr(index *ssd.IndexedBlock, node map[string][]byte, or []*ssql.Expr, trace *Trace) []uint16 {
 	entity := make([]bool, len(index.EntityID))
 	for _, expr := range or {
 		var selected []uint16
@@ -136,10 +138,12 @@ func evalOr(index *ssd.IndexedBlock, node map[string][]byte, or []*ssql.Expr) []
 				// no predicate, select only
 				continue
 			} else if ok {
-				selected = evalTuple(index, node, key, expr.Field.(*ssql.Expr_Tuple).Tuple)
+				selected = evalTuple(index, node, key, expr.Field.(*ssql.Expr_Tuple).Tuple, trace)
+			} else {
+				trace.tuple(tuple, false, time.Now(), selected)
 			}
 		case *ssql.Expr_Or:
-			selected = evalOr(index, node, expr.Field.(*ssql.Expr_Or).Or.Expr)
+			selected = evalOr(index, node, expr.Field.(*ssql.Expr_Or).Or.Expr, trace)
 		}
 
 		for _, x := range selected {
@@ -160,8 +164,12 @@ func evalOr(index *ssd.IndexedBlock, node map[string][]byte, or []*ssql.Expr) []
 	return rs[:j]
 }
 
-func evalTuple(index *ssd.IndexedBlock, node map[string][]byte, key []byte, expr *ssql.Tuple) []uint16 {
-	entity := empty
+func evalTuple(index *ssd.IndexedBlock, node map[string][]byte, key []byte, expr *ssql.Tuple, trace *Trace) (entity []uint16) {
+	if trace != nil {
+		defer func(start time.Time) { trace.tuple(expr, true, start, entity) }(time.Now())
+	}
+
+	entity = empty
 	switch expr.Predicate.(type) {
 	case *ssql.Tuple_Nested:
 		return evalNested(index, node, expr, expr.Predicate.(*ssql.Tuple_Nested).Nested.Expr)
@@ -276,11 +284,59 @@ func evalContain(index *ssd.IndexedBlock, key []byte, op *ssql.Tuple_Contain) ([
 }
 
 func evalKey(index *ssd.IndexedBlock, path []byte, op *ssql.Tuple_Key) ([]uint16, []uint16) {
-	text := op.Key.First.Value.(*ssql.Operand_Text).Text
+	switch v := op.Key.First.Value.(type) {
+	case *ssql.Operand_Text:
+		return operator.Contain(index, path, exactPattern(v.Text))
+	case *ssql.Operand_Int:
+		return operator.UnaryInt64(index, path, v.Int, ext.EqualInt64)
+	case *ssql.Operand_List:
+		patterns := make([]string, len(v.List.Text))
+		for i, t := range v.List.Text {
+			patterns[i] = string(exactPattern(t))
+		}
+
+		switch {
+		case len(v.List.Int) == 0:
+			return operator.InText(index, path, patterns)
+		case len(patterns) == 0:
+			return operator.InInt(index, path, v.List.Int)
+		}
+
+		entity, attribute := operator.InText(index, path, patterns)
+		ie, ia := operator.InInt(index, path, v.List.Int)
+		return sortEntity(append(entity, ie...), append(attribute, ia...))
+	}
+
+	return empty, empty
+}
+
+// exactPattern anchors text for full text match
+func exactPattern(text string) []byte {
 	key := make([]byte, len(text)+2)
 	copy(key[1:], text)
 	key[0], key[len(key)-1] = '^', '$'
-	return operator.Contain(index, path, key)
+	return key
+}
+
+// sortEntity sorts entity along with its attribute in ascending order
+func sortEntity(entity, attribute []uint16) ([]uint16, []uint16) {
+	sort.Sort(byEntity{entity, attribute})
+	return entity, attribute
+}
+
+type byEntity struct {
+	entity, attribute []uint16
+}
+
+func (b byEntity) Len() int { return len(b.entity) }
+
+func (b byEntity) Less(i, j int) bool {
+	return b.entity[i] < b.entity[j] || b.entity[i] == b.entity[j] && b.attribute[i] < b.attribute[j]
+}
+
+func (b byEntity) Swap(i, j int) {
+	b.entity[i], b.entity[j] = b.entity[j], b.entity[i]
+	b.attribute[i], b.attribute[j] = b.attribute[j], b.attribute[i]
 }
 
 func evalEqual(index *ssd.IndexedBlock, key []byte, op *ssql.Tuple_Eq) ([]uint16, []uint16) {
@@ -357,13 +413,19 @@ func evalLessEqual(index *ssd.IndexedBlock, key []byte, op *ssql.Tuple_Le) ([]ui
 
 func evalIn(index *ssd.IndexedBlock, key []byte, op *ssql.Tuple_In) ([]uint16, []uint16) {
 	if list, ok := op.In.First.Value.(*ssql.Operand_List); ok {
-		if len(list.List.Text) > 0 {
+		switch {
+		case len(list.List.Text) > 0:
 			return operator.InText(index, key, list.List.Text)
-		} else if len(list.List.Int) > 0 {
+		case len(list.List.Double) == 0:
 			return operator.InInt(index, key, list.List.Int)
-		} else if len(list.List.Double) > 0 {
+		case len(list.List.Int) == 0:
 			return operator.InFloat(index, key, list.List.Double)
 		}
+
+		// mixed list matches integer and real number values
+		entity, attribute := operator.InFloat(index, key, list.List.Double)
+		ie, ia := operator.InInt(index, key, list.List.Int)
+		return sortEntity(append(entity, ie...), append(attribute, ia...))
 	}
 
 	return empty, empty
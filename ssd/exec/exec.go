@@ -20,6 +20,8 @@
 package exec
 
 import (
+	"time"
+
 	"github.com/chronowave/chronowave/ssql"
 
 	"github.com/chronowave/chronowave/ssd"
@@ -28,8 +30,16 @@ import (
 )
 
 func Exec(index *ssd.IndexedBlock, stmt *ssql.Statement) *ssd.ResultSet {
+	return execute(index, stmt, nil)
+}
+
+// execute evaluates statement in index block, evaluation is traced if trace is not nil
+func execute(index *ssd.IndexedBlock, stmt *ssql.Statement, trace *Trace) *ssd.ResultSet {
 	node := map[string][]byte{}
-	entity := eval(index, node, stmt)
+	entity := eval(index, node, stmt, trace)
+	if trace != nil {
+		trace.Matched = len(entity)
+	}
 	if len(entity) == 0 {
 		return &ssd.ResultSet{RowId: []uint64{}}
 	}
@@ -41,7 +51,58 @@ func Exec(index *ssd.IndexedBlock, stmt *ssql.Statement) *ssd.ResultSet {
 		columns[i].Group = f.Group
 		columns[i].Func = f.Func
 	}
+	columns = appendTimestamps(columns, node)
 
+	start := time.Now()
 	columns = operator.Select(index, columns, entity)
-	return operator.Consolidate(index.ID, entity, columns)
+	if trace != nil {
+		trace.Select = OperatorTrace{Rows: selectedRows(columns), Duration: time.Since(start)}
+		start = time.Now()
+	}
+
+	rs := operator.Consolidate(index.ID, entity, columns)
+	if trace != nil {
+		trace.Consolidate = OperatorTrace{Rows: len(rs.RowId), Duration: time.Since(start)}
+	}
+	// drop timestamp columns not found
+	rs.Column, rs.ColumnType = rs.Column[:len(stmt.Find)], rs.ColumnType[:len(stmt.Find)]
+
+	return rs
+}
+
+// appendTimestamps appends columns of timestamp variables of aggregates, e.g. FIRST and LAST, which
+// are not found
+func appendTimestamps(columns []internal.Column, node map[string][]byte) []internal.Column {
+	found := len(columns)
+	for i := 0; i < found; i++ {
+		name := columns[i].Func.GetVariable()
+		if len(name) == 0 {
+			continue
+		}
+
+		exists := false
+		for j := range columns {
+			if columns[j].Name == name {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			key, ok := node[name]
+			columns = append(columns, internal.Column{Key: key, Ok: ok, Name: name})
+		}
+	}
+
+	return columns
+}
+
+// Restore returns JSON documents of entities in index block, entities are ordinals of documents
+// in ascending order
+func Restore(index *ssd.IndexedBlock, entity []uint16) [][]byte {
+	if len(entity) == 0 {
+		return nil
+	}
+
+	columns := operator.Select(index, []internal.Column{{Ok: true}}, entity)
+	return columns[0].ColumnJson.Cols
 }
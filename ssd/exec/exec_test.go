@@ -20,8 +20,12 @@
 package exec
 
 import (
+	"bytes"
+	"encoding/json"
 	"math"
+	"math/rand"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/chronowave/chronowave/ssql/parser"
@@ -126,3 +130,228 @@ func TestExec(t *testing.T) {
 		})
 	}
 }
+
+func TestRestore(t *testing.T) {
+	parsed, err := codec.ParseJson([]byte(`{"a": "bb", "b": 1} {"a": "cc", "c": {"d": 2.5}} {"e": [1, 2]}`))
+	if err != nil {
+		t.Fatalf("ParseJson() error = %v", err)
+	}
+
+	index, err := buildTestIndex(parsed)
+	if err != nil {
+		t.Fatalf("buildTestIndex() error = %v", err)
+	}
+
+	got := Restore(index, []uint16{0, 2})
+	want := [][]byte{[]byte(`{"a":"bb","b":1}`), []byte(`{"e":[1,2]}`)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Restore() = %q, want %q", got, want)
+	}
+}
+
+func TestQuotedPath(t *testing.T) {
+	parsed, err := codec.ParseJson([]byte(`{"http/status": 200, "a\u0001b": {"c\"d": "x"}} {"http": {"status": 404}}`))
+	if err != nil {
+		t.Fatalf("ParseJson() error = %v", err)
+	}
+
+	index, err := buildTestIndex(parsed)
+	if err != nil {
+		t.Fatalf("buildTestIndex() error = %v", err)
+	}
+
+	stmt, e := parser.Parse(`find $s, $c where [$s /"http/status"] [$c /"a\u0001b"/"c\"d"]`)
+	if len(e) > 0 {
+		t.Fatalf("ssql Parse() error = %v", e)
+	}
+
+	rs := Exec(index, stmt)
+	if rs.Column[0].RowIdx[0] == 0 || rs.Column[0].Value[0] != 200 || string(rs.Text[rs.Column[1].Value[0]]) != "x" {
+		t.Errorf("Exec() = %v, want status 200 and text x of row 0", rs)
+	}
+
+	got := Restore(index, []uint16{0, 1})
+	want := [][]byte{[]byte(`{"http/status":200,"a\u0001b":{"c\"d":"x"}}`), []byte(`{"http":{"status":404}}`)}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Restore() = %q, want %q", got, want)
+	}
+}
+
+func TestFirstLast(t *testing.T) {
+	parsed, err := codec.ParseJson([]byte(`{"ts": 3, "s": "a", "v": "x"} {"ts": 1, "s": "a", "v": "y"} {"ts": 2, "s": "a", "v": "z"}
+		{"ts": 5, "s": "b", "v": "p"} {"s": "b", "v": "q"} {"ts": 4, "s": "b"}`))
+	if err != nil {
+		t.Fatalf("ParseJson() error = %v", err)
+	}
+
+	index, err := buildTestIndex(parsed)
+	if err != nil {
+		t.Fatalf("buildTestIndex() error = %v", err)
+	}
+
+	// timestamp $ts is not in FIND, and value without timestamp is skipped
+	tests := []struct {
+		name string
+		stmt string
+		want string
+	}{
+		{"named by variable", `find group-by($s), first($v, $ts) where [$s /s] [$v /v] [$ts /ts]`,
+			`[{"s":"a","v":"y"},{"s":"b","v":"p"}]`},
+		{"named by function", `find group-by($s), first($v, $ts), last($v, $ts), topk($v, 1) where [$s /s] [$v /v] [$ts /ts]`,
+			`[{"s":"a","first(v, ts)":"y","last(v, ts)":"x","topk(v, 1)":[{"value":"x","count":1}]},` +
+				`{"s":"b","first(v, ts)":"p","last(v, ts)":"p","topk(v, 1)":[{"value":"p","count":1}]}]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stmt, e := parser.Parse(tt.stmt)
+			if len(e) > 0 {
+				t.Fatalf("ssql Parse() error = %v", e)
+			}
+
+			if got := string(codec.MarshalResultSet(Exec(index, stmt), 0)); got != tt.want {
+				t.Errorf("Exec() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExplain(t *testing.T) {
+	parsed, err := codec.ParseJson([]byte(`{"s": 200, "v": "a"} {"s": 500, "v": "b"} {"s": 200, "v": "c"} {"v": "d"}`))
+	if err != nil {
+		t.Fatalf("ParseJson() error = %v", err)
+	}
+
+	index, err := buildTestIndex(parsed)
+	if err != nil {
+		t.Fatalf("buildTestIndex() error = %v", err)
+	}
+
+	stmt, e := parser.Parse(`EXPLAIN find $v where [$v /v] [/s eq(200)] [/x eq(1)]`)
+	if len(e) > 0 {
+		t.Fatalf("ssql Parse() error = %v", e)
+	}
+
+	// missing path is evaluated first and ends evaluation
+	rs, trace := Explain(index, stmt)
+	want := []TupleTrace{{Path: "/x", Predicate: "eq", Exists: false, Matched: 0, Selectivity: 0}}
+	if trace.Documents != 4 || len(trace.Tuples) != len(want) {
+		t.Fatalf("Explain() trace = %+v, want 4 documents and %d tuples", trace, len(want))
+	}
+	for i, tt := range trace.Tuples {
+		tt.Duration = 0
+		if !reflect.DeepEqual(tt, want[i]) {
+			t.Errorf("Explain() tuple %d = %+v, want %+v", i, tt, want[i])
+		}
+	}
+
+	if len(rs.RowId) != 0 || trace.Matched != 0 || trace.Select.Rows != 0 {
+		t.Errorf("Explain() = %v, trace %+v, want no result as /x is missing", rs, trace)
+	}
+
+	stmt, _ = parser.Parse(`EXPLAIN find $v where [$v /v] [/s eq(200)]`)
+	rs, trace = Explain(index, stmt)
+	want = []TupleTrace{{Path: "/s", Predicate: "eq", Exists: true, Matched: 2, Selectivity: 0.5}}
+	for i, tt := range trace.Tuples {
+		tt.Duration = 0
+		if !reflect.DeepEqual(tt, want[i]) {
+			t.Errorf("Explain() tuple %d = %+v, want %+v", i, tt, want[i])
+		}
+	}
+	if trace.Matched != 2 || trace.Consolidate.Rows != len(rs.RowId) || len(rs.RowId) != 2 {
+		t.Errorf("Explain() = %v, trace %+v, want 2 matched and consolidated rows", rs, trace)
+	}
+}
+
+func TestRestoreRandomJson(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for round := 0; round < 200; round++ {
+		docs := make([][]byte, 1+r.Intn(8))
+		var buf bytes.Buffer
+		for i := range docs {
+			obj := map[string]interface{}{}
+			for k := r.Intn(5); k >= 0; k-- {
+				obj[randomKey(r)] = randomValue(r, 3)
+			}
+			if r.Intn(20) == 0 {
+				obj = map[string]interface{}{}
+			}
+			docs[i], _ = json.Marshal(obj)
+			buf.Write(docs[i])
+			buf.WriteByte('\n')
+		}
+
+		parsed, err := codec.ParseJson(buf.Bytes())
+		if err != nil {
+			t.Fatalf("ParseJson(%s) error = %v", buf.Bytes(), err)
+		}
+
+		index, err := buildTestIndex(parsed)
+		if err != nil {
+			t.Fatalf("buildTestIndex(%s) error = %v", buf.Bytes(), err)
+		}
+
+		entity := make([]uint16, len(docs))
+		for i := range entity {
+			entity[i] = uint16(i)
+		}
+
+		restored := Restore(index, entity)
+		if len(restored) != len(docs) {
+			t.Fatalf("Restore(%s) = %d documents, want %d", buf.Bytes(), len(restored), len(docs))
+		}
+
+		for i, doc := range docs {
+			var got, want interface{}
+			if err := json.Unmarshal(restored[i], &got); err != nil {
+				t.Fatalf("Restore() = %s of %s, error = %v", restored[i], doc, err)
+			}
+			json.Unmarshal(doc, &want)
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("Restore() = %s, want %s", restored[i], doc)
+			}
+		}
+	}
+}
+
+// keys and runes are few, hfmi encoder overflows blocks of 17 or more symbols when block length is
+// a multiple of 256
+func randomKey(r *rand.Rand) string {
+	return string([]byte{byte('a' + r.Intn(4))})
+}
+
+func randomValue(r *rand.Rand, depth int) interface{} {
+	kind := r.Intn(10)
+	if depth == 0 {
+		kind = r.Intn(6)
+	}
+
+	switch kind {
+	case 0:
+		return r.Int63n(1<<40) - 1<<39
+	case 1:
+		return r.NormFloat64() * 1000
+	case 2:
+		return r.Intn(2) == 0
+	case 3:
+		return nil
+	case 4, 5:
+		var sb strings.Builder
+		runes := []rune("ab \"\\/\n\té中")
+		for n := r.Intn(160); n > 0; n-- {
+			sb.WriteRune(runes[r.Intn(len(runes))])
+		}
+		return sb.String()
+	case 6, 7:
+		obj := map[string]interface{}{}
+		for n := r.Intn(4); n > 0; n-- {
+			obj[randomKey(r)] = randomValue(r, depth-1)
+		}
+		return obj
+	default:
+		arr := make([]interface{}, r.Intn(4))
+		for i := range arr {
+			arr[i] = randomValue(r, depth-1)
+		}
+		return arr
+	}
+}
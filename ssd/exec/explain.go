@@ -0,0 +1,130 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package exec
+
+import (
+	"time"
+
+	"github.com/chronowave/chronowave/ssql"
+
+	"github.com/chronowave/chronowave/ssd"
+	"github.com/chronowave/chronowave/ssd/internal"
+)
+
+// Trace records evaluation of statement in index block
+type Trace struct {
+	// Rejected tells block is not searched as its Bloom filters exclude WHERE
+	Rejected bool `json:"rejected,omitempty"`
+	// Documents is number of documents in block
+	Documents int `json:"documents"`
+	// Tuples are top level tuples of WHERE and its OR, nested tuples are evaluated with parent
+	Tuples []TupleTrace `json:"tuples,omitempty"`
+	// Matched is number of documents matched by WHERE
+	Matched     int           `json:"matched"`
+	Select      OperatorTrace `json:"select"`
+	Consolidate OperatorTrace `json:"consolidate"`
+	Duration    time.Duration `json:"duration_ns"`
+}
+
+// TupleTrace records evaluation of tuple predicate
+type TupleTrace struct {
+	Name      string `json:"name,omitempty"`
+	Path      string `json:"path"`
+	Predicate string `json:"predicate"`
+	// Exists tells whether path is in block
+	Exists bool `json:"exists"`
+	// Matched is number of documents matched by predicate, Selectivity is its ratio to documents
+	Matched     int           `json:"matched"`
+	Selectivity float64       `json:"selectivity"`
+	Duration    time.Duration `json:"duration_ns"`
+}
+
+// OperatorTrace records rows produced by operator
+type OperatorTrace struct {
+	Rows     int           `json:"rows"`
+	Duration time.Duration `json:"duration_ns"`
+}
+
+// Explain executes statement as Exec, and returns trace of its evaluation along with result set
+func Explain(index *ssd.IndexedBlock, stmt *ssql.Statement) (*ssd.ResultSet, *Trace) {
+	trace := &Trace{Documents: len(index.EntityID)}
+	start := time.Now()
+	rs := execute(index, stmt, trace)
+	trace.Duration = time.Since(start)
+	return rs, trace
+}
+
+// tuple records evaluation of tuple started at start, entity are matched documents in ascending
+// order, trace is nil if not explaining
+func (t *Trace) tuple(tuple *ssql.Tuple, exists bool, start time.Time, entity []uint16) {
+	if t == nil {
+		return
+	}
+
+	tt := TupleTrace{
+		Name:     tuple.Name,
+		Path:     tuple.Path,
+		Exists:   exists,
+		Duration: time.Since(start),
+	}
+
+	m := tuple.ProtoReflect()
+	if fd := m.WhichOneof(m.Descriptor().Oneofs().ByName("predicate")); fd != nil {
+		tt.Predicate = string(fd.Name())
+	}
+
+	// an entity repeats for each of its matched attributes
+	for i, e := range entity {
+		if i == 0 || entity[i-1] != e {
+			tt.Matched++
+		}
+	}
+	if t.Documents > 0 {
+		tt.Selectivity = float64(tt.Matched) / float64(t.Documents)
+	}
+
+	t.Tuples = append(t.Tuples, tt)
+}
+
+// selectedRows returns rows of the longest column
+func selectedRows(columns []internal.Column) int {
+	rows := 0
+	for _, c := range columns {
+		n := 0
+		switch {
+		case c.ColumnText != nil:
+			n = len(c.ColumnText.Rows)
+		case c.ColumnFloat64 != nil:
+			n = len(c.ColumnFloat64.Rows)
+		case c.ColumnInt64 != nil:
+			n = len(c.ColumnInt64.Rows)
+		case c.ColumnBool != nil:
+			n = len(c.ColumnBool.Rows)
+		case c.ColumnNull != nil:
+			n = len(c.ColumnNull.Rows)
+		case c.ColumnJson != nil:
+			n = len(c.ColumnJson.Rows)
+		}
+		if n > rows {
+			rows = n
+		}
+	}
+	return rows
+}
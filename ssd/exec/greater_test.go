@@ -53,6 +53,11 @@ func TestGreater(t *testing.T) {
 			stmt: `find $val where [$val /c] [/k ge(2)] [/k gt(1)]`},
 			[]byte(`[{"val":"dd"}]`),
 		},
+		{"negative", args{
+			json: `[{"k": -10, "c": "a"}, {"k": -3, "c": "b"}, {"k": 4, "c": "c"}]`,
+			stmt: `find $val where [$val /c] [/k gt(-5)] [/k lt(1e1)]`},
+			[]byte(`[{"val":"b"},{"val":"c"}]`),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -60,6 +60,11 @@ func TestIn(t *testing.T) {
 			stmt: `find $val where [$val /] [/k in(25, 15)]`},
 			[]byte(`[{"val":{"a":[{"b":1,"c":3},{"b":2,"c":1}],"c":"dd","k":15}},{"val":{"a":[{"b":2,"c":2},{"b":3,"c":2}],"d":"a","k":25}}]`),
 		},
+		{"mixed", args{
+			json: `[{"k": -15}, {"k": 2.5}, {"k": 5}, {"k": -15.0}]`,
+			stmt: `find $val where [$val /] [/k in(-15, 2.5)]`},
+			[]byte(`[{"val":{"k":-15}},{"val":{"k":2.5}},{"val":{"k":-15}}]`),
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,246 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package exec
+
+import (
+	"sort"
+
+	"github.com/chronowave/chronowave/ssql"
+
+	"github.com/chronowave/chronowave/ssd"
+	"github.com/chronowave/chronowave/ssd/operator"
+)
+
+// selectivity of predicates is a fraction 1/n of values of path, values are not histogrammed
+const (
+	eqSelectivity    = 10
+	rangeSelectivity = 3
+)
+
+// estimate is cost of evaluating expression in index block
+type estimate struct {
+	// work is number of values scanned
+	work int
+	// rows is number of attributes expected to match
+	rows int
+}
+
+func (e estimate) less(o estimate) bool {
+	return e.work < o.work || e.work == o.work && e.rows < o.rows
+}
+
+// planAnd orders AND expressions by estimated cost in index block, cheapest and most selective
+// first. Expressions on paths missing in block cost nothing and run first, so that evaluation ends
+// before any scan. Select only tuples keep their order as they don't filter.
+func planAnd(index *ssd.IndexedBlock, and []*ssql.Expr) []*ssql.Expr {
+	if len(and) < 2 {
+		return and
+	}
+
+	estimates := make([]estimate, len(and))
+	order := make([]int, len(and))
+	for i, expr := range and {
+		estimates[i], order[i] = estimateExpr(index, expr), i
+	}
+
+	sort.SliceStable(order, func(i, j int) bool {
+		return estimates[order[i]].less(estimates[order[j]])
+	})
+
+	planned := make([]*ssql.Expr, len(and))
+	for i, o := range order {
+		planned[i] = and[o]
+	}
+
+	return planned
+}
+
+func estimateExpr(index *ssd.IndexedBlock, expr *ssql.Expr) estimate {
+	switch field := expr.Field.(type) {
+	case *ssql.Expr_Tuple:
+		return estimateTuple(index, field.Tuple)
+	case *ssql.Expr_Or:
+		// union of all
+		var e estimate
+		for _, x := range field.Or.Expr {
+			xe := estimateExpr(index, x)
+			e.work += xe.work
+			e.rows += xe.rows
+		}
+		return e
+	}
+
+	return estimate{}
+}
+
+func estimateTuple(index *ssd.IndexedBlock, tuple *ssql.Tuple) estimate {
+	if tuple.Predicate == nil {
+		return estimate{}
+	}
+
+	key, ok := index.Meta.GetCode(ssd.SplitPath(tuple.Path))
+	if !ok {
+		return estimate{}
+	}
+
+	switch pred := tuple.Predicate.(type) {
+	case *ssql.Tuple_Eq:
+		return estimateNumeric(index, key, eqSelectivity, pred.Eq.First)
+	case *ssql.Tuple_Neq:
+		return estimateNumeric(index, key, 1, pred.Neq.First)
+	case *ssql.Tuple_Gt:
+		return estimateNumeric(index, key, rangeSelectivity, pred.Gt.First)
+	case *ssql.Tuple_Ge:
+		return estimateNumeric(index, key, rangeSelectivity, pred.Ge.First)
+	case *ssql.Tuple_Lt:
+		return estimateNumeric(index, key, rangeSelectivity, pred.Lt.First)
+	case *ssql.Tuple_Le:
+		return estimateNumeric(index, key, rangeSelectivity, pred.Le.First)
+	case *ssql.Tuple_Between:
+		return estimateNumeric(index, key, rangeSelectivity, pred.Between.First, pred.Between.Second)
+	case *ssql.Tuple_Timeframe:
+		return estimateNumeric(index, key, rangeSelectivity, pred.Timeframe.First, pred.Timeframe.Second)
+	case *ssql.Tuple_In:
+		if list, ok := pred.In.First.Value.(*ssql.Operand_List); ok {
+			return estimateList(index, key, list.List)
+		}
+	case *ssql.Tuple_Key:
+		switch v := pred.Key.First.Value.(type) {
+		case *ssql.Operand_Text:
+			return estimateText(index, key, 1, exactPattern(v.Text))
+		case *ssql.Operand_Int:
+			return estimateNumeric(index, key, eqSelectivity, pred.Key.First)
+		case *ssql.Operand_List:
+			return estimateList(index, key, v.List)
+		}
+	case *ssql.Tuple_Contain:
+		if text, ok := pred.Contain.First.Value.(*ssql.Operand_Text); ok {
+			return estimateText(index, key, 0, []byte(text.Text))
+		}
+	case *ssql.Tuple_Exist:
+		return estimateExist(index, key)
+	case *ssql.Tuple_Nested:
+		return estimateNested(index, key, tuple, pred.Nested.Expr)
+	}
+
+	return estimate{}
+}
+
+// estimateNumeric scans values of path in INT64 column if all operands are integers, in FLT64
+// column otherwise
+func estimateNumeric(index *ssd.IndexedBlock, key []byte, selectivity int, operands ...*ssql.Operand) estimate {
+	var c byte = ssd.INT64
+	for _, op := range operands {
+		if _, ok := op.Value.(*ssql.Operand_Int); !ok {
+			c = ssd.FLT64
+		}
+	}
+
+	n := operator.Cardinality(index, key, c)
+	return estimate{work: n, rows: fraction(n, selectivity)}
+}
+
+// estimateText searches patterns in full text, matched texts are intersected with values of path,
+// exact matches of n patterns are expected to select n values at most
+func estimateText(index *ssd.IndexedBlock, key []byte, exact int, patterns ...[]byte) estimate {
+	n := operator.Cardinality(index, key, ssd.TEXT)
+	if n == 0 {
+		return estimate{}
+	}
+
+	e := estimate{work: n, rows: n}
+	for _, p := range patterns {
+		e.work += operator.ContainCost(index, p)
+	}
+	if exact > 0 && exact < n {
+		e.rows = exact
+	}
+
+	return e
+}
+
+func estimateList(index *ssd.IndexedBlock, key []byte, list *ssql.List) estimate {
+	var e estimate
+	if len(list.Text) > 0 {
+		patterns := make([][]byte, len(list.Text))
+		for i, t := range list.Text {
+			patterns[i] = exactPattern(t)
+		}
+		e = estimateText(index, key, len(patterns), patterns...)
+	}
+
+	for _, c := range []struct {
+		code   byte
+		values int
+	}{{ssd.INT64, len(list.Int)}, {ssd.FLT64, len(list.Double)}} {
+		if c.values > 0 {
+			n := operator.Cardinality(index, key, c.code)
+			rows := fraction(n*c.values, eqSelectivity)
+			if rows > n {
+				rows = n
+			}
+			e.work += n
+			e.rows += rows
+		}
+	}
+
+	return e
+}
+
+// estimateExist counts values of path in all of value types
+func estimateExist(index *ssd.IndexedBlock, key []byte) estimate {
+	var n int
+	for _, c := range ssd.VALUES {
+		n += operator.Cardinality(index, key, c)
+	}
+
+	return estimate{work: n, rows: n}
+}
+
+// estimateNested evaluates every nested tuple and joins them with parent, it matches no more than
+// the most selective nested tuple
+func estimateNested(index *ssd.IndexedBlock, key []byte, parent *ssql.Tuple, nested []*ssql.Expr) estimate {
+	e := estimateExist(index, key)
+	for _, expr := range nested {
+		if field, ok := expr.Field.(*ssql.Expr_Tuple); ok {
+			ne := estimateTuple(index, &ssql.Tuple{
+				Path:      parent.Path + "/" + field.Tuple.Path,
+				Predicate: field.Tuple.Predicate,
+			})
+			e.work += ne.work
+			if ne.rows < e.rows {
+				e.rows = ne.rows
+			}
+		}
+	}
+
+	return e
+}
+
+// fraction returns 1/selectivity of n, at least 1 if n is not 0
+func fraction(n, selectivity int) int {
+	if n == 0 {
+		return 0
+	} else if n < selectivity {
+		return 1
+	}
+
+	return n / selectivity
+}
@@ -0,0 +1,91 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package exec
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/chronowave/chronowave/ssql/parser"
+
+	"github.com/chronowave/chronowave/ssd/codec"
+)
+
+func TestPlanAnd(t *testing.T) {
+	var docs strings.Builder
+	for i := 0; i < 64; i++ {
+		fmt.Fprintf(&docs, `{"msg": "request %d took foo%d ms", "code": %d, "tags": ["a%d", "b"]}`, i, i, i%8, i)
+	}
+
+	parsed, err := codec.ParseJson([]byte(docs.String()))
+	if err != nil {
+		t.Fatalf("ParseJson() error = %v", err)
+	}
+
+	index, err := buildTestIndex(parsed)
+	if err != nil {
+		t.Fatalf("buildTestIndex() error = %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		query string
+		want  []string
+	}{
+		{"eq before wild card", `find $m where [$m /msg contain("*foo*ms")] [/code eq(5)]`,
+			[]string{"/code", "/msg"}},
+		{"missing first", `find $m where [$m /msg contain("*foo*ms")] [/code eq(5)] [/none eq(1)]`,
+			[]string{"/none", "/code", "/msg"}},
+		{"key before wild card", `find $m where [$m /msg] [/msg contain("*foo*ms")] [/tags key("a7")]`,
+			[]string{"$m", "/tags", "/msg"}},
+		{"or costs all", `find $m where [$m /msg] {[/code eq(3)] [/code eq(4)]} [/code ge(0)]`,
+			[]string{"$m", "/code", "or"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stmt, e := parser.Parse(tt.query)
+			if len(e) > 0 {
+				t.Fatalf("ssql Parse() error = %v", e)
+			}
+
+			var got []string
+			for _, expr := range planAnd(index, stmt.Where) {
+				if tuple := expr.GetTuple(); tuple == nil {
+					got = append(got, "or")
+				} else if tuple.Predicate == nil {
+					got = append(got, "$"+tuple.Name)
+				} else {
+					got = append(got, tuple.Path)
+				}
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("planAnd() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	stmt, _ := parser.Parse(`find $c where [$c /code] [/msg contain("*foo*ms")] [/code eq(5)] [/code lt(6)]`)
+	if rs := Exec(index, stmt); len(rs.RowId) != 8 {
+		t.Errorf("Exec() = %v rows, want 8", len(rs.RowId))
+	}
+}
@@ -0,0 +1,249 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package exec
+
+import (
+	"sort"
+
+	"github.com/chronowave/chronowave/ssql"
+
+	"github.com/chronowave/chronowave/ssd"
+	"github.com/chronowave/chronowave/ssd/operator"
+)
+
+// Stats summarizes JSON paths and numeric values of index block, so that a statement which can't
+// match any of its documents is not evaluated in the block
+type Stats struct {
+	// Paths are JSON paths in block, in the form of ssd.JoinPath
+	Paths []string `json:"paths"`
+	// Int and Float are ranges of INT64 and FLT64 values by JSON path
+	Int   map[string]IntRange   `json:"int,omitempty"`
+	Float map[string]FloatRange `json:"float,omitempty"`
+
+	paths map[string]bool
+}
+
+// IntRange is min and max of INT64 values
+type IntRange struct {
+	Min int64 `json:"min"`
+	Max int64 `json:"max"`
+}
+
+// FloatRange is min and max of FLT64 values
+type FloatRange struct {
+	Min float64 `json:"min"`
+	Max float64 `json:"max"`
+}
+
+// BlockStats returns Stats of index block
+func BlockStats(index *ssd.IndexedBlock) *Stats {
+	stats := &Stats{Paths: []string{}, Int: map[string]IntRange{}, Float: map[string]FloatRange{}}
+	index.Meta.Walk(func(keys [][]byte, code []byte) {
+		path := ssd.JoinPath(keys)
+		stats.Paths = append(stats.Paths, path)
+
+		if ints := operator.Int64Values(index, code); len(ints) > 0 {
+			r := IntRange{Min: ints[0], Max: ints[0]}
+			for _, v := range ints[1:] {
+				if v < r.Min {
+					r.Min = v
+				} else if v > r.Max {
+					r.Max = v
+				}
+			}
+			stats.Int[path] = r
+		}
+
+		if floats := operator.Float64Values(index, code); len(floats) > 0 {
+			r := FloatRange{Min: floats[0], Max: floats[0]}
+			for _, v := range floats[1:] {
+				if v < r.Min {
+					r.Min = v
+				} else if v > r.Max {
+					r.Max = v
+				}
+			}
+			stats.Float[path] = r
+		}
+	})
+	sort.Strings(stats.Paths)
+
+	return stats
+}
+
+// Excludes tells whether WHERE of statement can't match any document of block, it is the case
+// when a tuple with predicate is on a path not in block, or its numeric predicate falls out of
+// range of values of the path
+func (s *Stats) Excludes(stmt *ssql.Statement) bool {
+	if s.paths == nil {
+		s.paths = make(map[string]bool, len(s.Paths))
+		for _, p := range s.Paths {
+			s.paths[p] = true
+		}
+	}
+
+	return s.excludesAnd(stmt.Where)
+}
+
+func (s *Stats) excludesAnd(and []*ssql.Expr) bool {
+	for _, expr := range and {
+		if s.excludes(expr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Stats) excludes(expr *ssql.Expr) bool {
+	switch field := expr.Field.(type) {
+	case *ssql.Expr_Tuple:
+		return s.excludesTuple(field.Tuple)
+	case *ssql.Expr_Or:
+		for _, x := range field.Or.Expr {
+			if !s.excludes(x) {
+				return false
+			}
+		}
+		return len(field.Or.Expr) > 0
+	}
+
+	return false
+}
+
+func (s *Stats) excludesTuple(tuple *ssql.Tuple) bool {
+	if tuple.Predicate == nil {
+		// select only
+		return false
+	}
+
+	keys := ssd.SplitPath(tuple.Path)
+	path := ssd.JoinPath(keys)
+	if len(keys) > 0 && !s.paths[path] {
+		return true
+	}
+
+	switch pred := tuple.Predicate.(type) {
+	case *ssql.Tuple_Eq:
+		return s.outOfRange(path, pred.Eq.First, pred.Eq.First)
+	case *ssql.Tuple_Gt:
+		return s.outOfRange(path, pred.Gt.First, nil)
+	case *ssql.Tuple_Ge:
+		return s.outOfRange(path, pred.Ge.First, nil)
+	case *ssql.Tuple_Lt:
+		return s.outOfRange(path, nil, pred.Lt.First)
+	case *ssql.Tuple_Le:
+		return s.outOfRange(path, nil, pred.Le.First)
+	case *ssql.Tuple_Between:
+		return s.outOfRange(path, pred.Between.First, pred.Between.Second)
+	case *ssql.Tuple_Timeframe:
+		return s.outOfRange(path, pred.Timeframe.First, pred.Timeframe.Second)
+	case *ssql.Tuple_Key:
+		if _, ok := pred.Key.First.Value.(*ssql.Operand_Int); ok {
+			return s.outOfRange(path, pred.Key.First, pred.Key.First)
+		}
+	case *ssql.Tuple_In:
+		if list, ok := pred.In.First.Value.(*ssql.Operand_List); ok && len(list.List.Text) == 0 {
+			return s.excludesList(path, list.List)
+		}
+	}
+
+	return false
+}
+
+// outOfRange tells whether values of path of operand type are all below lower bound lo, or all
+// above upper bound hi, nil bound is unbounded. Bounds are inclusive, gt and lt are checked as ge
+// and le. Integer operands match INT64 values, and FLT64 values if mixed with a real number.
+func (s *Stats) outOfRange(path string, lo, hi *ssql.Operand) bool {
+	for _, op := range []*ssql.Operand{lo, hi} {
+		if op != nil && !isNumber(op) {
+			return false
+		}
+	}
+
+	if isInt(lo) && isInt(hi) {
+		r, ok := s.Int[path]
+		if !ok {
+			return true
+		}
+		return lo != nil && r.Max < lo.GetInt() || hi != nil && r.Min > hi.GetInt()
+	}
+
+	r, ok := s.Float[path]
+	if !ok {
+		return true
+	}
+	return lo != nil && r.Max < toFloat(lo) || hi != nil && r.Min > toFloat(hi)
+}
+
+// excludesList tells whether none of integers and real numbers of list is in range of path, mixed
+// list matches integers in INT64 values and all in FLT64 values
+func (s *Stats) excludesList(path string, list *ssql.List) bool {
+	if len(list.Double) > 0 {
+		if r, ok := s.Float[path]; ok {
+			for _, v := range list.Double {
+				if r.Min <= v && v <= r.Max {
+					return false
+				}
+			}
+		}
+	}
+
+	if len(list.Int) > 0 {
+		if r, ok := s.Int[path]; ok {
+			for _, v := range list.Int {
+				if r.Min <= v && v <= r.Max {
+					return false
+				}
+			}
+		}
+	}
+
+	return true
+}
+
+func isNumber(op *ssql.Operand) bool {
+	switch op.Value.(type) {
+	case *ssql.Operand_Int, *ssql.Operand_Double:
+		return true
+	}
+
+	return false
+}
+
+func isInt(op *ssql.Operand) bool {
+	if op == nil {
+		return true
+	}
+
+	_, ok := op.Value.(*ssql.Operand_Int)
+	return ok
+}
+
+func toFloat(op *ssql.Operand) float64 {
+	switch v := op.Value.(type) {
+	case *ssql.Operand_Int:
+		return float64(v.Int)
+	case *ssql.Operand_Double:
+		return v.Double
+	}
+
+	return 0
+}
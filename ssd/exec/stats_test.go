@@ -0,0 +1,107 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package exec
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/chronowave/chronowave/ssql/parser"
+
+	"github.com/chronowave/chronowave/ssd/codec"
+)
+
+func TestBlockStats(t *testing.T) {
+	parsed, err := codec.ParseJson([]byte(`{"a": {"b": 5, "c": "x"}, "n": 1.5, "http/status": 200}
+                                           {"a": {"b": -3}, "n": 2, "k": [7, 9.5]}`))
+	if err != nil {
+		t.Fatalf("ParseJson() error = %v", err)
+	}
+
+	index, err := buildTestIndex(parsed)
+	if err != nil {
+		t.Fatalf("buildTestIndex() error = %v", err)
+	}
+
+	stats := BlockStats(index)
+	wantPaths := []string{`/"http/status"`, "/a", "/a/b", "/a/c", "/k", "/n"}
+	if !reflect.DeepEqual(stats.Paths, wantPaths) {
+		t.Errorf("BlockStats() paths = %v, want %v", stats.Paths, wantPaths)
+	}
+	wantInt := map[string]IntRange{`/"http/status"`: {200, 200}, "/a/b": {-3, 5}, "/k": {7, 7}, "/n": {2, 2}}
+	if !reflect.DeepEqual(stats.Int, wantInt) {
+		t.Errorf("BlockStats() int = %v, want %v", stats.Int, wantInt)
+	}
+	wantFloat := map[string]FloatRange{"/k": {9.5, 9.5}, "/n": {1.5, 1.5}}
+	if !reflect.DeepEqual(stats.Float, wantFloat) {
+		t.Errorf("BlockStats() float = %v, want %v", stats.Float, wantFloat)
+	}
+
+	// stats kept in catalog as JSON
+	b, err := json.Marshal(stats)
+	if err != nil {
+		t.Fatalf("json Marshal() error = %v", err)
+	}
+	decoded := &Stats{}
+	if err = json.Unmarshal(b, decoded); err != nil {
+		t.Fatalf("json Unmarshal() error = %v", err)
+	}
+
+	tests := []struct {
+		query    string
+		excludes bool
+	}{
+		{`find $a where [$a /a]`, false},
+		{`find $a where [$a /a] [/x exist()]`, true},
+		{`find $a where [$a /a] [/a/c contain("y")]`, false},
+		{`find $a where [$a /a] [/"http/status" eq(200)]`, false},
+		{`find $a where [$a /a] [/"http/status" eq(404)]`, true},
+		{`find $a where [$a /a] [/a/b gt(5)]`, false},
+		{`find $a where [$a /a] [/a/b gt(6)]`, true},
+		{`find $a where [$a /a] [/a/b lt(-4)]`, true},
+		{`find $a where [$a /a] [/a/b between(6, 10)]`, true},
+		{`find $a where [$a /a] [/a/b between(-10, -3)]`, false},
+		{`find $a where [$a /a] [/a/b eq(1.5)]`, true},
+		{`find $a where [$a /a] [/n eq(1.5)]`, false},
+		{`find $a where [$a /a] [/n between(1, 1.2)]`, true},
+		{`find $a where [$a /a] [/k in(8, 10)]`, true},
+		{`find $a where [$a /a] [/k in(8, 9.5)]`, false},
+		{`find $a where [$a /a] {[/x exist()] [/a/b eq(9)]}`, true},
+		{`find $a where [$a /a] {[/x exist()] [/a/b eq(5)]}`, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.query, func(t *testing.T) {
+			stmt, e := parser.Parse(tt.query)
+			if len(e) > 0 {
+				t.Fatalf("ssql Parse() error = %v", e)
+			}
+
+			if got := decoded.Excludes(stmt); got != tt.excludes {
+				t.Errorf("Excludes() = %v, want %v", got, tt.excludes)
+			}
+
+			// excluded block has no result
+			if rs := Exec(index, stmt); tt.excludes && len(rs.RowId) > 0 {
+				t.Errorf("Exec() = %v rows, excluded block has result", len(rs.RowId))
+			}
+		})
+	}
+}
@@ -69,6 +69,8 @@ type IndexedBlock struct {
 	HLT      HeaderLookupTable
 	HeaderDA []uint32
 	FragDA   []uint32
+	// Bloom are filters of text values by JSON path in the form of JoinPath, nil if block has none
+	Bloom map[string]*Bloom
 }
 
 type Column struct {
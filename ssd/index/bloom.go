@@ -0,0 +1,191 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package index
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"sort"
+
+	"github.com/chronowave/chronowave/ssd"
+)
+
+// maxSections bounds section table read before its checksum is verified
+const maxSections = 1 << 10
+
+// buildBloom adds distinct text values of parsed block to Bloom filters of their JSON paths. text
+// of value is in the order of entity stream, FRAG of long text is not part of value.
+func buildBloom(parsed *ssd.ParsedBlock) map[string]*ssd.Bloom {
+	if len(parsed.Columnar.Text) == 0 {
+		return nil
+	}
+
+	texts := bytes.Split(parsed.Content.Value(), []byte{ssd.SOH})
+	values := map[string]map[uint32]bool{}
+	k := 0
+	for _, segment := range bytes.Split(parsed.Entity.Bytes(), []byte{ssd.SOH}) {
+		// path code is followed by marker of value
+		i := 0
+		for i < len(segment) && !ssd.IsControlCharacter(segment[i]) {
+			i++
+		}
+		if i == len(segment) || segment[i] != ssd.TEXT || k >= len(parsed.Columnar.Text) {
+			continue
+		}
+
+		code := string(segment[:i])
+		if values[code] == nil {
+			values[code] = map[uint32]bool{}
+		}
+		values[code][parsed.Columnar.Text[k]] = true
+		k++
+	}
+
+	bloom := make(map[string]*ssd.Bloom, len(values))
+	for code, ids := range values {
+		distinct := make([][]byte, 0, len(ids))
+		items := 0
+		for id := range ids {
+			// text 0 is empty
+			var text []byte
+			if id > 0 && int(id) <= len(texts) {
+				text = bytes.ReplaceAll(texts[id-1], []byte{ssd.FRAG}, nil)
+			}
+			distinct = append(distinct, text)
+			items += ssd.BloomItems(text)
+		}
+
+		b := ssd.NewBloom(items)
+		for _, text := range distinct {
+			b.AddText(text)
+		}
+		bloom[ssd.JoinPath(parsed.Meta.GetPath([]byte(code)))] = b
+	}
+
+	return bloom
+}
+
+// encodeBloom encodes number of filters, followed by path, number of hashes and bits of filters
+// in order of path
+func encodeBloom(bloom map[string]*ssd.Bloom) []byte {
+	paths := make([]string, 0, len(bloom))
+	sz := 4
+	for p, b := range bloom {
+		paths = append(paths, p)
+		sz += 12 + len(p) + len(b.Bits)*8
+	}
+	sort.Strings(paths)
+
+	buf := make([]byte, 4, sz)
+	binary.LittleEndian.PutUint32(buf, uint32(len(paths)))
+	for _, p := range paths {
+		b := bloom[p]
+		buf = appendUint32(buf, uint32(len(p)))
+		buf = append(buf, p...)
+		buf = appendUint32(buf, b.K)
+		buf = appendUint32(buf, uint32(len(b.Bits)))
+		for _, w := range b.Bits {
+			buf = append(buf, 0, 0, 0, 0, 0, 0, 0, 0)
+			binary.LittleEndian.PutUint64(buf[len(buf)-8:], w)
+		}
+	}
+
+	return buf
+}
+
+func appendUint32(buf []byte, v uint32) []byte {
+	buf = append(buf, 0, 0, 0, 0)
+	binary.LittleEndian.PutUint32(buf[len(buf)-4:], v)
+	return buf
+}
+
+// decodeBloom decodes filters, bits are copied out of buffer
+func decodeBloom(buf []byte) (map[string]*ssd.Bloom, error) {
+	r := &reader{buf: buf}
+	n := r.uint32()
+	bloom := make(map[string]*ssd.Bloom, n)
+	for i := uint32(0); i < n && r.err == nil; i++ {
+		path := string(r.bytes(uint64(r.uint32())))
+		b := &ssd.Bloom{K: r.uint32()}
+		words := r.bytes(uint64(r.uint32()) * 8)
+		b.Bits = make([]uint64, len(words)/8)
+		for j := range b.Bits {
+			b.Bits[j] = binary.LittleEndian.Uint64(words[j*8:])
+		}
+		bloom[path] = b
+	}
+
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	return bloom, nil
+}
+
+// ReadBloom reads Bloom filters of index file without decoding the rest of block, nil if file has
+// none, e.g. version 0 file or block without text
+func ReadBloom(r io.ReaderAt) (map[string]*ssd.Bloom, error) {
+	header := make([]byte, headerSize)
+	if _, err := r.ReadAt(header, 0); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCorrupted, err)
+	}
+	if string(header[:4]) != string(magic[:]) {
+		return nil, nil
+	}
+	if header[4] != version1 {
+		return nil, fmt.Errorf("%w %v", ErrVersion, header[4])
+	}
+
+	n := binary.LittleEndian.Uint32(header[16:20])
+	if n > maxSections {
+		return nil, fmt.Errorf("%w: %v sections", ErrCorrupted, n)
+	}
+	table := make([]byte, sectionSize*uint64(n)+4)
+	if _, err := r.ReadAt(table, headerSize); err != nil {
+		return nil, fmt.Errorf("%w: truncated section table: %v", ErrCorrupted, err)
+	}
+
+	end := len(table) - 4
+	checksum := crc32.Update(crc32.Checksum(header, castagnoli), castagnoli, table[:end])
+	if checksum != binary.LittleEndian.Uint32(table[end:]) {
+		return nil, fmt.Errorf("%w: header checksum mismatch", ErrCorrupted)
+	}
+
+	for s := table[:end]; len(s) > 0; s = s[sectionSize:] {
+		if binary.LittleEndian.Uint32(s[:4]) != sectionBloom {
+			continue
+		}
+
+		data := make([]byte, binary.LittleEndian.Uint32(s[8:12]))
+		if _, err := r.ReadAt(data, int64(binary.LittleEndian.Uint32(s[4:8]))); err != nil {
+			return nil, fmt.Errorf("%w: section %v is truncated", ErrCorrupted, sectionBloom)
+		}
+		if crc32.Checksum(data, castagnoli) != binary.LittleEndian.Uint32(s[12:16]) {
+			return nil, fmt.Errorf("%w: section %v checksum mismatch", ErrCorrupted, sectionBloom)
+		}
+
+		return decodeBloom(data)
+	}
+
+	return nil, nil
+}
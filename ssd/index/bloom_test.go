@@ -0,0 +1,80 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package index
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestReadBloom(t *testing.T) {
+	index := buildIndex(t, `[{"a": "xyz", "b": {"c": "hello world"}}, {"a": "", "b": {"c": 1}}, {"d": 2}]`)
+
+	if got := len(index.Bloom); got != 2 {
+		t.Fatalf("Build() Bloom = %v, want filters of /a and /b/c", index.Bloom)
+	}
+	a, c := index.Bloom["/a"], index.Bloom["/b/c"]
+	if a == nil || c == nil {
+		t.Fatalf("Build() Bloom = %v, want filters of /a and /b/c", index.Bloom)
+	}
+	for _, v := range []string{"xyz", ""} {
+		if !a.HasValue([]byte(v)) {
+			t.Errorf("Bloom /a HasValue(%q) = false, want true", v)
+		}
+	}
+	if !c.HasValue([]byte("hello world")) || !c.HasGrams([]byte("lo wor")) {
+		t.Errorf("Bloom /b/c = false, want value and its n-grams")
+	}
+
+	buf, err := EncodeIndexBlock(index)
+	if err != nil {
+		t.Fatalf("EncodeIndexBlock() error = %v", err)
+	}
+
+	got, err := ReadBloom(bytes.NewReader(buf))
+	if err != nil {
+		t.Fatalf("ReadBloom() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, index.Bloom) {
+		t.Errorf("ReadBloom() = %v, want %v", got, index.Bloom)
+	}
+
+	flipped := append([]byte{}, buf...)
+	flipped[len(flipped)-1] ^= 0xff
+	if _, err := ReadBloom(bytes.NewReader(flipped)); !errors.Is(err, ErrCorrupted) {
+		t.Errorf("ReadBloom() error = %v, want %v", err, ErrCorrupted)
+	}
+	if _, err := ReadBloom(bytes.NewReader(buf[:len(buf)-1])); !errors.Is(err, ErrCorrupted) {
+		t.Errorf("ReadBloom() truncated error = %v, want %v", err, ErrCorrupted)
+	}
+
+	// block without text, and version 0 file have no filters
+	noText, err := EncodeIndexBlock(buildIndex(t, `[{"a": 1}]`))
+	if err != nil {
+		t.Fatalf("EncodeIndexBlock() error = %v", err)
+	}
+	for _, b := range [][]byte{readVersion0(t, "v0_text.ssd"), noText} {
+		if got, err := ReadBloom(bytes.NewReader(b)); got != nil || err != nil {
+			t.Errorf("ReadBloom() = %v, %v, want no filters", got, err)
+		}
+	}
+}
@@ -21,352 +21,455 @@ package index
 
 import (
 	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
 	"math"
 	"unsafe"
 
-	hfmi "github.com/rleiwang/hfmi/ctor"
+	"github.com/rleiwang/hfmi"
+	hfmictor "github.com/rleiwang/hfmi/ctor"
 
 	"github.com/chronowave/chronowave/ssd"
 )
 
+// index file of version 1 starts with header of magic [4]byte, version byte, reserved [3]byte,
+// ID uint64, number of sections uint32, sections [n]{kind, offset, length, CRC32C uint32} and
+// CRC32C of header uint32, followed by sections aligned to 8 bytes. version 0 file is version byte
+// followed by sections without table and checksum.
+const (
+	version0 = 0
+	version1 = 1
+
+	headerSize  = 4 + 4 + 8 + 4
+	sectionSize = 4 * 4
+	alignment   = 8
+)
+
+const (
+	sectionEntityID = iota + 1
+	sectionMeta
+	sectionEntity
+	sectionColumnar
+	sectionContent
+	sectionHLT
+	sectionHeaderDA
+	sectionFragDA
+	// sectionBloom is optional, block without text has none
+	sectionBloom
+)
+
+var (
+	magic = [4]byte{'C', 'W', 'I', 'B'}
+
+	castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+	// ErrCorrupted is returned when index file is truncated or fails checksum
+	ErrCorrupted = errors.New("corrupted index block")
+	// ErrVersion is returned for index file of unknown format version
+	ErrVersion = errors.New("unsupported index block version")
+)
+
 func EncodeIndexBlock(index *ssd.IndexedBlock) ([]byte, error) {
 	meta, err := index.Meta.Bytes()
 	if err != nil {
 		return nil, err
 	}
 
-	// entity
-	entity, entdict, entcnt := index.Entity.Bytes(), index.Entity.Dictionary(), index.Entity.Len()
+	sections := []struct {
+		kind uint32
+		data []byte
+	}{
+		{sectionEntityID, encodeUint32s(index.EntityID)},
+		{sectionMeta, meta},
+		{sectionEntity, encodeFMI(index.Entity)},
+		{sectionColumnar, encodeColumnar(index)},
+		{sectionContent, encodeFMI(index.Content)},
+		{sectionHLT, encodeHLT(index)},
+		{sectionHeaderDA, encodeUint32s(index.HeaderDA)},
+		{sectionFragDA, encodeUint32s(index.FragDA)},
+	}
+	if len(index.Bloom) > 0 {
+		sections = append(sections, struct {
+			kind uint32
+			data []byte
+		}{sectionBloom, encodeBloom(index.Bloom)})
+	}
 
-	// content
-	var (
-		content  []byte
-		contdict []byte
-		contcnt  uint
-	)
-	if index.Content != nil {
-		content, contdict, contcnt = index.Content.Bytes(), index.Content.Dictionary(), index.Content.Len()
-	}
-
-	sz := 1 + 8
-	sz += len(index.EntityID)*4 + 4
-	sz += len(meta) + 4
-	sz += len(entity) + len(entdict) + 4*3
-	csz, cols := sizingColumnar(index)
-	sz += csz + 4*len(cols)
-	sz += len(content) + len(contdict) + 4*3
-	hsz, hlt := sizingHLT(index)
-	sz += hsz + 4*len(hlt)
-	sz += len(index.HeaderDA)*4 + 4
-	sz += len(index.FragDA)*4 + 4
+	offset := align(headerSize + sectionSize*len(sections) + 4)
+	// file ends at the last section without padding
+	sz := offset
+	for _, s := range sections {
+		sz = align(sz) + len(s.data)
+	}
 
 	buf := make([]byte, sz)
-	// version
-	buf[0] = 0
-	working := buf[1:]
-	binary.LittleEndian.PutUint64(working[:8], index.ID)
-	working = working[8:]
-
-	binary.LittleEndian.PutUint32(working[:4], uint32(len(index.EntityID)))
-	working = working[4:]
-	for _, v := range index.EntityID {
-		binary.LittleEndian.PutUint32(working[:4], v)
-		working = working[4:]
+	copy(buf, magic[:])
+	buf[4] = version1
+	binary.LittleEndian.PutUint64(buf[8:16], index.ID)
+	binary.LittleEndian.PutUint32(buf[16:20], uint32(len(sections)))
+
+	table := buf[headerSize:]
+	for _, s := range sections {
+		binary.LittleEndian.PutUint32(table[:4], s.kind)
+		binary.LittleEndian.PutUint32(table[4:8], uint32(offset))
+		binary.LittleEndian.PutUint32(table[8:12], uint32(len(s.data)))
+		binary.LittleEndian.PutUint32(table[12:16], crc32.Checksum(s.data, castagnoli))
+		table = table[sectionSize:]
+
+		copy(buf[offset:], s.data)
+		offset = align(offset + len(s.data))
 	}
 
-	binary.LittleEndian.PutUint32(working[:4], uint32(len(meta)))
-	working = working[4:]
-	copy(working, meta)
-	working = working[len(meta):]
+	end := headerSize + sectionSize*len(sections)
+	binary.LittleEndian.PutUint32(buf[end:end+4], crc32.Checksum(buf[:end], castagnoli))
 
-	binary.LittleEndian.PutUint32(working[:4], uint32(entcnt))
-	working = working[4:]
-	binary.LittleEndian.PutUint32(working[:4], uint32(len(entity)))
-	binary.LittleEndian.PutUint32(working[4:8], uint32(len(entdict)))
-	working = working[8:]
-	copy(working, entity)
-	working = working[len(entity):]
-	copy(working, entdict)
-	working = working[len(entdict):]
+	return buf, nil
+}
 
-	for _, c := range cols {
-		binary.LittleEndian.PutUint32(working[:4], c)
-		working = working[4:]
+// DecodeIndexBlock decodes index file of version 0 or 1, slices of index block alias buf.
+// it returns error on corrupted input.
+func DecodeIndexBlock(buf []byte) (index *ssd.IndexedBlock, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			index, err = nil, fmt.Errorf("%w: %v", ErrCorrupted, r)
+		}
+	}()
+
+	switch {
+	case len(buf) >= 4 && string(buf[:4]) == string(magic[:]):
+		return decodeVersion1(buf)
+	case len(buf) > 0 && buf[0] == version0:
+		return decodeVersion0(buf[1:])
+	case len(buf) == 0:
+		return nil, fmt.Errorf("%w: empty file", ErrCorrupted)
 	}
 
-	working = encodeColumnar(index, working)
+	return nil, ErrVersion
+}
 
-	binary.LittleEndian.PutUint32(working[:4], uint32(contcnt))
-	working = working[4:]
-	binary.LittleEndian.PutUint32(working[:4], uint32(len(content)))
-	binary.LittleEndian.PutUint32(working[4:8], uint32(len(contdict)))
-	working = working[8:]
-	copy(working, content)
-	working = working[len(content):]
-	copy(working, contdict)
-	working = working[len(contdict):]
+func decodeVersion1(buf []byte) (*ssd.IndexedBlock, error) {
+	if len(buf) < headerSize {
+		return nil, fmt.Errorf("%w: truncated header", ErrCorrupted)
+	}
+	if buf[4] != version1 {
+		return nil, fmt.Errorf("%w %v", ErrVersion, buf[4])
+	}
 
-	for _, c := range hlt {
-		binary.LittleEndian.PutUint32(working[:4], c)
-		working = working[4:]
+	n := uint64(binary.LittleEndian.Uint32(buf[16:20]))
+	end := headerSize + sectionSize*n
+	if uint64(len(buf)) < end+4 {
+		return nil, fmt.Errorf("%w: truncated section table", ErrCorrupted)
+	}
+	if crc32.Checksum(buf[:end], castagnoli) != binary.LittleEndian.Uint32(buf[end:end+4]) {
+		return nil, fmt.Errorf("%w: header checksum mismatch", ErrCorrupted)
 	}
 
-	working = encodeHLT(index, working)
+	sections := map[uint32]*reader{}
+	for table := buf[headerSize:end]; len(table) > 0; table = table[sectionSize:] {
+		kind := binary.LittleEndian.Uint32(table[:4])
+		offset := uint64(binary.LittleEndian.Uint32(table[4:8]))
+		length := uint64(binary.LittleEndian.Uint32(table[8:12]))
+		if offset+length > uint64(len(buf)) {
+			return nil, fmt.Errorf("%w: section %v is truncated", ErrCorrupted, kind)
+		}
 
-	binary.LittleEndian.PutUint32(working[:4], uint32(len(index.HeaderDA)))
-	working = working[4:]
-	for _, v := range index.HeaderDA {
-		binary.LittleEndian.PutUint32(working[:4], v)
-		working = working[4:]
+		data := buf[offset : offset+length]
+		if crc32.Checksum(data, castagnoli) != binary.LittleEndian.Uint32(table[12:16]) {
+			return nil, fmt.Errorf("%w: section %v checksum mismatch", ErrCorrupted, kind)
+		}
+		// unknown section is skipped
+		sections[kind] = &reader{buf: data}
 	}
 
-	binary.LittleEndian.PutUint32(working[:4], uint32(len(index.FragDA)))
-	working = working[4:]
-	for _, v := range index.FragDA {
-		binary.LittleEndian.PutUint32(working[:4], v)
-		working = working[4:]
+	for kind := uint32(sectionEntityID); kind <= sectionFragDA; kind++ {
+		if _, ok := sections[kind]; !ok {
+			return nil, fmt.Errorf("%w: missing section %v", ErrCorrupted, kind)
+		}
 	}
 
-	return buf, nil
-}
+	index := &ssd.IndexedBlock{ID: binary.LittleEndian.Uint64(buf[8:16])}
 
-func DecodeIndexBlock(buf []byte) (*ssd.IndexedBlock, error) {
-	// version buf[0]
-	buf = buf[1:]
+	r := sections[sectionEntityID]
+	index.EntityID = r.uint32s(uint64(len(r.buf) / 4))
 
-	index := &ssd.IndexedBlock{
-		ID: binary.LittleEndian.Uint64(buf[:8]),
+	var err error
+	r = sections[sectionMeta]
+	if index.Meta, err = ssd.EntityMetaFromBytes(r.buf); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCorrupted, err)
 	}
-	buf = buf[8:]
 
-	sz := binary.LittleEndian.Uint32(buf[:4])
-	buf = buf[4:]
-	index.EntityID = *(*[]uint32)(unsafe.Pointer(&buf))
-	index.EntityID = index.EntityID[:sz]
-	buf = buf[sz*4:]
+	index.Entity = sections[sectionEntity].fmi()
+	sections[sectionColumnar].columnar(index)
+	index.Content = sections[sectionContent].fmi()
+	sections[sectionHLT].hlt(index)
 
-	sz = binary.LittleEndian.Uint32(buf[:4])
-	buf = buf[4:]
+	r = sections[sectionHeaderDA]
+	index.HeaderDA = r.uint32s(uint64(len(r.buf) / 4))
+	r = sections[sectionFragDA]
+	index.FragDA = r.uint32s(uint64(len(r.buf) / 4))
 
-	var err error
-	if index.Meta, err = ssd.EntityMetaFromBytes(buf[:sz]); err != nil {
-		return nil, err
+	if r, ok := sections[sectionBloom]; ok {
+		if index.Bloom, err = decodeBloom(r.buf); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, r := range sections {
+		if r.err != nil {
+			return nil, r.err
+		}
 	}
-	buf = buf[sz:]
-
-	cnt := binary.LittleEndian.Uint32(buf[:4])
-	sz = binary.LittleEndian.Uint32(buf[4:8])
-	dict := binary.LittleEndian.Uint32(buf[8:12])
-	buf = buf[12:]
-	index.Entity = hfmi.Build(uint(cnt), buf[sz:sz+dict], buf[:sz])
-	buf = buf[sz+dict:]
-
-	// columnar
-	cols := *(*[]uint32)(unsafe.Pointer(&buf))
-	cols = cols[:4]
-	buf = buf[16:]
-
-	buf = decodeColumnar(index, buf, cols)
-
-	cnt = binary.LittleEndian.Uint32(buf[:4])
-	sz = binary.LittleEndian.Uint32(buf[4:8])
-	dict = binary.LittleEndian.Uint32(buf[8:12])
-	buf = buf[12:]
-	index.Content = hfmi.Build(uint(cnt), buf[sz:sz+dict], buf[:sz])
-	buf = buf[sz+dict:]
-
-	// HLT
-	hlt := *(*[]uint32)(unsafe.Pointer(&buf))
-	hlt = hlt[:5]
-	buf = buf[20:]
-	buf = decodeHLT(index, buf, hlt)
-
-	sz = binary.LittleEndian.Uint32(buf[:4])
-	buf = buf[4:]
-	index.HeaderDA = *(*[]uint32)(unsafe.Pointer(&buf))
-	index.HeaderDA = index.HeaderDA[:sz]
-	buf = buf[sz*4:]
-
-	sz = binary.LittleEndian.Uint32(buf[:4])
-	buf = buf[4:]
-	index.FragDA = *(*[]uint32)(unsafe.Pointer(&buf))
-	index.FragDA = index.FragDA[:sz]
 
 	return index, nil
 }
 
-func sizingHLT(index *ssd.IndexedBlock) (int, []uint32) {
-	sz := make([]uint32, 5)
-	sz[0] = uint32(len(index.HLT.Text.Entity))
-	sz[1] = uint32(len(index.HLT.Float64.Entity))
-	sz[2] = uint32(len(index.HLT.Int64.Entity))
-	sz[3] = uint32(len(index.HLT.Bool.Entity))
-	sz[4] = uint32(len(index.HLT.Null.Entity))
-
-	bytes := 0
-	for _, s := range sz {
-		bytes += int(s) * 4
-	}
+func decodeVersion0(buf []byte) (*ssd.IndexedBlock, error) {
+	r := &reader{buf: buf}
 
-	return bytes, sz
-}
+	index := &ssd.IndexedBlock{ID: r.uint64()}
+	index.EntityID = r.uint32s(uint64(r.uint32()))
 
-func encodeHLT(index *ssd.IndexedBlock, buf []byte) []byte {
-	for _, v := range index.HLT.Text.Entity {
-		binary.LittleEndian.PutUint16(buf[:2], v)
-		buf = buf[2:]
-	}
-	for _, v := range index.HLT.Text.Attribute {
-		binary.LittleEndian.PutUint16(buf[:2], v)
-		buf = buf[2:]
+	meta := r.bytes(uint64(r.uint32()))
+	if r.err != nil {
+		return nil, r.err
 	}
 
-	for _, v := range index.HLT.Float64.Entity {
-		binary.LittleEndian.PutUint16(buf[:2], v)
-		buf = buf[2:]
-	}
-	for _, v := range index.HLT.Float64.Attribute {
-		binary.LittleEndian.PutUint16(buf[:2], v)
-		buf = buf[2:]
+	var err error
+	if index.Meta, err = ssd.EntityMetaFromBytes(meta); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCorrupted, err)
 	}
 
-	for _, v := range index.HLT.Int64.Entity {
-		binary.LittleEndian.PutUint16(buf[:2], v)
-		buf = buf[2:]
+	index.Entity = r.fmi()
+	r.columnar(index)
+	index.Content = r.fmi()
+	r.hlt(index)
+	index.HeaderDA = r.uint32s(uint64(r.uint32()))
+	index.FragDA = r.uint32s(uint64(r.uint32()))
+
+	if r.err != nil {
+		return nil, r.err
 	}
 
-	for _, v := range index.HLT.Int64.Attribute {
-		binary.LittleEndian.PutUint16(buf[:2], v)
-		buf = buf[2:]
+	return index, nil
+}
+
+func align(n int) int {
+	return (n + alignment - 1) / alignment * alignment
+}
+
+func encodeUint32s(values []uint32) []byte {
+	buf := make([]byte, len(values)*4)
+	for i, v := range values {
+		binary.LittleEndian.PutUint32(buf[i*4:], v)
 	}
+	return buf
+}
 
-	for _, v := range index.HLT.Bool.Entity {
-		binary.LittleEndian.PutUint16(buf[:2], v)
-		buf = buf[2:]
+// encodeFMI encodes count, sizes of FM-index and its dictionary, followed by them
+func encodeFMI(fmi hfmi.FMI) []byte {
+	var (
+		data []byte
+		dict []byte
+		cnt  uint
+	)
+	if fmi != nil {
+		data, dict, cnt = fmi.Bytes(), fmi.Dictionary(), fmi.Len()
 	}
 
-	for _, v := range index.HLT.Bool.Attribute {
-		binary.LittleEndian.PutUint16(buf[:2], v)
-		buf = buf[2:]
+	buf := make([]byte, 12, 12+len(data)+len(dict))
+	binary.LittleEndian.PutUint32(buf[:4], uint32(cnt))
+	binary.LittleEndian.PutUint32(buf[4:8], uint32(len(data)))
+	binary.LittleEndian.PutUint32(buf[8:12], uint32(len(dict)))
+	buf = append(buf, data...)
+	return append(buf, dict...)
+}
+
+// encodeHLT encodes number of headers of each type, followed by entity and attribute of headers
+func encodeHLT(index *ssd.IndexedBlock) []byte {
+	isa := []ssd.HeaderISA{index.HLT.Text, index.HLT.Float64, index.HLT.Int64, index.HLT.Bool, index.HLT.Null}
+
+	sz := 4 * len(isa)
+	for _, h := range isa {
+		sz += len(h.Entity) * 4
 	}
 
-	for _, v := range index.HLT.Null.Entity {
-		binary.LittleEndian.PutUint16(buf[:2], v)
-		buf = buf[2:]
+	buf := make([]byte, sz)
+	working := buf
+	for _, h := range isa {
+		binary.LittleEndian.PutUint32(working[:4], uint32(len(h.Entity)))
+		working = working[4:]
 	}
 
-	for _, v := range index.HLT.Null.Attribute {
-		binary.LittleEndian.PutUint16(buf[:2], v)
-		buf = buf[2:]
+	for _, h := range isa {
+		for _, v := range h.Entity {
+			binary.LittleEndian.PutUint16(working[:2], v)
+			working = working[2:]
+		}
+		for _, v := range h.Attribute {
+			binary.LittleEndian.PutUint16(working[:2], v)
+			working = working[2:]
+		}
 	}
 
 	return buf
 }
 
-func decodeHLT(index *ssd.IndexedBlock, buf []byte, sz []uint32) []byte {
-	i := sz[0]
-	index.HLT.Text.Entity = *(*[]uint16)(unsafe.Pointer(&buf))
-	index.HLT.Text.Entity = index.HLT.Text.Entity[:i]
-	buf = buf[i*2:]
+// encodeColumnar encodes sizes in bytes of float64, int64, bool and text columns, followed by them
+func encodeColumnar(index *ssd.IndexedBlock) []byte {
+	sz := []uint32{
+		uint32(len(index.Columnar.Float64)) * 8,
+		uint32(len(index.Columnar.Int64)) * 8,
+		uint32(len(index.Columnar.Bool)),
+		uint32(len(index.Columnar.Text)) * 4,
+	}
 
-	index.HLT.Text.Attribute = *(*[]uint16)(unsafe.Pointer(&buf))
-	index.HLT.Text.Attribute = index.HLT.Text.Attribute[:i]
-	buf = buf[i*2:]
+	total := 4 * len(sz)
+	for _, s := range sz {
+		total += int(s)
+	}
 
-	i = sz[1]
-	index.HLT.Float64.Entity = *(*[]uint16)(unsafe.Pointer(&buf))
-	index.HLT.Float64.Entity = index.HLT.Float64.Entity[:i]
-	buf = buf[i*2:]
+	buf := make([]byte, total)
+	working := buf
+	for _, s := range sz {
+		binary.LittleEndian.PutUint32(working[:4], s)
+		working = working[4:]
+	}
 
-	index.HLT.Float64.Attribute = *(*[]uint16)(unsafe.Pointer(&buf))
-	index.HLT.Float64.Attribute = index.HLT.Float64.Attribute[:i]
-	buf = buf[i*2:]
+	for _, f := range index.Columnar.Float64 {
+		binary.LittleEndian.PutUint64(working[:8], math.Float64bits(f))
+		working = working[8:]
+	}
 
-	i = sz[2]
-	index.HLT.Int64.Entity = *(*[]uint16)(unsafe.Pointer(&buf))
-	index.HLT.Int64.Entity = index.HLT.Int64.Entity[:i]
-	buf = buf[i*2:]
+	for _, v := range index.Columnar.Int64 {
+		binary.LittleEndian.PutUint64(working[:8], uint64(v))
+		working = working[8:]
+	}
 
-	index.HLT.Int64.Attribute = *(*[]uint16)(unsafe.Pointer(&buf))
-	index.HLT.Int64.Attribute = index.HLT.Int64.Attribute[:i]
-	buf = buf[i*2:]
+	for i, v := range index.Columnar.Bool {
+		if v {
+			working[i] = 1
+		}
+	}
+	working = working[len(index.Columnar.Bool):]
 
-	i = sz[3]
-	index.HLT.Bool.Entity = *(*[]uint16)(unsafe.Pointer(&buf))
-	index.HLT.Bool.Entity = index.HLT.Bool.Entity[:i]
-	buf = buf[i*2:]
+	for _, v := range index.Columnar.Text {
+		binary.LittleEndian.PutUint32(working[:4], v)
+		working = working[4:]
+	}
 
-	index.HLT.Bool.Attribute = *(*[]uint16)(unsafe.Pointer(&buf))
-	index.HLT.Bool.Attribute = index.HLT.Bool.Attribute[:i]
-	buf = buf[i*2:]
+	return buf
+}
 
-	i = sz[4]
-	index.HLT.Null.Entity = *(*[]uint16)(unsafe.Pointer(&buf))
-	index.HLT.Null.Entity = index.HLT.Null.Entity[:i]
-	buf = buf[i*2:]
+// reader reads buffer with bounds check, the first error is kept and the following reads are empty
+type reader struct {
+	buf []byte
+	err error
+}
 
-	index.HLT.Null.Attribute = *(*[]uint16)(unsafe.Pointer(&buf))
-	index.HLT.Null.Attribute = index.HLT.Null.Attribute[:i]
+func (r *reader) bytes(n uint64) []byte {
+	if r.err != nil {
+		return nil
+	}
+	if n > uint64(len(r.buf)) {
+		r.err = fmt.Errorf("%w: need %v bytes, %v left", ErrCorrupted, n, len(r.buf))
+		return nil
+	}
 
-	return buf[i*2:]
+	b := r.buf[:n:n]
+	r.buf = r.buf[n:]
+	return b
 }
 
-func sizingColumnar(index *ssd.IndexedBlock) (int, []uint32) {
-	sz := make([]uint32, 4)
-	sz[0] = uint32(len(index.Columnar.Float64)) * 8
-	sz[1] = uint32(len(index.Columnar.Int64)) * 8
-	sz[2] = uint32(len(index.Columnar.Bool))
-	sz[3] = uint32(len(index.Columnar.Text)) * 4
-
-	bytes := 0
-	for _, s := range sz {
-		bytes += int(s)
+func (r *reader) uint32() uint32 {
+	if b := r.bytes(4); len(b) == 4 {
+		return binary.LittleEndian.Uint32(b)
 	}
+	return 0
+}
 
-	return bytes, sz
+func (r *reader) uint64() uint64 {
+	if b := r.bytes(8); len(b) == 8 {
+		return binary.LittleEndian.Uint64(b)
+	}
+	return 0
 }
 
-func encodeColumnar(index *ssd.IndexedBlock, buf []byte) []byte {
-	for _, f := range index.Columnar.Float64 {
-		binary.LittleEndian.PutUint64(buf[:8], math.Float64bits(f))
-		buf = buf[8:]
+// slices below alias buffer of reader, lengths are bounded by 1GB arrays for 32 bits platforms
+func (r *reader) uint16s(n uint64) []uint16 {
+	if b := r.bytes(n * 2); len(b) > 0 && n <= 1<<29 {
+		return (*[1 << 29]uint16)(unsafe.Pointer(&b[0]))[:n:n]
 	}
+	return []uint16{}
+}
 
-	for _, v := range index.Columnar.Int64 {
-		binary.LittleEndian.PutUint64(buf[:8], uint64(v))
-		buf = buf[8:]
+func (r *reader) uint32s(n uint64) []uint32 {
+	if b := r.bytes(n * 4); len(b) > 0 && n <= 1<<28 {
+		return (*[1 << 28]uint32)(unsafe.Pointer(&b[0]))[:n:n]
 	}
+	return []uint32{}
+}
 
-	for i, v := range index.Columnar.Bool {
-		if v {
-			buf[i] = 1
-		}
+func (r *reader) int64s(n uint64) []int64 {
+	if b := r.bytes(n * 8); len(b) > 0 && n <= 1<<27 {
+		return (*[1 << 27]int64)(unsafe.Pointer(&b[0]))[:n:n]
 	}
-	buf = buf[len(index.Columnar.Bool):]
+	return []int64{}
+}
 
-	for _, v := range index.Columnar.Text {
-		binary.LittleEndian.PutUint32(buf[:4], v)
-		buf = buf[4:]
+func (r *reader) float64s(n uint64) []float64 {
+	if b := r.bytes(n * 8); len(b) > 0 && n <= 1<<27 {
+		return (*[1 << 27]float64)(unsafe.Pointer(&b[0]))[:n:n]
 	}
+	return []float64{}
+}
 
-	return buf
+func (r *reader) bools(n uint64) []bool {
+	if b := r.bytes(n); len(b) > 0 {
+		return *(*[]bool)(unsafe.Pointer(&b))
+	}
+	return []bool{}
 }
 
-func decodeColumnar(index *ssd.IndexedBlock, buf []byte, sz []uint32) []byte {
-	index.Columnar.Float64 = *(*[]float64)(unsafe.Pointer(&buf))
-	index.Columnar.Float64 = index.Columnar.Float64[:sz[0]/8]
-	buf = buf[sz[0]:]
+// fmi returns nil for empty FM-index, e.g. content of block without text
+func (r *reader) fmi() hfmi.FMI {
+	cnt := r.uint32()
+	sz := r.uint32()
+	dict := r.uint32()
+	data := r.bytes(uint64(sz))
+	ridx := r.bytes(uint64(dict))
+	if r.err != nil || sz == 0 {
+		return nil
+	}
+	if sz < 4 || uint64(binary.LittleEndian.Uint32(data[:4]))+4 > uint64(sz) {
+		r.err = fmt.Errorf("%w: invalid FM-index", ErrCorrupted)
+		return nil
+	}
 
-	index.Columnar.Int64 = *(*[]int64)(unsafe.Pointer(&buf))
-	index.Columnar.Int64 = index.Columnar.Int64[:sz[1]/8]
-	buf = buf[sz[1]:]
+	return hfmictor.Build(uint(cnt), ridx, data)
+}
+
+func (r *reader) columnar(index *ssd.IndexedBlock) {
+	var sz [4]uint32
+	for i := range sz {
+		sz[i] = r.uint32()
+	}
 
-	index.Columnar.Bool = *(*[]bool)(unsafe.Pointer(&buf))
-	index.Columnar.Bool = index.Columnar.Bool[:sz[2]]
-	buf = buf[sz[2]:]
+	index.Columnar.Float64 = r.float64s(uint64(sz[0] / 8))
+	index.Columnar.Int64 = r.int64s(uint64(sz[1] / 8))
+	index.Columnar.Bool = r.bools(uint64(sz[2]))
+	index.Columnar.Text = r.uint32s(uint64(sz[3] / 4))
+}
 
-	index.Columnar.Text = *(*[]uint32)(unsafe.Pointer(&buf))
-	index.Columnar.Text = index.Columnar.Text[:sz[3]/4]
+func (r *reader) hlt(index *ssd.IndexedBlock) {
+	var sz [5]uint32
+	for i := range sz {
+		sz[i] = r.uint32()
+	}
 
-	return buf[sz[3]:]
+	isa := []*ssd.HeaderISA{&index.HLT.Text, &index.HLT.Float64, &index.HLT.Int64, &index.HLT.Bool, &index.HLT.Null}
+	for i, h := range isa {
+		h.Entity = r.uint16s(uint64(sz[i]))
+		h.Attribute = r.uint16s(uint64(sz[i]))
+	}
 }
@@ -0,0 +1,158 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package index
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	hfmi "github.com/rleiwang/hfmi/ctor"
+
+	"github.com/chronowave/chronowave/ssd"
+	"github.com/chronowave/chronowave/ssd/codec"
+)
+
+// readVersion0 reads index block file written by encoder of version 0, see testdata/README.md
+func readVersion0(t *testing.T, name string) []byte {
+	buf, err := ioutil.ReadFile(filepath.Join("testdata", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf
+}
+
+func buildIndex(t *testing.T, json string) *ssd.IndexedBlock {
+	hfmi.SetSegmentCache(1024)
+	parsed, err := codec.ParseJson([]byte(json))
+	if err != nil {
+		t.Fatalf("ParseJson() error = %v", err)
+	}
+
+	index, err := Build(parsed)
+	if err != nil {
+		t.Fatalf("Build() error = %v", err)
+	}
+	index.ID = 42
+	return index
+}
+
+// assertIndexBlock compares index blocks by their encoding
+func assertIndexBlock(t *testing.T, got, want *ssd.IndexedBlock) {
+	x, err := EncodeIndexBlock(got)
+	if err != nil {
+		t.Fatalf("EncodeIndexBlock() error = %v", err)
+	}
+	y, _ := EncodeIndexBlock(want)
+	if !bytes.Equal(x, y) {
+		t.Errorf("DecodeIndexBlock() = %+v, want %+v", got, want)
+	}
+}
+
+func TestIndexBlockCodec(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		// the same documents in version 0
+		v0 string
+	}{
+		{"text", `[{"a": "x", "b": 1.5}, {"a": "y", "c": [1, 2]}, {"d": true, "e": null}]`, "v0_text.ssd"},
+		{"no text", `[{"a": 1}, {"b": 2.5}]`, "v0_no_text.ssd"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			index := buildIndex(t, tt.json)
+
+			buf, err := EncodeIndexBlock(index)
+			if err != nil {
+				t.Fatalf("EncodeIndexBlock() error = %v", err)
+			}
+
+			got, err := DecodeIndexBlock(buf)
+			if err != nil {
+				t.Fatalf("DecodeIndexBlock() error = %v", err)
+			}
+			assertIndexBlock(t, got, index)
+
+			got, err = DecodeIndexBlock(readVersion0(t, tt.v0))
+			if err != nil {
+				t.Fatalf("DecodeIndexBlock() version 0 error = %v", err)
+			}
+			// version 0 has no Bloom filters
+			v0 := *index
+			v0.Bloom = nil
+			assertIndexBlock(t, got, &v0)
+		})
+	}
+}
+
+func TestDecodeCorruptedIndexBlock(t *testing.T) {
+	index := buildIndex(t, `[{"a": "x", "b": 1.5}, {"a": "y", "c": [1, 2]}]`)
+	buf, err := EncodeIndexBlock(index)
+	if err != nil {
+		t.Fatalf("EncodeIndexBlock() error = %v", err)
+	}
+	v0 := readVersion0(t, "v0_text.ssd")
+
+	for i := 0; i < len(buf); i++ {
+		if _, err := DecodeIndexBlock(buf[:i]); err == nil {
+			t.Errorf("DecodeIndexBlock() truncated at %v, want error", i)
+		}
+
+		flipped := append([]byte{}, buf...)
+		flipped[i] ^= 0xff
+		if _, err := DecodeIndexBlock(flipped); err == nil && !isPadding(buf, i) {
+			t.Errorf("DecodeIndexBlock() flipped at %v, want error", i)
+		}
+	}
+
+	for i := 0; i < len(v0); i++ {
+		// version 0 has no checksum, it must not panic
+		DecodeIndexBlock(v0[:i])
+	}
+
+	buf[4] = 9
+	if _, err := DecodeIndexBlock(buf); !errors.Is(err, ErrVersion) {
+		t.Errorf("DecodeIndexBlock() error = %v, want %v", err, ErrVersion)
+	}
+	if _, err := DecodeIndexBlock([]byte("not an index")); !errors.Is(err, ErrVersion) {
+		t.Errorf("DecodeIndexBlock() error = %v, want %v", err, ErrVersion)
+	}
+}
+
+// isPadding tells whether offset i of index file is alignment padding or reserved header bytes
+func isPadding(buf []byte, i int) bool {
+	if i >= 5 && i < 8 {
+		return true
+	}
+
+	n := int(binary.LittleEndian.Uint32(buf[16:20]))
+	for j := 0; j < n; j++ {
+		s := buf[headerSize+j*sectionSize:]
+		offset, length := int(binary.LittleEndian.Uint32(s[4:8])), int(binary.LittleEndian.Uint32(s[8:12]))
+		if i >= offset && i < offset+length {
+			return false
+		}
+	}
+	return i >= headerSize+n*sectionSize+4
+}
@@ -38,6 +38,8 @@ func Build(parsed *ssd.ParsedBlock) (*ssd.IndexedBlock, error) {
 	index := &ssd.IndexedBlock{
 		EntityID: make([]uint32, parsed.Count),
 		Meta:     parsed.Meta,
+		// built ahead of FM-index, which overwrites parsed entity and content
+		Bloom: buildBloom(parsed),
 	}
 
 	index.Columnar.Float64 = make([]float64, len(parsed.Columnar.Float64))
@@ -24,6 +24,7 @@ import (
 	"testing"
 
 	"github.com/chronowave/chronowave/ssd"
+	"github.com/chronowave/chronowave/ssd/aggregator"
 )
 
 func TestColumnInt64Bytes(t *testing.T) {
@@ -93,6 +94,20 @@ func TestResultSet(t *testing.T) {
 			},
 			Text: [][]byte{[]byte("test"), []byte("test2")},
 		}}},
+		{"aggregate", args{&ssd.ResultSet{
+			RowId:      []uint64{15},
+			ColumnType: []byte{0, 0, 0},
+			Column: []ssd.Column{
+				{RowIdx: []byte{1}, Value: []uint64{0}},
+				{RowIdx: []byte{1}, Value: []uint64{1}},
+				{RowIdx: []byte{1}, Value: []uint64{2}},
+			},
+			Aggregate: []aggregator.Aggregator{
+				stepped(aggregator.NewCount(), 3),
+				stepped(aggregator.NewAverage(), 4),
+				stepped(aggregator.NewDistinct(0), 5),
+			},
+		}}},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -107,3 +122,11 @@ func TestResultSet(t *testing.T) {
 		})
 	}
 }
+
+// stepped steps aggregator by integers from 0 to n
+func stepped(a aggregator.Aggregator, n int64) aggregator.Aggregator {
+	for v := int64(0); v < n; v++ {
+		a.StepInt64(v)
+	}
+	return a
+}
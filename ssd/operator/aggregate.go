@@ -0,0 +1,487 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package operator
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/chronowave/chronowave/ssql"
+
+	"github.com/chronowave/chronowave/ssd"
+	"github.com/chronowave/chronowave/ssd/aggregator"
+)
+
+const (
+	// DefaultGroups is number of groups an Aggregation keeps in memory by default
+	DefaultGroups = 1 << 16
+
+	// spilled groups are partitioned by group hash, a partition is merged at a time
+	spillPartitions = 16
+)
+
+var errSpill = errors.New("corrupted spill file of aggregation")
+
+// IsAggregate returns true if statement finds aggregate functions or groups by variables
+func IsAggregate(stmt *ssql.Statement) bool {
+	for _, f := range stmt.Find {
+		if f.Group || f.Func != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Aggregation merges result sets of aggregate statement, consolidated per index block, one at a
+// time as blocks are searched, so that a row per group is kept instead of result sets of all blocks.
+// Once there are more groups than its limit, groups are spilled to files partitioned by group hash,
+// and Result merges them a partition at a time.
+type Aggregation struct {
+	grouped   bool
+	aggregate []bool
+	names     []string
+	types     []byte
+	limit     int
+	dir       string
+
+	groups map[uint64]int
+	rows   []*group
+
+	files   []*os.File
+	writers []*bufio.Writer
+	err     error
+}
+
+// group is a row of group values and aggregates, TEXT and JSON values are kept in data
+type group struct {
+	rid   uint64
+	set   []bool
+	value []uint64
+	data  [][]byte
+	agg   []aggregator.Aggregator
+}
+
+// NewAggregation merges result sets of statement with up to limit groups in memory, default to
+// DefaultGroups if limit is not positive, groups beyond are spilled to files in dir
+func NewAggregation(stmt *ssql.Statement, limit int, dir string) *Aggregation {
+	if limit <= 0 {
+		limit = DefaultGroups
+	}
+
+	a := &Aggregation{
+		aggregate: make([]bool, len(stmt.Find)),
+		names:     make([]string, len(stmt.Find)),
+		types:     make([]byte, len(stmt.Find)),
+		limit:     limit,
+		dir:       dir,
+		groups:    map[uint64]int{},
+	}
+
+	functions := make([]*ssql.Function, len(stmt.Find))
+	for i, f := range stmt.Find {
+		a.names[i] = f.Name
+		if !f.Group {
+			functions[i] = f.Func
+		}
+		a.grouped = a.grouped || f.Group
+		if f.Func != nil {
+			a.aggregate[i] = true
+		} else {
+			a.types[i] = ssd.NULL
+		}
+	}
+	a.names = columnNames(a.names, functions)
+
+	return a
+}
+
+// Add merges groups of result set, and spills groups to disk once there are more than limit
+func (a *Aggregation) Add(rs *ssd.ResultSet) error {
+	if a.err != nil || rs == nil {
+		return a.err
+	}
+
+	for j, rid := range rs.RowId {
+		if !a.grouped {
+			// aggregates without group by are a single row
+			rid = 0
+		}
+
+		g := a.group(rid)
+		for i := range rs.Column {
+			c := &rs.Column[i]
+			if i >= len(a.names) || len(c.RowIdx) == 0 || c.RowIdx[j] == 0 {
+				continue
+			}
+
+			if a.aggregate[i] {
+				if f := rs.Aggregate[c.Value[j]]; g.agg[i] == nil {
+					g.agg[i] = f
+				} else {
+					g.agg[i].Merge(f)
+				}
+				continue
+			} else if g.set[i] {
+				continue
+			}
+
+			g.set[i] = true
+			if a.types[i] == ssd.NULL {
+				a.types[i] = rs.ColumnType[i]
+			}
+
+			switch rs.ColumnType[i] {
+			case ssd.TEXT:
+				g.data[i] = append([]byte(nil), rs.Text[c.Value[j]]...)
+			case ssd.JSON:
+				g.data[i] = append([]byte(nil), rs.Json[c.Value[j]]...)
+			default:
+				g.value[i] = c.Value[j]
+			}
+		}
+	}
+
+	if len(a.rows) > a.limit {
+		a.err = a.spill()
+	}
+
+	return a.err
+}
+
+// Groups returns number of groups in memory
+func (a *Aggregation) Groups() int {
+	return len(a.rows)
+}
+
+// Spilled returns true if groups have been spilled to disk
+func (a *Aggregation) Spilled() bool {
+	return a.files != nil
+}
+
+// Result returns merged groups in order of their first appearance unless spilled, merging stops once
+// there are limit groups of complete partitions, if limit is positive
+func (a *Aggregation) Result(limit uint32) (*ssd.ResultSet, error) {
+	if a.err != nil {
+		return nil, a.err
+	} else if a.files == nil {
+		return a.resultSet(a.rows), nil
+	}
+
+	if err := a.spill(); err != nil {
+		return nil, err
+	}
+
+	var merged []*group
+	for _, f := range a.files {
+		if limit > 0 && len(merged) >= int(limit) {
+			break
+		}
+
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return nil, err
+		}
+
+		r := bufio.NewReader(f)
+		for {
+			g, err := a.readGroup(r)
+			if err == io.EOF {
+				break
+			} else if err != nil {
+				return nil, err
+			}
+			a.merge(g)
+		}
+
+		merged = append(merged, a.rows...)
+		a.reset()
+	}
+
+	return a.resultSet(merged), nil
+}
+
+// Close removes spill files
+func (a *Aggregation) Close() {
+	for _, f := range a.files {
+		f.Close()
+		os.Remove(f.Name())
+	}
+	a.files, a.writers = nil, nil
+}
+
+// group returns group of hash rid, a new one is added if it doesn't exist
+func (a *Aggregation) group(rid uint64) *group {
+	if idx, ok := a.groups[rid]; ok {
+		return a.rows[idx]
+	}
+
+	noc := len(a.names)
+	g := &group{
+		rid:   rid,
+		set:   make([]bool, noc),
+		value: make([]uint64, noc),
+		data:  make([][]byte, noc),
+		agg:   make([]aggregator.Aggregator, noc),
+	}
+	a.groups[rid] = len(a.rows)
+	a.rows = append(a.rows, g)
+
+	return g
+}
+
+// merge merges spilled group into group of the same hash
+func (a *Aggregation) merge(s *group) {
+	g := a.group(s.rid)
+	for i := range g.set {
+		if a.aggregate[i] {
+			if g.agg[i] == nil {
+				g.agg[i] = s.agg[i]
+			} else if s.agg[i] != nil {
+				g.agg[i].Merge(s.agg[i])
+			}
+		} else if !g.set[i] && s.set[i] {
+			g.set[i], g.value[i], g.data[i] = true, s.value[i], s.data[i]
+		}
+	}
+}
+
+func (a *Aggregation) reset() {
+	a.groups = map[uint64]int{}
+	a.rows = nil
+}
+
+// spill appends groups in memory to spill files of their partitions
+func (a *Aggregation) spill() error {
+	if a.files == nil {
+		if err := os.MkdirAll(a.dir, os.ModePerm); err != nil {
+			return err
+		}
+
+		for i := 0; i < spillPartitions; i++ {
+			f, err := ioutil.TempFile(a.dir, "aggregate")
+			if err != nil {
+				a.Close()
+				return err
+			}
+			a.files = append(a.files, f)
+			a.writers = append(a.writers, bufio.NewWriter(f))
+		}
+	}
+
+	var buf []byte
+	for _, g := range a.rows {
+		buf = a.encodeGroup(buf[:0], g)
+		if _, err := a.writers[g.rid%spillPartitions].Write(buf); err != nil {
+			return err
+		}
+	}
+
+	for _, w := range a.writers {
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	a.reset()
+	return nil
+}
+
+// encodeGroup appends group to buf as size of record (4) + group hash (8) + columns, each is
+// presence (1) + aggregate size (4) + aggregate bytes, or value (8) + data size (4) + data
+func (a *Aggregation) encodeGroup(buf []byte, g *group) []byte {
+	var scratch [8]byte
+	buf = append(buf, scratch[:4]...)
+	binary.LittleEndian.PutUint64(scratch[:], g.rid)
+	buf = append(buf, scratch[:]...)
+
+	for i := range g.set {
+		switch {
+		case a.aggregate[i] && g.agg[i] != nil:
+			buf = appendData(append(buf, 1), g.agg[i].Bytes())
+		case !a.aggregate[i] && g.set[i]:
+			binary.LittleEndian.PutUint64(scratch[:], g.value[i])
+			buf = appendData(append(append(buf, 1), scratch[:]...), g.data[i])
+		default:
+			buf = append(buf, 0)
+		}
+	}
+
+	binary.LittleEndian.PutUint32(buf[:4], uint32(len(buf)-4))
+	return buf
+}
+
+// appendData appends size of data (4) and data to buf
+func appendData(buf, data []byte) []byte {
+	var scratch [4]byte
+	binary.LittleEndian.PutUint32(scratch[:], uint32(len(data)))
+	return append(append(buf, scratch[:]...), data...)
+}
+
+// readGroup reads a group encoded by encodeGroup, it returns io.EOF at the end of spill file
+func (a *Aggregation) readGroup(r io.Reader) (*group, error) {
+	var scratch [4]byte
+	if _, err := io.ReadFull(r, scratch[:]); err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, binary.LittleEndian.Uint32(scratch[:]))
+	if _, err := io.ReadFull(r, buf); err != nil || len(buf) < 8 {
+		return nil, errSpill
+	}
+
+	noc := len(a.names)
+	g := &group{
+		rid:   binary.LittleEndian.Uint64(buf[:8]),
+		set:   make([]bool, noc),
+		value: make([]uint64, noc),
+		data:  make([][]byte, noc),
+		agg:   make([]aggregator.Aggregator, noc),
+	}
+
+	buf = buf[8:]
+	for i := 0; i < noc; i++ {
+		if len(buf) == 0 {
+			return nil, errSpill
+		}
+		present := buf[0] == 1
+		buf = buf[1:]
+		if !present {
+			continue
+		}
+
+		if !a.aggregate[i] {
+			if len(buf) < 8 {
+				return nil, errSpill
+			}
+			g.set[i], g.value[i], buf = true, binary.LittleEndian.Uint64(buf[:8]), buf[8:]
+		}
+
+		if len(buf) < 4 {
+			return nil, errSpill
+		}
+		sz := binary.LittleEndian.Uint32(buf[:4])
+		buf = buf[4:]
+		if uint32(len(buf)) < sz {
+			return nil, errSpill
+		}
+		data := buf[:sz:sz]
+		buf = buf[sz:]
+
+		if !a.aggregate[i] {
+			g.data[i] = data
+		} else if sz == 0 {
+			return nil, errSpill
+		} else if g.agg[i] = aggregator.FromBytes(data); g.agg[i] == nil {
+			return nil, errSpill
+		}
+	}
+
+	return g, nil
+}
+
+// resultSet converts groups to result set, aggregate columns are of type 0 valued by index of aggregate
+func (a *Aggregation) resultSet(rows []*group) *ssd.ResultSet {
+	nor, noc := len(rows), len(a.names)
+	rs := &ssd.ResultSet{
+		RowId:      make([]uint64, nor),
+		ColumnType: make([]byte, noc),
+		Column:     make([]ssd.Column, noc),
+		Text:       [][]byte{},
+		Aggregate:  []aggregator.Aggregator{},
+	}
+	copy(rs.ColumnType, a.types)
+
+	for i := range rs.Column {
+		rs.Column[i].Name = a.names[i]
+		rs.Column[i].RowIdx = make([]byte, nor)
+		rs.Column[i].Value = make([]uint64, nor)
+	}
+
+	for j, g := range rows {
+		rs.RowId[j] = g.rid
+		for i := range rs.Column {
+			c := &rs.Column[i]
+			switch {
+			case a.aggregate[i]:
+				if g.agg[i] != nil {
+					c.RowIdx[j], c.Value[j] = 1, uint64(len(rs.Aggregate))
+					rs.Aggregate = append(rs.Aggregate, g.agg[i])
+				}
+			case !g.set[i]:
+			case a.types[i] == ssd.TEXT:
+				c.RowIdx[j], c.Value[j] = 1, uint64(len(rs.Text))
+				rs.Text = append(rs.Text, g.data[i])
+			case a.types[i] == ssd.JSON:
+				c.RowIdx[j], c.Value[j] = 1, uint64(len(rs.Json))
+				rs.Json = append(rs.Json, g.data[i])
+			default:
+				c.RowIdx[j], c.Value[j] = 1, g.value[i]
+			}
+		}
+	}
+
+	return rs
+}
+
+// columnNames returns names of columns in results, which are names of variables, except that
+// aggregate of a variable named by other column too is named as it is in FIND, e.g. first(v, ts)
+// and pctl(v, 0.9), functions are nil for columns other than aggregates
+func columnNames(names []string, functions []*ssql.Function) []string {
+	named := make(map[string]int, len(names))
+	for _, name := range names {
+		named[name]++
+	}
+
+	for i, fn := range functions {
+		if fn != nil && named[names[i]] > 1 {
+			names[i] = functionName(names[i], fn)
+		}
+	}
+
+	return names
+}
+
+// functionName returns aggregate of variable as it is in FIND
+func functionName(name string, fn *ssql.Function) string {
+	var b strings.Builder
+	b.WriteString(strings.ToLower(strings.ReplaceAll(fn.Name.String(), "_", "-")))
+	b.WriteByte('(')
+	b.WriteString(name)
+	switch p := fn.Parameter.(type) {
+	case *ssql.Function_Double:
+		b.WriteString(", ")
+		b.WriteString(strconv.FormatFloat(p.Double, 'f', -1, 64))
+	case *ssql.Function_Int:
+		b.WriteString(", ")
+		b.WriteString(strconv.FormatInt(p.Int, 10))
+	case *ssql.Function_Variable:
+		b.WriteString(", ")
+		b.WriteString(p.Variable)
+	}
+	b.WriteByte(')')
+
+	return b.String()
+}
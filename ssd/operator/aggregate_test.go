@@ -0,0 +1,240 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package operator
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"math"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/chronowave/chronowave/ssql"
+	"github.com/chronowave/chronowave/ssql/parser"
+
+	"github.com/chronowave/chronowave/ssd"
+	"github.com/chronowave/chronowave/ssd/aggregator"
+	"github.com/chronowave/chronowave/ssd/codec"
+)
+
+func TestAggregation(t *testing.T) {
+	input := []string{"test_1.json", "test_2.json", "test_1.json"}
+	tests := []struct {
+		name    string
+		stmt    string
+		limit   int
+		spilled bool
+	}{
+		{"group", `find group-by($a), max($b), avg($c) where [$a /url] [$b /hit] [$c /duration] order-by $a`, 0, false},
+		{"spilled", `find group-by($a), max($b), avg($c) where [$a /url] [$b /hit] [$c /duration] order-by $a`, 1, true},
+		{"count", `find group-by($a), count($b), sum($c) where [$a /url] [$b /hit] [$c /duration] order-by $a`, 2, true},
+		{"count-distinct", `find group-by($a), count-distinct($b), count-distinct($c, 1) where [$a /url] [$b /hit] [$c /duration] order-by $a`, 1, true},
+		{"no group", `find count($b), sum($c), min($c) where [$b /hit] [$c /duration]`, 1, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stmt, e := parser.Parse(tt.stmt)
+			if len(e) > 0 {
+				t.Fatalf("ssql Parse() error = %v", e)
+			}
+
+			// rows of aggregates without group by are merged by row id of block 0
+			rss := make([]*ssd.ResultSet, len(input))
+			for i := range rss {
+				rss[i] = exec(t, 0, input[i], stmt)
+			}
+			want := codec.MarshalResultSet(OrderBy(Merge(rss), stmt), 0)
+
+			dir := t.TempDir()
+			agg := NewAggregation(stmt, tt.limit, dir)
+			defer agg.Close()
+
+			for i := range input {
+				if err := agg.Add(exec(t, uint64(i), input[i], stmt)); err != nil {
+					t.Fatalf("Add() error = %v", err)
+				}
+			}
+
+			if agg.Spilled() != tt.spilled {
+				t.Errorf("Spilled() = %v, want %v", agg.Spilled(), tt.spilled)
+			}
+
+			rs, err := agg.Result(0)
+			if err != nil {
+				t.Fatalf("Result() error = %v", err)
+			}
+
+			if got := codec.MarshalResultSet(OrderBy(rs, stmt), 0); string(got) != string(want) {
+				t.Errorf("Result() = %v, want %v", string(got), string(want))
+			}
+
+			agg.Close()
+			if files, _ := ioutil.ReadDir(dir); len(files) > 0 {
+				t.Errorf("Close() left %v spill files", len(files))
+			}
+		})
+	}
+}
+
+func TestCountDistinct(t *testing.T) {
+	tests := []struct {
+		name   string
+		limit  int64
+		values int
+		blocks int
+		// relative error of estimate
+		error float64
+	}{
+		{"exact", 0, 1000, 3, 0},
+		{"exact across blocks", 2000, 1500, 4, 0},
+		{"hyperloglog", 100, 100000, 3, 0.03},
+		{"hyperloglog of small", 10, 500, 2, 0.03},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fn := &ssql.Function{Name: ssql.Function_COUNT_DISTINCT, Parameter: &ssql.Function_Int{Int: tt.limit}}
+			merged := aggregator.New(fn)
+			for b := 0; b < tt.blocks; b++ {
+				// every block has the same values, half of them are text
+				a := aggregator.New(fn)
+				for v := 0; v < tt.values; v++ {
+					if v%2 == 0 {
+						a.(aggregator.BytesStepper).StepBytes([]byte(strconv.Itoa(v)))
+					} else {
+						a.StepFloat64(float64(v))
+					}
+				}
+				merged.MergeBytes(a.Bytes())
+			}
+
+			got := aggregator.FromBytes(merged.Bytes()).DoneFloat(0)
+			if math.Abs(got-float64(tt.values)) > tt.error*float64(tt.values) {
+				t.Errorf("DoneFloat() = %v, want %v", got, tt.values)
+			}
+		})
+	}
+}
+
+func TestAggregators(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   *ssql.Function
+		// value stepped of v
+		value func(v int) float64
+		want  float64
+		json  string
+	}{
+		{"stddev", &ssql.Function{Name: ssql.Function_STDDEV}, func(v int) float64 { return float64(v) }, 29.0115, ""},
+		{"variance", &ssql.Function{Name: ssql.Function_VARIANCE}, func(v int) float64 { return float64(v) }, 841.6667, ""},
+		{"first", &ssql.Function{Name: ssql.Function_FIRST, Parameter: &ssql.Function_Variable{Variable: "ts"}},
+			func(v int) float64 { return float64(v) / 2 }, 49.5, "49.5"},
+		{"last", &ssql.Function{Name: ssql.Function_LAST, Parameter: &ssql.Function_Variable{Variable: "ts"}},
+			func(v int) float64 { return float64(v) / 2 }, 0, "0"},
+		{"topk", &ssql.Function{Name: ssql.Function_TOPK, Parameter: &ssql.Function_Int{Int: 2}},
+			func(v int) float64 { return float64(v % 7) }, 15, `[{"value":0,"count":15},{"value":1,"count":15}]`},
+		{"histogram", &ssql.Function{Name: ssql.Function_HISTOGRAM, Parameter: &ssql.Function_Int{Int: 2}},
+			func(v int) float64 { return float64(v % 10) }, 100,
+			`[{"lower":0,"upper":4.5,"count":50},{"lower":4.5,"upper":9,"count":50}]`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// values of 4 blocks are interleaved, timestamp is descending
+			merged := aggregator.New(tt.fn)
+			for b := 0; b < 4; b++ {
+				a := aggregator.New(tt.fn)
+				for v := b; v < 100; v += 4 {
+					if ts, ok := a.(aggregator.TimeStepper); ok {
+						ts.At(int64(1000 - v))
+					}
+					a.StepFloat64(tt.value(v))
+				}
+				merged.MergeBytes(a.Bytes())
+			}
+
+			merged = aggregator.FromBytes(merged.Bytes())
+			if got := math.Round(merged.DoneFloat(.5)*10000) / 10000; got != tt.want {
+				t.Errorf("DoneFloat() = %v, want %v", got, tt.want)
+			}
+			if len(tt.json) > 0 {
+				if got, _ := merged.(json.Marshaler).MarshalJSON(); string(got) != tt.json {
+					t.Errorf("MarshalJSON() = %s, want %s", got, tt.json)
+				}
+			}
+		})
+	}
+}
+
+// spilled is Aggregator of bytes in spill file
+type spilled struct {
+	aggregator.Aggregator
+	data []byte
+}
+
+func (s spilled) Bytes() []byte {
+	return s.data
+}
+
+func TestReadGroup(t *testing.T) {
+	stmt, e := parser.Parse(`find avg($c), count($c), sum($c), min($c), max($c), pctl($c, 0.5), count-distinct($c), ` +
+		`stddev($c), variance($c), first($c, $t), last($c, $t), topk($c, 2), histogram($c, 2) where [$c /duration] [$t /ts]`)
+	if len(e) > 0 {
+		t.Fatalf("ssql Parse() error = %v", e)
+	}
+
+	a := NewAggregation(stmt, 0, t.TempDir())
+	defer a.Close()
+
+	for i, f := range stmt.Find {
+		t.Run(a.names[i], func(t *testing.T) {
+			agg := aggregator.New(f.Func)
+			for v := 0; v < 10; v++ {
+				if ts, ok := agg.(aggregator.TimeStepper); ok {
+					ts.At(int64(v))
+				}
+				agg.StepFloat64(float64(v) / 2)
+			}
+			data := agg.Bytes()
+
+			// aggregate of column i is truncated to n bytes, the other columns are absent
+			for n := 0; n <= len(data); n++ {
+				a.group(1).agg[i] = spilled{data: data[:n]}
+				buf := a.encodeGroup(nil, a.rows[0])
+				a.reset()
+
+				g, err := a.readGroup(bytes.NewReader(buf))
+				if n < len(data) {
+					if err != errSpill {
+						t.Fatalf("readGroup() of %v bytes error = %v, want %v", n, err, errSpill)
+					}
+					continue
+				}
+
+				if err != nil {
+					t.Fatalf("readGroup() error = %v", err)
+				}
+				if want := aggregator.FromBytes(data); !reflect.DeepEqual(g.agg[i], want) {
+					t.Errorf("readGroup() = %v, want %v", g.agg[i], want)
+				}
+			}
+		})
+	}
+}
@@ -24,11 +24,10 @@ import (
 	"math"
 	"unsafe"
 
-	"github.com/chronowave/chronowave/ssql"
-
 	"github.com/chronowave/chronowave/ssd"
 	"github.com/chronowave/chronowave/ssd/aggregator"
 	"github.com/chronowave/chronowave/ssd/internal"
+	"github.com/chronowave/chronowave/ssql"
 
 	"github.com/cespare/xxhash/v2"
 )
@@ -53,8 +52,17 @@ func Consolidate(iid uint64, rows []uint16, columns []internal.Column) *ssd.Resu
 		ColumnType: make([]byte, noc),
 	}
 
+	names, functions := make([]string, noc), make([]*ssql.Function, noc)
+	for i := range columns {
+		names[i] = columns[i].Name
+		if !columns[i].Group {
+			functions[i] = columns[i].Func
+		}
+	}
+	names = columnNames(names, functions)
+
 	for i := range columns {
-		rs.Column[i].Name = columns[i].Name
+		rs.Column[i].Name = names[i]
 		if columns[i].Group {
 			if digest == nil {
 				digest = xxhash.New()
@@ -146,6 +154,11 @@ func Consolidate(iid uint64, rows []uint16, columns []internal.Column) *ssd.Resu
 		ridx = 0
 		cidx = make([]int, len(columns))
 		buf  = [8]byte{}
+
+		// timestamp columns of aggregates and their cursors
+		timed  = timestampColumns(columns, aggregates)
+		tidx   = make([]int, len(columns))
+		quoted []byte
 	)
 
 	rs.Aggregate = make([]aggregator.Aggregator, nor*len(aggregates))
@@ -153,20 +166,7 @@ func Consolidate(iid uint64, rows []uint16, columns []internal.Column) *ssd.Resu
 		// aggregates without group by
 		for _, f := range aggregates {
 			// init functions
-			switch columns[f].Func.Name {
-			case ssql.Function_AVG:
-				rs.Aggregate[aidx] = aggregator.NewAverage()
-			case ssql.Function_SUM:
-				rs.Aggregate[aidx] = aggregator.NewSum()
-			case ssql.Function_MIN:
-				rs.Aggregate[aidx] = aggregator.NewMin()
-			case ssql.Function_MAX:
-				rs.Aggregate[aidx] = aggregator.NewMax()
-			case ssql.Function_COUNT:
-				rs.Aggregate[aidx] = aggregator.NewCount()
-			case ssql.Function_PCTL:
-				rs.Aggregate[aidx] = aggregator.NewPercentile()
-			}
+			rs.Aggregate[aidx] = aggregator.New(columns[f].Func)
 			rs.Column[f].RowIdx[0] = 0
 			rs.Column[f].Value[0] = uint64(aidx)
 			aidx++
@@ -184,7 +184,7 @@ func Consolidate(iid uint64, rows []uint16, columns []internal.Column) *ssd.Resu
 				switch columns[g].Type {
 				case ssd.TEXT:
 					j, columnar := cidx[g], columns[g].ColumnText
-					if columnar.Rows[j] == uint32(i) {
+					if j < len(columnar.Rows) && columnar.Rows[j] == uint32(i) {
 						v := textIdx[columnar.Cols[j]]
 						digest.Write(rs.Text[v])
 						cidx[g]++
@@ -196,7 +196,7 @@ func Consolidate(iid uint64, rows []uint16, columns []internal.Column) *ssd.Resu
 					}
 				case ssd.FLT64:
 					j, columnar := cidx[g], columns[g].ColumnFloat64
-					if columnar.Rows[j] == uint32(i) {
+					if j < len(columnar.Rows) && columnar.Rows[j] == uint32(i) {
 						v := math.Float64bits(columnar.Columnar[columnar.Cols[j]])
 						binary.LittleEndian.PutUint64(buf[:8], v)
 						digest.Write(buf[:8])
@@ -209,7 +209,7 @@ func Consolidate(iid uint64, rows []uint16, columns []internal.Column) *ssd.Resu
 					}
 				case ssd.INT64:
 					j, columnar := cidx[g], columns[g].ColumnInt64
-					if columnar.Rows[j] == uint32(i) {
+					if j < len(columnar.Rows) && columnar.Rows[j] == uint32(i) {
 						v := columnar.Columnar[columnar.Cols[j]]
 						binary.LittleEndian.PutUint64(buf[:8], uint64(v))
 						digest.Write(buf[:8])
@@ -222,7 +222,7 @@ func Consolidate(iid uint64, rows []uint16, columns []internal.Column) *ssd.Resu
 					}
 				case ssd.BOOL:
 					j, columnar := cidx[g], columns[g].ColumnBool
-					if columnar.Rows[j] == uint32(i) {
+					if j < len(columnar.Rows) && columnar.Rows[j] == uint32(i) {
 						v := columnar.Columnar[columnar.Cols[j]]
 						buf[0] = *(*byte)(unsafe.Pointer(&v)) & 1
 						digest.Write(buf[:1])
@@ -235,7 +235,7 @@ func Consolidate(iid uint64, rows []uint16, columns []internal.Column) *ssd.Resu
 					}
 				case ssd.JSON:
 					j, columnar := cidx[g], columns[g].ColumnJson
-					if columnar.Rows[j] == uint32(i) {
+					if j < len(columnar.Rows) && columnar.Rows[j] == uint32(i) {
 						digest.Write(columnar.Cols[j])
 						cidx[g]++
 
@@ -264,20 +264,7 @@ func Consolidate(iid uint64, rows []uint16, columns []internal.Column) *ssd.Resu
 
 				// init functions
 				for _, f := range aggregates {
-					switch columns[f].Func.Name {
-					case ssql.Function_AVG:
-						rs.Aggregate[aidx] = aggregator.NewAverage()
-					case ssql.Function_SUM:
-						rs.Aggregate[aidx] = aggregator.NewSum()
-					case ssql.Function_MIN:
-						rs.Aggregate[aidx] = aggregator.NewMin()
-					case ssql.Function_MAX:
-						rs.Aggregate[aidx] = aggregator.NewMax()
-					case ssql.Function_COUNT:
-						rs.Aggregate[aidx] = aggregator.NewCount()
-					case ssql.Function_PCTL:
-						rs.Aggregate[aidx] = aggregator.NewPercentile()
-					}
+					rs.Aggregate[aidx] = aggregator.New(columns[f].Func)
 					rs.Column[f].RowIdx[ridx] = 0
 					rs.Column[f].Value[ridx] = uint64(aidx)
 					aidx++
@@ -289,18 +276,32 @@ func Consolidate(iid uint64, rows []uint16, columns []internal.Column) *ssd.Resu
 
 		for _, f := range aggregates {
 			column := &columns[f]
+			if t := timed[f]; t >= 0 {
+				if ts, ok := timestampAt(&columns[t], &tidx[t], uint32(i)); ok {
+					if a, ok := rs.Aggregate[rs.Column[f].Value[ridx]].(aggregator.TimeStepper); ok {
+						a.At(ts)
+					}
+				}
+			}
+
 			switch column.Type {
 			case ssd.TEXT:
 				j, columnar := cidx[f], column.ColumnText
-				if columnar.Rows[j] == uint32(i) {
-					v := uint32(textIdx[columnar.Cols[j]])
-					rs.Aggregate[rs.Column[f].Value[ridx]].StepInt64(int64(v))
+				if j < len(columnar.Rows) && columnar.Rows[j] == uint32(i) {
+					v := textIdx[columnar.Cols[j]]
+					if a, ok := rs.Aggregate[rs.Column[f].Value[ridx]].(aggregator.BytesStepper); ok {
+						// text in JSON
+						quoted = append(append(append(quoted[:0], '"'), rs.Text[v]...), '"')
+						a.StepBytes(quoted)
+					} else {
+						rs.Aggregate[rs.Column[f].Value[ridx]].StepInt64(int64(v))
+					}
 					rs.Column[f].RowIdx[ridx] = 1
 					cidx[f]++
 				}
 			case ssd.FLT64:
 				j, columnar := cidx[f], column.ColumnFloat64
-				if columnar.Rows[j] == uint32(i) {
+				if j < len(columnar.Rows) && columnar.Rows[j] == uint32(i) {
 					v := columnar.Columnar[columnar.Cols[j]]
 					rs.Aggregate[rs.Column[f].Value[ridx]].StepFloat64(v)
 					rs.Column[f].RowIdx[ridx] = 1
@@ -308,7 +309,7 @@ func Consolidate(iid uint64, rows []uint16, columns []internal.Column) *ssd.Resu
 				}
 			case ssd.INT64:
 				j, columnar := cidx[f], column.ColumnInt64
-				if columnar.Rows[j] == uint32(i) {
+				if j < len(columnar.Rows) && columnar.Rows[j] == uint32(i) {
 					v := columnar.Columnar[columnar.Cols[j]]
 					rs.Aggregate[rs.Column[f].Value[ridx]].StepInt64(v)
 					rs.Column[f].RowIdx[ridx] = 1
@@ -316,22 +317,22 @@ func Consolidate(iid uint64, rows []uint16, columns []internal.Column) *ssd.Resu
 				}
 			case ssd.BOOL:
 				j, columnar := cidx[f], column.ColumnBool
-				if columnar.Rows[j] == uint32(i) {
-					rs.Aggregate[rs.Column[f].Value[ridx]].StepInt64(0)
+				if j < len(columnar.Rows) && columnar.Rows[j] == uint32(i) {
+					stepBytes(rs.Aggregate[rs.Column[f].Value[ridx]], boolText[columnar.Columnar[columnar.Cols[j]]], 0)
 					rs.Column[f].RowIdx[ridx] = 1
 					cidx[f]++
 				}
 			case ssd.NULL:
 				j, columnar := cidx[f], column.ColumnNull
-				if columnar.Rows[j] == uint32(i) {
-					rs.Aggregate[rs.Column[f].Value[ridx]].StepInt64(0)
+				if j < len(columnar.Rows) && columnar.Rows[j] == uint32(i) {
+					stepBytes(rs.Aggregate[rs.Column[f].Value[ridx]], nil, 0)
 					rs.Column[f].RowIdx[ridx] = 1
 					cidx[f]++
 				}
 			case ssd.JSON:
 				j, columnar := cidx[f], column.ColumnJson
-				if columnar.Rows[j] == uint32(i) {
-					rs.Aggregate[rs.Column[f].Value[ridx]].StepInt64(0)
+				if j < len(columnar.Rows) && columnar.Rows[j] == uint32(i) {
+					stepBytes(rs.Aggregate[rs.Column[f].Value[ridx]], columnar.Cols[j], 0)
 					rs.Column[f].RowIdx[ridx] = 1
 					cidx[f]++
 				}
@@ -347,3 +348,62 @@ func Consolidate(iid uint64, rows []uint16, columns []internal.Column) *ssd.Resu
 	rs.RowId = rs.RowId[:cnt]
 	return rs
 }
+
+var boolText = map[bool][]byte{false: []byte("false"), true: []byte("true")}
+
+// stepBytes steps aggregator by value in JSON if it is aggregator.BytesStepper, by v otherwise. nil
+// value is null, which isn't stepped by aggregator.BytesStepper
+func stepBytes(a aggregator.Aggregator, value []byte, v int64) {
+	if s, ok := a.(aggregator.BytesStepper); !ok {
+		a.StepInt64(v)
+	} else if value != nil {
+		s.StepBytes(value)
+	}
+}
+
+// timestampColumns returns index of column of timestamp variable of each aggregate, e.g. FIRST and
+// LAST, -1 if there is none
+func timestampColumns(columns []internal.Column, aggregates []int) []int {
+	timed := make([]int, len(columns))
+	for i := range timed {
+		timed[i] = -1
+	}
+
+	for _, f := range aggregates {
+		if name := columns[f].Func.GetVariable(); len(name) > 0 {
+			for t := range columns {
+				if columns[t].Name == name && columns[t].Ok {
+					timed[f] = t
+					break
+				}
+			}
+		}
+	}
+
+	return timed
+}
+
+// timestampAt returns integer or real number of column at row, cursor is index of column rows
+// advanced in ascending order of rows
+func timestampAt(column *internal.Column, cursor *int, row uint32) (int64, bool) {
+	switch column.Type {
+	case ssd.INT64:
+		columnar := column.ColumnInt64
+		for *cursor < len(columnar.Rows) && columnar.Rows[*cursor] < row {
+			*cursor++
+		}
+		if *cursor < len(columnar.Rows) && columnar.Rows[*cursor] == row {
+			return columnar.Columnar[columnar.Cols[*cursor]], true
+		}
+	case ssd.FLT64:
+		columnar := column.ColumnFloat64
+		for *cursor < len(columnar.Rows) && columnar.Rows[*cursor] < row {
+			*cursor++
+		}
+		if *cursor < len(columnar.Rows) && columnar.Rows[*cursor] == row {
+			return int64(columnar.Columnar[columnar.Cols[*cursor]]), true
+		}
+	}
+
+	return 0, false
+}
@@ -88,6 +88,16 @@ func Contain(index *ssd.IndexedBlock, key, pattern []byte) ([]uint16, []uint16)
 	return entity, attribute
 }
 
+// SanitizePattern returns text pattern to search with wild card in SENTINEL, and whether it is
+// anchored at prefix and suffix, pattern itself is not modified
+func SanitizePattern(pattern []byte) ([]byte, bool, bool) {
+	if len(pattern) == 0 {
+		return nil, false, false
+	}
+
+	return sanitizePattern(append([]byte(nil), pattern...))
+}
+
 // returns pattern: text pattern to search, prefix: true if search prefix, suffix: true if search suffix
 func sanitizePattern(pattern []byte) ([]byte, bool, bool) {
 	prefix, suffix := pattern[0] == '^', pattern[len(pattern)-1] == '$'
@@ -0,0 +1,155 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package operator
+
+import (
+	"bytes"
+	"runtime/debug"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/chronowave/chronowave/ssd"
+)
+
+const (
+	// wildCardFactor weighs depth first search of wild card in the middle of pattern
+	wildCardFactor = 8
+)
+
+// Cardinality returns number of values of type c at path key in block, read from bound of key in
+// entity FM-index, it is an upper bound of documents matched by a predicate on the path
+func Cardinality(index *ssd.IndexedBlock, key []byte, c byte) (n int) {
+	defer func() {
+		if err := recover(); err != nil {
+			log.Error().Msgf("Cardinality panic: '%v', trace %v, block %v, path %v",
+				err, string(debug.Stack()), index.ID, string(key))
+			n = 0
+		}
+	}()
+
+	// GetBound (s, e]
+	_, sohEND, ok := index.Entity.GetBound(ssd.SOH)
+	if !ok {
+		sohEND = 0
+	}
+
+	// +1 count sentinel
+	bound := findEndOfKeyBound(index.Entity, sohEND+1, key, c)
+	if bound == nil {
+		return 0
+	}
+
+	return int(bound[end] - bound[beg])
+}
+
+// ContainCost estimates work of full text search of pattern in block: every text of block is
+// marked when collecting matches, and occurrences of the first character of pattern are extended,
+// by depth first search if pattern has wild card in the middle
+func ContainCost(index *ssd.IndexedBlock, pattern []byte) (cost int) {
+	defer func() {
+		if err := recover(); err != nil {
+			log.Error().Msgf("ContainCost panic: '%v', trace %v, block %v, pattern %v",
+				err, string(debug.Stack()), index.ID, string(pattern))
+			cost = 0
+		}
+	}()
+
+	if len(pattern) == 0 {
+		return 0
+	}
+
+	sanitized, prefix, _ := SanitizePattern(pattern)
+	if len(sanitized) == 0 {
+		return 0
+	}
+
+	_, sohEND, ok := index.Content.GetBound(ssd.SOH)
+	if !ok {
+		sohEND = 0
+	}
+
+	var bound []uint
+	if prefix {
+		bound = findEndRange(index.Content, sanitized[0], sohEND+1)
+	} else if s, e, ok := index.Content.GetBound(sanitized[0]); ok {
+		bound = []uint{s, e}
+	}
+
+	cost = int(sohEND) + 1
+	if bound != nil {
+		occurrences := int(bound[end] - bound[beg])
+		if bytes.IndexByte(sanitized, ssd.SENTINEL) >= 0 {
+			occurrences *= wildCardFactor
+		}
+		cost += occurrences
+	}
+
+	return cost
+}
+
+// Int64Values returns INT64 values at path key in block, nil if there is none
+func Int64Values(index *ssd.IndexedBlock, key []byte) []int64 {
+	s, e, ok := valueBound(index, key, ssd.INT64)
+	if !ok {
+		return nil
+	}
+
+	return index.Columnar.Int64[s:e]
+}
+
+// Float64Values returns FLT64 values at path key in block, nil if there is none
+func Float64Values(index *ssd.IndexedBlock, key []byte) []float64 {
+	s, e, ok := valueBound(index, key, ssd.FLT64)
+	if !ok {
+		return nil
+	}
+
+	return index.Columnar.Float64[s:e]
+}
+
+// valueBound returns [s, e) of values of type c at path key in columnar of type c
+func valueBound(index *ssd.IndexedBlock, key []byte, c byte) (s, e uint, ok bool) {
+	defer func() {
+		if err := recover(); err != nil {
+			log.Error().Msgf("value bound panic: '%v', trace %v, block %v, path %v",
+				err, string(debug.Stack()), index.ID, string(key))
+			ok = false
+		}
+	}()
+
+	start, _, ok := index.Entity.GetBound(c)
+	if !ok {
+		return 0, 0, false
+	}
+
+	// GetBound (s, e]
+	_, sohEND, ok := index.Entity.GetBound(ssd.SOH)
+	if !ok {
+		sohEND = 0
+	}
+
+	// +1 count sentinel
+	bound := findEndOfKeyBound(index.Entity, sohEND+1, key, c)
+	if bound == nil || bound[end] == bound[beg] {
+		return 0, 0, false
+	}
+
+	return bound[beg] - start, bound[end] - start, true
+}
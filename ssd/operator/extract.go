@@ -102,8 +102,20 @@ func extractJson(index *ssd.IndexedBlock, parent []byte, bound []uint, padding u
 			case ssd.INT64:
 				eav[i].Offset = index.HLT.Int64.Attribute[r-1]
 				eav[i].Value = uint64(index.Columnar.Int64[r-1])
+			case ssd.BOOL:
+				eav[i].Offset = index.HLT.Bool.Attribute[r-1]
+				if index.Columnar.Bool[r-1] {
+					eav[i].Value = 1
+				}
+			case ssd.NULL:
+				eav[i].Offset = index.HLT.Null.Attribute[r-1]
 			}
 
+			i++
+		} else if c == ssd.AED && len(code) == 0 {
+			// next element of array
+			eav[i].Code = code
+			eav[i].ValueType = c
 			i++
 		}
 	}
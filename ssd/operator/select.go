@@ -27,6 +27,7 @@ import (
 
 	"github.com/chronowave/chronowave/ssd"
 	"github.com/chronowave/chronowave/ssd/internal"
+	"github.com/chronowave/chronowave/ssql"
 )
 
 // Select returns entity attribute value in result set
@@ -46,7 +47,7 @@ func Select(index *ssd.IndexedBlock, columns []internal.Column, entity []uint16)
 
 		if len(columns[i].Key) == 0 {
 			columns[i].Type = ssd.JSON
-			columns[i].ColumnJson = selectJson(index, columns[i].Key, columns[i].Func == nil, entity)
+			columns[i].ColumnJson = selectJson(index, columns[i].Key, extractsJson(columns[i].Func), entity)
 			continue
 		}
 
@@ -79,7 +80,7 @@ func Select(index *ssd.IndexedBlock, columns []internal.Column, entity []uint16)
 			columns[i].ColumnNull = selectNull(rank, &index.HLT.Null, entity)
 		default:
 			columns[i].Type = ssd.JSON
-			columns[i].ColumnJson = selectJson(index, columns[i].Key, columns[i].Func == nil, entity)
+			columns[i].ColumnJson = selectJson(index, columns[i].Key, extractsJson(columns[i].Func), entity)
 		}
 	}
 
@@ -218,6 +219,16 @@ func selectFloat64(rank []uint, header *ssd.HeaderISA, columnar []float64, entit
 	}
 }
 
+// extractsJson returns true if JSON value is selected, or stepped by aggregate fn
+func extractsJson(fn *ssql.Function) bool {
+	switch fn.GetName() {
+	case ssql.Function_COUNT_DISTINCT, ssql.Function_FIRST, ssql.Function_LAST, ssql.Function_TOPK:
+		return true
+	}
+
+	return fn == nil
+}
+
 func selectJson(index *ssd.IndexedBlock, key []byte, extract bool, entity []uint16) *internal.ColumnJson {
 	_, end, ok := index.Entity.GetBound(ssd.SOH)
 	if !ok {
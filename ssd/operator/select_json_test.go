@@ -72,6 +72,14 @@ func TestSelectJson(t *testing.T) {
 		},
 			[][]byte{[]byte(`{"a":[{"b":2,"c":2},{"b":3,"c":2}],"d":"a"}`)},
 		},
+		{"nested array", args{
+			json: `[{"l": [{"f": [{"k": 1}]}, {"t": 2}]},
+			        {"l": [{"f": [{"k": 1}], "t": 2}, {"f": [{"k": 3}], "t": 4}]}]`,
+			paths:    []string{"/"},
+			entities: []uint16{0, 1},
+		},
+			[][]byte{[]byte(`{"l":[{"f":[{"k":1}]},{"t":2}]}`), []byte(`{"l":[{"f":[{"k":1}],"t":2},{"f":[{"k":3}],"t":4}]}`)},
+		},
 		{"nested", args{
 			json:     `{"a":[{"a":1,"b":[{"c":2},{"d":"a"}]}, {"a":2,"b":[{"c":3},{"d":"b"}]}]}`,
 			paths:    []string{"a/b"},
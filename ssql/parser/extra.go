@@ -20,46 +20,69 @@
 package parser
 
 import (
+	"fmt"
 	"strconv"
+	"strings"
+	"time"
+
+	"github.com/araddon/dateparse"
 
 	"github.com/chronowave/chronowave/ssql"
 	"github.com/chronowave/chronowave/ssql/parser/gen"
 )
 
+// EpochTime converts time to integer timestamp which TIMEFRAME compares with,
+// it defaults to microseconds and follows the timestamp unit of data directory once configured.
+var EpochTime = func(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Microsecond)
+}
+
+// durationUnits extends time.ParseDuration with day and week
+var durationUnits = map[string]time.Duration{
+	"ns": time.Nanosecond,
+	"us": time.Microsecond,
+	"ms": time.Millisecond,
+	"s":  time.Second,
+	"m":  time.Minute,
+	"h":  time.Hour,
+	"d":  24 * time.Hour,
+	"w":  7 * 24 * time.Hour,
+}
+
 func (p *parser) VisitTimeframe(ctx *gen.TimeframeContext) interface{} {
-	var (
-		first  int64
-		second int64
-		err    error
-	)
-	operands := ctx.AllINTEGER()
-	if len(operands) == 0 {
-		token := ctx.GetStart()
-		p.errors = append(p.errors, Error{
-			Line:    token.GetLine(),
-			Column:  token.GetColumn(),
-			Message: "missing integer values",
-		})
-	} else {
-		first, err = strconv.ParseInt(operands[0].GetText(), 10, 64)
-		if err != nil {
-			token := operands[0].GetSymbol()
+	var first, second int64
+
+	if ctx.LAST() != nil {
+		now := time.Now()
+		second = p.epoch(now)
+		if ctx.DURATION() == nil {
+			token := ctx.GetStart()
 			p.errors = append(p.errors, Error{
 				Line:    token.GetLine(),
 				Column:  token.GetColumn(),
-				Message: err.Error(),
+				Message: "missing duration",
 			})
-		}
-
-		second, err = strconv.ParseInt(operands[1].GetText(), 10, 64)
-		if err != nil {
-			token := operands[1].GetSymbol()
+		} else if d, err := parseDuration(ctx.DURATION().GetText()); err != nil {
+			token := ctx.DURATION().GetSymbol()
 			p.errors = append(p.errors, Error{
 				Line:    token.GetLine(),
 				Column:  token.GetColumn(),
 				Message: err.Error(),
 			})
+		} else {
+			first = p.epoch(now.Add(-d))
 		}
+	} else if operands := ctx.AllMoment(); len(operands) < 2 {
+		token := ctx.GetStart()
+		p.errors = append(p.errors, Error{
+			Line:    token.GetLine(),
+			Column:  token.GetColumn(),
+			Message: "missing time values",
+		})
+	} else {
+		now := time.Now()
+		first = p.moment(operands[0].(*gen.MomentContext), now)
+		second = p.moment(operands[1].(*gen.MomentContext), now)
 
 		if first > second {
 			token := ctx.GetStart()
@@ -89,49 +112,125 @@ func (p *parser) VisitTimeframe(ctx *gen.TimeframeContext) interface{} {
 	}
 }
 
-func (p *parser) VisitKey(ctx *gen.KeyContext) interface{} {
-	if ctx.INTEGER() != nil {
-		v, err := strconv.ParseInt(ctx.INTEGER().GetText(), 10, 64)
+// moment resolves integer timestamp as is, and string of now, now-15m, now+1h, or date time to integer timestamp
+func (p *parser) moment(ctx *gen.MomentContext, now time.Time) int64 {
+	if n := ctx.SignedInteger(); n != nil {
+		return p.signedInteger(n)
+	}
+
+	v, err := parseMoment(stripQuote(ctx.STRING().GetText()), now, p.epoch)
+
+	if err != nil {
+		token := ctx.GetStart()
+		p.errors = append(p.errors, Error{
+			Line:    token.GetLine(),
+			Column:  token.GetColumn(),
+			Message: err.Error(),
+		})
+	}
+
+	return v
+}
+
+func parseMoment(text string, now time.Time, epoch func(time.Time) int64) (int64, error) {
+	s := strings.ToLower(strings.TrimSpace(text))
+	if strings.HasPrefix(s, "now") {
+		rest := strings.TrimSpace(s[3:])
+		if len(rest) == 0 {
+			return epoch(now), nil
+		}
+
+		d, err := parseDuration(strings.TrimSpace(rest[1:]))
 		if err != nil {
-			token := ctx.GetStart()
-			p.errors = append(p.errors, Error{
-				Line:    token.GetLine(),
-				Column:  token.GetColumn(),
-				Message: err.Error(),
-			})
+			return 0, err
 		}
 
-		return &ssql.Tuple{
-			Predicate: &ssql.Tuple_Key{
-				Key: &ssql.Unary{
-					First: &ssql.Operand{
-						Value: &ssql.Operand_Int{Int: v},
-					},
-				},
-			},
+		switch rest[0] {
+		case '-':
+			return epoch(now.Add(-d)), nil
+		case '+':
+			return epoch(now.Add(d)), nil
 		}
+		return 0, fmt.Errorf("invalid relative time '%v', expect now-<duration> or now+<duration>", text)
 	}
 
-	var text string
-	if ctx.STRING() == nil {
+	if t, err := time.Parse(time.RFC3339Nano, text); err == nil {
+		return epoch(t), nil
+	}
+
+	t, err := dateparse.ParseIn(text, time.UTC)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time '%v': %v", text, err)
+	}
+	return epoch(t), nil
+}
+
+// parseDuration parses sequence of number and unit, such as 15m, 1h30m or 7d
+func parseDuration(text string) (time.Duration, error) {
+	s := strings.ToLower(text)
+	var d time.Duration
+	for len(s) > 0 {
+		i := 0
+		for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+			i++
+		}
+		j := i
+		for j < len(s) && s[j] >= 'a' && s[j] <= 'z' {
+			j++
+		}
+
+		unit, ok := durationUnits[s[i:j]]
+		if i == 0 || !ok {
+			return 0, fmt.Errorf("invalid duration '%v', expect number followed by one of ns, us, ms, s, m, h, d, w", text)
+		}
+
+		n, err := strconv.ParseInt(s[:i], 10, 64)
+		if err != nil {
+			return 0, err
+		}
+
+		d += time.Duration(n) * unit
+		s = s[j:]
+	}
+
+	if d == 0 {
+		return 0, fmt.Errorf("invalid duration '%v'", text)
+	}
+	return d, nil
+}
+
+func (p *parser) VisitKey(ctx *gen.KeyContext) interface{} {
+	var list ssql.List
+	for _, n := range ctx.AllSignedInteger() {
+		list.Int = append(list.Int, p.signedInteger(n))
+	}
+
+	for _, n := range ctx.AllSTRING() {
+		list.Text = append(list.Text, stripQuote(n.GetText()))
+	}
+
+	var operand ssql.Operand
+	switch {
+	case len(list.Int)+len(list.Text) > 1:
+		operand.Value = &ssql.Operand_List{List: &list}
+	case len(list.Int) == 1:
+		operand.Value = &ssql.Operand_Int{Int: list.Int[0]}
+	case len(list.Text) == 1:
+		operand.Value = &ssql.Operand_Text{Text: list.Text[0]}
+	default:
 		token := ctx.GetStart()
 		p.errors = append(p.errors, Error{
 			Line:    token.GetLine(),
 			Column:  token.GetColumn(),
 			Message: "missing value",
 		})
-	} else {
-		text = stripQuote(ctx.STRING().GetText())
+		operand.Value = &ssql.Operand_Text{}
 	}
 
 	return &ssql.Tuple{
 		Predicate: &ssql.Tuple_Key{
 			Key: &ssql.Unary{
-				First: &ssql.Operand{
-					Value: &ssql.Operand_Text{
-						Text: text,
-					},
-				},
+				First: &operand,
 			},
 		},
 	}
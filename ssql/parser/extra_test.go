@@ -22,6 +22,7 @@ package parser
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/chronowave/chronowave/ssql"
 )
@@ -49,6 +50,19 @@ func TestTimeframe(t *testing.T) {
 								Second: &ssql.Operand{Value: &ssql.Operand_Int{Int: 3}}}}}},
 				}}}, false,
 		},
+		{"timeframe_date", args{"find $b where [$b /adf/adf timeframe('2020-10-02T04:00:00Z', \"2020-10-02 05:00:00\")]"},
+			&ssql.Statement{
+				Find: []*ssql.Attribute{{Name: "b"}},
+				Where: []*ssql.Expr{{
+					Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
+						Name: "b",
+						Path: "/adf/adf",
+						Predicate: &ssql.Tuple_Timeframe{
+							Timeframe: &ssql.Binary{
+								First:  &ssql.Operand{Value: &ssql.Operand_Int{Int: 1601611200000000}},
+								Second: &ssql.Operand{Value: &ssql.Operand_Int{Int: 1601614800000000}}}}}},
+				}}}, false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -68,6 +82,57 @@ func TestTimeframe(t *testing.T) {
 	}
 }
 
+func TestRelativeTimeframe(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  time.Duration
+	}{
+		{"last", "find $b where [$b /adf/adf timeframe(last 15m)]", 15 * time.Minute},
+		{"last_compound", "find $b where [$b /adf/adf timeframe(LAST 1h30m)]", 90 * time.Minute},
+		{"last_day", "find $b where [$b /adf/adf timeframe(last 2d)]", 48 * time.Hour},
+		{"now", "find $b where [$b /adf/adf timeframe('now-1w', 'now')]", 7 * 24 * time.Hour},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, errs := Parse(tt.query)
+			if len(errs) > 0 {
+				t.Fatalf("parse() error = %v", errs)
+			}
+
+			tf := got.Where[0].GetTuple().GetTimeframe()
+			first, second := tf.First.GetInt(), tf.Second.GetInt()
+			if d := time.Duration(second-first) * time.Microsecond; d != tt.want {
+				t.Errorf("parse() timeframe = %v, want %v", d, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseWithEpoch(t *testing.T) {
+	seconds := func(t time.Time) int64 { return t.Unix() }
+	got, errs := ParseWithEpoch("find $b where [$b /adf/adf timeframe('2020-10-02T04:00:00Z', 'now')]", seconds)
+	if len(errs) > 0 {
+		t.Fatalf("ParseWithEpoch() error = %v", errs)
+	}
+
+	if first := got.Where[0].GetTuple().GetTimeframe().First.GetInt(); first != 1601611200 {
+		t.Errorf("ParseWithEpoch() timeframe = %v, want %v", first, 1601611200)
+	}
+}
+
+func TestInvalidTimeframe(t *testing.T) {
+	for _, q := range []string{
+		"find $b where [$b /adf/adf timeframe('now', 'now-1h')]",
+		"find $b where [$b /adf/adf timeframe('yesterday-ish', 'now')]",
+		"find $b where [$b /adf/adf timeframe('now*1h', 'now')]",
+	} {
+		if _, errs := Parse(q); len(errs) == 0 {
+			t.Errorf("parse(%v) doesn't produce expected errors", q)
+		}
+	}
+}
+
 func TestKey(t *testing.T) {
 	type args struct {
 		query string
@@ -106,6 +171,24 @@ func TestKey(t *testing.T) {
 				}},
 			}, false,
 		},
+		{"key_list", args{"find $b where [$b /adf/adf key('abc', \"def\", 10)]"},
+			&ssql.Statement{
+				Find: []*ssql.Attribute{{Name: "b"}},
+				Where: []*ssql.Expr{{
+					Field: &ssql.Expr_Tuple{
+						Tuple: &ssql.Tuple{
+							Name: "b",
+							Path: "/adf/adf",
+							Predicate: &ssql.Tuple_Key{
+								Key: &ssql.Unary{First: &ssql.Operand{Value: &ssql.Operand_List{
+									List: &ssql.List{Text: []string{"abc", "def"}, Int: []int64{10}},
+								}}},
+							},
+						},
+					},
+				}},
+			}, false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -0,0 +1,89 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package parser
+
+import (
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+
+	"github.com/chronowave/chronowave/ssql"
+	"github.com/chronowave/chronowave/ssql/parser/gen"
+)
+
+// VisitFrom collects namespace and labels, a plain name is namespace and key=value is label of
+// index blocks. labels are separated by either , for AND or OR for OR.
+func (p *parser) VisitFrom(ctx *gen.FromContext) interface{} {
+	from := &ssql.From{Or: len(ctx.AllOR()) > 0}
+
+	namespaces := 0
+	for _, label := range ctx.AllLabel() {
+		text := p.VisitLabel(label.(*gen.LabelContext)).(string)
+		if !strings.Contains(text, "=") {
+			namespaces++
+			if namespaces > 1 {
+				p.error(label.GetStart(), "only one namespace is allowed in FROM")
+			} else if from.Or {
+				p.error(label.GetStart(), "namespace can't be combined with OR in FROM")
+			}
+		}
+		from.Labels = append(from.Labels, text)
+	}
+
+	if from.Or && len(ctx.AllOR()) != len(from.Labels)-1 {
+		p.error(ctx.GetStart(), "labels are separated by either , or OR in FROM")
+	}
+
+	p.stmt.From = from
+	return nil
+}
+
+// VisitLabel returns namespace, or label as key=value
+func (p *parser) VisitLabel(ctx *gen.LabelContext) interface{} {
+	var parts []string
+	for _, child := range ctx.GetChildren() {
+		switch node := child.(type) {
+		case *gen.NameContext:
+			// NAME or keyword
+			parts = append(parts, node.GetText())
+		case antlr.TerminalNode:
+			switch node.GetSymbol().GetTokenType() {
+			case gen.SSQLParserSTRING:
+				parts = append(parts, stripQuote(node.GetText()))
+			case gen.SSQLParserINTEGER:
+				parts = append(parts, node.GetText())
+			}
+		}
+	}
+
+	if len(parts) > 0 && strings.Contains(parts[0], "=") {
+		p.error(ctx.GetStart(), "= is not allowed in namespace or label key")
+	}
+
+	return strings.Join(parts, "=")
+}
+
+func (p *parser) error(token antlr.Token, msg string) {
+	p.errors = append(p.errors, Error{
+		Line:    token.GetLine(),
+		Column:  token.GetColumn(),
+		Message: msg,
+	})
+}
@@ -0,0 +1,98 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package parser
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"unicode"
+
+	"github.com/chronowave/chronowave/ssql"
+	"github.com/chronowave/chronowave/ssql/parser/gen"
+)
+
+func TestFrom(t *testing.T) {
+	type args struct {
+		query string
+	}
+	tests := []struct {
+		name    string
+		args    args
+		want    *ssql.From
+		wantErr bool
+	}{
+		{"none", args{"find $b where [$b /]"}, nil, false},
+		{"name", args{"find $b from tenantA where [$b /]"}, &ssql.From{Labels: []string{"tenantA"}}, false},
+		{"string", args{"find $b from 'tenant key' where [$b /]"}, &ssql.From{Labels: []string{"tenant key"}}, false},
+		{"many", args{"find $b from tenantA, tenantB where [$b /]"}, &ssql.From{Labels: []string{"tenantA", "tenantB"}}, true},
+		{"labels", args{"find $b from tenantA, env=prod, 'region'=\"us-east\", v=2 where [$b /]"},
+			&ssql.From{Labels: []string{"tenantA", "env=prod", "region=us-east", "v=2"}}, false},
+		{"or", args{"find $b from env=prod or env=dev where [$b /]"},
+			&ssql.From{Or: true, Labels: []string{"env=prod", "env=dev"}}, false},
+		{"mixed", args{"find $b from env=prod or env=dev, v=2 where [$b /]"},
+			&ssql.From{Or: true, Labels: []string{"env=prod", "env=dev", "v=2"}}, true},
+		{"or_namespace", args{"find $b from tenantA or env=dev where [$b /]"},
+			&ssql.From{Or: true, Labels: []string{"tenantA", "env=dev"}}, true},
+		{"keyword value", args{"find $b from env=last, tier=key, q=Count-Distinct where [$b /]"},
+			&ssql.From{Labels: []string{"env=last", "tier=key", "q=Count-Distinct"}}, false},
+		{"keyword name", args{"find $b from first where [$b /]"}, &ssql.From{Labels: []string{"first"}}, false},
+		{"keyword key", args{"find $b from histogram=1, topk=stddev, limit=desc where [$b /]"},
+			&ssql.From{Labels: []string{"histogram=1", "topk=stddev", "limit=desc"}}, false},
+		{"keywords", args{"find $b from Explain=find, from=order-by, group-by=asc, exist=timeframe, in=between where [$b /]"},
+			&ssql.From{Labels: []string{"Explain=find", "from=order-by", "group-by=asc", "exist=timeframe", "in=between"}}, false},
+		{"keyword or", args{"find $b from env=last or env=first where [$b /]"},
+			&ssql.From{Or: true, Labels: []string{"env=last", "env=first"}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, errs := Parse(tt.args.query)
+			if tt.wantErr {
+				if len(errs) == 0 {
+					t.Error("parse() doesn't produce expected errors")
+				}
+			} else if len(errs) > 0 {
+				t.Errorf("parse() error = %v", errs)
+			}
+
+			if !reflect.DeepEqual(got.From, tt.want) {
+				t.Errorf("parse() = %v, want %v", got.From, tt.want)
+			}
+		})
+	}
+}
+
+func TestFromKeywordLabel(t *testing.T) {
+	// every keyword but OR and WHERE is label key and value without quotes
+	for _, literal := range gen.NewSSQLLexer(nil).LiteralNames {
+		keyword := strings.Trim(literal, "'")
+		if len(keyword) < 2 || !unicode.IsLetter(rune(keyword[0])) || keyword == "OR" || keyword == "WHERE" {
+			continue
+		}
+
+		label := strings.ToLower(keyword) + "=" + keyword
+		got, errs := Parse("find $b from " + label + " where [$b /]")
+		if len(errs) > 0 {
+			t.Errorf("parse() label %v error = %v", label, errs)
+		} else if want := (&ssql.From{Labels: []string{label}}); !reflect.DeepEqual(got.From, want) {
+			t.Errorf("parse() = %v, want %v", got.From, want)
+		}
+	}
+}
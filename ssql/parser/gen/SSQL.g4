@@ -23,7 +23,7 @@ grammar SSQL;
 // =============
 
 start
-    : FIND selection WHERE expression orderBy? limit? EOF
+    : EXPLAIN? FIND selection from? WHERE expression orderBy? limit? EOF
     ;
 
 selection
@@ -36,13 +36,46 @@ attribute
     ;
 
 aggregate
-    : (AVG | MAX | MIN | SUM | COUNT) '(' IDENTIFIER ')' | percentile
+    : (AVG | MAX | MIN | SUM | COUNT | STDDEV | VARIANCE) '(' IDENTIFIER ')' | percentile | countDistinct
+    | firstLast | topK | histogram
     ;
 
 percentile
     : PERCENTILE '(' IDENTIFIER ',' REAL_NUMBER ')'
     ;
 
+countDistinct
+    : COUNT_DISTINCT '(' IDENTIFIER (',' INTEGER)? ')'
+    ;
+
+firstLast
+    : (FIRST | LAST) '(' IDENTIFIER ',' IDENTIFIER ')'
+    ;
+
+topK
+    : TOPK '(' IDENTIFIER ',' INTEGER ')'
+    ;
+
+histogram
+    : HISTOGRAM '(' IDENTIFIER ',' INTEGER ')'
+    ;
+
+from
+    : FROM label ((',' | OR) label)*
+    ;
+
+label
+    : (name | STRING) ('=' (name | STRING | INTEGER))?
+    ;
+
+// keywords other than OR and WHERE, which end a label, are names too
+name
+    : NAME | AVG | MAX | MIN | SUM | COUNT | COUNT_DISTINCT | STDDEV | VARIANCE | FIRST | TOPK
+    | HISTOGRAM | PERCENTILE | PARTITION | EQ | NEQ | IN | LT | LE | GE | GT | BETWEEN | CONTAIN
+    | EXIST | TIMEFRAME | KEY | LAST | EXPLAIN | FIND | FROM | ORDER_BY | GROUP_BY | LIMIT | ASC
+    | DESC
+    ;
+
 groupBy
     : IDENTIFIER | partition
     ;
@@ -105,8 +138,7 @@ in
     ;
 
 between
-    : BETWEEN '(' INTEGER ',' INTEGER ')'
-    | BETWEEN '(' REAL_NUMBER ',' REAL_NUMBER ')'
+    : BETWEEN '(' scalar ',' scalar ')'
     ;
 
 contain
@@ -118,31 +150,36 @@ exist
     ;
 
 timeframe
-    : TIMEFRAME '(' INTEGER ',' INTEGER ')'
+    : TIMEFRAME '(' moment ',' moment ')'
+    | TIMEFRAME '(' LAST DURATION ')'
+    ;
+
+moment
+    : signedInteger | STRING
     ;
 
 key
-    : KEY '(' (INTEGER | STRING) ')'
+    : KEY '(' (signedInteger | STRING) (',' (signedInteger | STRING))* ')'
     ;
 
 scalar
-    : REAL_NUMBER | INTEGER+
+    : '-'? (REAL_NUMBER | INTEGER+)
+    ;
+
+signedInteger
+    : '-'? INTEGER+
     ;
 
 list
-    : stringList | doubleList | intList
+    : stringList | scalarList
     ;
 
 stringList
     : STRING (',' STRING)*
     ;
 
-doubleList
-    : REAL_NUMBER (',' REAL_NUMBER)*
-    ;
-
-intList
-    : INTEGER (',' INTEGER)*
+scalarList
+    : scalar (',' scalar)*
     ;
 
 orderBy
@@ -164,6 +201,12 @@ MAX : 'MAX';
 MIN : 'MIN';
 SUM : 'SUM';
 COUNT : 'COUNT';
+COUNT_DISTINCT : 'COUNT-DISTINCT';
+STDDEV : 'STDDEV';
+VARIANCE : 'VARIANCE';
+FIRST : 'FIRST';
+TOPK : 'TOPK';
+HISTOGRAM : 'HISTOGRAM';
 PERCENTILE : 'PCTL';
 PARTITION : 'PART';
 
@@ -179,8 +222,12 @@ CONTAIN : 'CONTAIN';
 EXIST : 'EXIST';
 TIMEFRAME : 'TIMEFRAME';
 KEY : 'KEY';
+LAST : 'LAST';
 
+EXPLAIN : 'EXPLAIN';
 FIND : 'FIND';
+FROM : 'FROM';
+OR : 'OR';
 WHERE : 'WHERE';
 ORDER_BY : 'ORDER-BY';
 GROUP_BY : 'GROUP-BY';
@@ -188,10 +235,11 @@ LIMIT : 'LIMIT';
 ASC : 'ASC';
 DESC : 'DESC';
 NAME : (LETTER | '_') (LETTER | DIGIT | '_' | '.' | '-')*;
-PATH : '/' | '/' NAME ('/' NAME)*;
+PATH : '/' | '/' PATH_KEY ('/' PATH_KEY)*;
 
 STRING : DQUOTA_STRING | SQUOTA_STRING |  BQUOTA_STRING;
 INTEGER : '0'+ | NON_ZERO_DIGIT DIGIT*;
+DURATION : (DIGIT+ ('NS' | 'US' | 'MS' | 'S' | 'M' | 'H' | 'D' | 'W'))+;
 REAL_NUMBER
     : (DIGIT+)? '.' DIGIT+
     | DIGIT+ '.' EXPONENT
@@ -203,6 +251,7 @@ fragment LETTER : 'A'..'Z' | 'a'..'z';
 fragment NON_ZERO_DIGIT  : '1'..'9';
 fragment DIGIT  : '0'..'9';
 fragment EXPONENT: 'E' [-+]? DIGIT+;
+fragment PATH_KEY : (LETTER | '_') (LETTER | DIGIT | '_' | '.' | '-')* | '"' ('\\'. | ~('"' | '\\'))* '"';
 fragment DQUOTA_STRING : '"' ( '\\'. | '""' | ~('"'| '\\') )* '"';
 fragment SQUOTA_STRING : '\'' ('\\'. | '\'\'' | ~('\'' | '\\'))* '\'';
 fragment BQUOTA_STRING : '`' ( '\\'. | '``' | ~('`'|'\\'))* '`';
@@ -50,6 +50,48 @@ func (s *BaseSSQLListener) EnterPercentile(ctx *PercentileContext) {}
 // ExitPercentile is called when production percentile is exited.
 func (s *BaseSSQLListener) ExitPercentile(ctx *PercentileContext) {}
 
+// EnterCountDistinct is called when production countDistinct is entered.
+func (s *BaseSSQLListener) EnterCountDistinct(ctx *CountDistinctContext) {}
+
+// ExitCountDistinct is called when production countDistinct is exited.
+func (s *BaseSSQLListener) ExitCountDistinct(ctx *CountDistinctContext) {}
+
+// EnterFirstLast is called when production firstLast is entered.
+func (s *BaseSSQLListener) EnterFirstLast(ctx *FirstLastContext) {}
+
+// ExitFirstLast is called when production firstLast is exited.
+func (s *BaseSSQLListener) ExitFirstLast(ctx *FirstLastContext) {}
+
+// EnterTopK is called when production topK is entered.
+func (s *BaseSSQLListener) EnterTopK(ctx *TopKContext) {}
+
+// ExitTopK is called when production topK is exited.
+func (s *BaseSSQLListener) ExitTopK(ctx *TopKContext) {}
+
+// EnterHistogram is called when production histogram is entered.
+func (s *BaseSSQLListener) EnterHistogram(ctx *HistogramContext) {}
+
+// ExitHistogram is called when production histogram is exited.
+func (s *BaseSSQLListener) ExitHistogram(ctx *HistogramContext) {}
+
+// EnterFrom is called when production from is entered.
+func (s *BaseSSQLListener) EnterFrom(ctx *FromContext) {}
+
+// ExitFrom is called when production from is exited.
+func (s *BaseSSQLListener) ExitFrom(ctx *FromContext) {}
+
+// EnterLabel is called when production label is entered.
+func (s *BaseSSQLListener) EnterLabel(ctx *LabelContext) {}
+
+// ExitLabel is called when production label is exited.
+func (s *BaseSSQLListener) ExitLabel(ctx *LabelContext) {}
+
+// EnterName is called when production name is entered.
+func (s *BaseSSQLListener) EnterName(ctx *NameContext) {}
+
+// ExitName is called when production name is exited.
+func (s *BaseSSQLListener) ExitName(ctx *NameContext) {}
+
 // EnterGroupBy is called when production groupBy is entered.
 func (s *BaseSSQLListener) EnterGroupBy(ctx *GroupByContext) {}
 
@@ -164,6 +206,12 @@ func (s *BaseSSQLListener) EnterTimeframe(ctx *TimeframeContext) {}
 // ExitTimeframe is called when production timeframe is exited.
 func (s *BaseSSQLListener) ExitTimeframe(ctx *TimeframeContext) {}
 
+// EnterMoment is called when production moment is entered.
+func (s *BaseSSQLListener) EnterMoment(ctx *MomentContext) {}
+
+// ExitMoment is called when production moment is exited.
+func (s *BaseSSQLListener) ExitMoment(ctx *MomentContext) {}
+
 // EnterKey is called when production key is entered.
 func (s *BaseSSQLListener) EnterKey(ctx *KeyContext) {}
 
@@ -176,6 +224,12 @@ func (s *BaseSSQLListener) EnterScalar(ctx *ScalarContext) {}
 // ExitScalar is called when production scalar is exited.
 func (s *BaseSSQLListener) ExitScalar(ctx *ScalarContext) {}
 
+// EnterSignedInteger is called when production signedInteger is entered.
+func (s *BaseSSQLListener) EnterSignedInteger(ctx *SignedIntegerContext) {}
+
+// ExitSignedInteger is called when production signedInteger is exited.
+func (s *BaseSSQLListener) ExitSignedInteger(ctx *SignedIntegerContext) {}
+
 // EnterList is called when production list is entered.
 func (s *BaseSSQLListener) EnterList(ctx *ListContext) {}
 
@@ -188,17 +242,11 @@ func (s *BaseSSQLListener) EnterStringList(ctx *StringListContext) {}
 // ExitStringList is called when production stringList is exited.
 func (s *BaseSSQLListener) ExitStringList(ctx *StringListContext) {}
 
-// EnterDoubleList is called when production doubleList is entered.
-func (s *BaseSSQLListener) EnterDoubleList(ctx *DoubleListContext) {}
-
-// ExitDoubleList is called when production doubleList is exited.
-func (s *BaseSSQLListener) ExitDoubleList(ctx *DoubleListContext) {}
-
-// EnterIntList is called when production intList is entered.
-func (s *BaseSSQLListener) EnterIntList(ctx *IntListContext) {}
+// EnterScalarList is called when production scalarList is entered.
+func (s *BaseSSQLListener) EnterScalarList(ctx *ScalarListContext) {}
 
-// ExitIntList is called when production intList is exited.
-func (s *BaseSSQLListener) ExitIntList(ctx *IntListContext) {}
+// ExitScalarList is called when production scalarList is exited.
+func (s *BaseSSQLListener) ExitScalarList(ctx *ScalarListContext) {}
 
 // EnterOrderBy is called when production orderBy is entered.
 func (s *BaseSSQLListener) EnterOrderBy(ctx *OrderByContext) {}
@@ -27,6 +27,34 @@ func (v *BaseSSQLVisitor) VisitPercentile(ctx *PercentileContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSSQLVisitor) VisitCountDistinct(ctx *CountDistinctContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSSQLVisitor) VisitFirstLast(ctx *FirstLastContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSSQLVisitor) VisitTopK(ctx *TopKContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSSQLVisitor) VisitHistogram(ctx *HistogramContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSSQLVisitor) VisitFrom(ctx *FromContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSSQLVisitor) VisitLabel(ctx *LabelContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
+func (v *BaseSSQLVisitor) VisitName(ctx *NameContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSSQLVisitor) VisitGroupBy(ctx *GroupByContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -103,6 +131,10 @@ func (v *BaseSSQLVisitor) VisitTimeframe(ctx *TimeframeContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
+func (v *BaseSSQLVisitor) VisitMoment(ctx *MomentContext) interface{} {
+	return v.VisitChildren(ctx)
+}
+
 func (v *BaseSSQLVisitor) VisitKey(ctx *KeyContext) interface{} {
 	return v.VisitChildren(ctx)
 }
@@ -111,19 +143,19 @@ func (v *BaseSSQLVisitor) VisitScalar(ctx *ScalarContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseSSQLVisitor) VisitList(ctx *ListContext) interface{} {
+func (v *BaseSSQLVisitor) VisitSignedInteger(ctx *SignedIntegerContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseSSQLVisitor) VisitStringList(ctx *StringListContext) interface{} {
+func (v *BaseSSQLVisitor) VisitList(ctx *ListContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseSSQLVisitor) VisitDoubleList(ctx *DoubleListContext) interface{} {
+func (v *BaseSSQLVisitor) VisitStringList(ctx *StringListContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
-func (v *BaseSSQLVisitor) VisitIntList(ctx *IntListContext) interface{} {
+func (v *BaseSSQLVisitor) VisitScalarList(ctx *ScalarListContext) interface{} {
 	return v.VisitChildren(ctx)
 }
 
@@ -14,7 +14,7 @@ var _ = fmt.Printf
 var _ = unicode.IsLetter
 
 var serializedLexerAtn = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 43, 399,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 56, 546,
 	8, 1, 4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7,
 	9, 7, 4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12,
 	4, 13, 9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4,
@@ -24,176 +24,242 @@ var serializedLexerAtn = []uint16{
 	4, 34, 9, 34, 4, 35, 9, 35, 4, 36, 9, 36, 4, 37, 9, 37, 4, 38, 9, 38, 4,
 	39, 9, 39, 4, 40, 9, 40, 4, 41, 9, 41, 4, 42, 9, 42, 4, 43, 9, 43, 4, 44,
 	9, 44, 4, 45, 9, 45, 4, 46, 9, 46, 4, 47, 9, 47, 4, 48, 9, 48, 4, 49, 9,
-	49, 3, 2, 3, 2, 3, 3, 3, 3, 3, 4, 3, 4, 3, 5, 3, 5, 3, 6, 3, 6, 3, 7, 3,
-	7, 3, 8, 3, 8, 3, 9, 3, 9, 3, 9, 3, 10, 3, 10, 3, 10, 3, 10, 3, 11, 3,
-	11, 3, 11, 3, 11, 3, 12, 3, 12, 3, 12, 3, 12, 3, 13, 3, 13, 3, 13, 3, 13,
-	3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 15, 3, 15, 3, 15, 3, 15, 3,
-	15, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 17, 3, 17, 3, 17, 3, 18, 3, 18,
-	3, 18, 3, 18, 3, 19, 3, 19, 3, 19, 3, 20, 3, 20, 3, 20, 3, 21, 3, 21, 3,
-	21, 3, 22, 3, 22, 3, 22, 3, 23, 3, 23, 3, 23, 3, 24, 3, 24, 3, 24, 3, 24,
-	3, 24, 3, 24, 3, 24, 3, 24, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3,
-	25, 3, 25, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 27, 3, 27, 3, 27,
-	3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 27, 3, 28, 3, 28, 3, 28, 3,
-	28, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30,
-	3, 30, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3,
-	32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 33, 3, 33,
-	3, 33, 3, 33, 3, 33, 3, 33, 3, 34, 3, 34, 3, 34, 3, 34, 3, 35, 3, 35, 3,
-	35, 3, 35, 3, 35, 3, 36, 3, 36, 5, 36, 253, 10, 36, 3, 36, 3, 36, 3, 36,
-	7, 36, 258, 10, 36, 12, 36, 14, 36, 261, 11, 36, 3, 37, 3, 37, 3, 37, 3,
-	37, 3, 37, 7, 37, 268, 10, 37, 12, 37, 14, 37, 271, 11, 37, 5, 37, 273,
-	10, 37, 3, 38, 3, 38, 3, 38, 5, 38, 278, 10, 38, 3, 39, 6, 39, 281, 10,
-	39, 13, 39, 14, 39, 282, 3, 39, 3, 39, 7, 39, 287, 10, 39, 12, 39, 14,
-	39, 290, 11, 39, 5, 39, 292, 10, 39, 3, 40, 6, 40, 295, 10, 40, 13, 40,
-	14, 40, 296, 5, 40, 299, 10, 40, 3, 40, 3, 40, 6, 40, 303, 10, 40, 13,
-	40, 14, 40, 304, 3, 40, 6, 40, 308, 10, 40, 13, 40, 14, 40, 309, 3, 40,
-	3, 40, 3, 40, 3, 40, 6, 40, 316, 10, 40, 13, 40, 14, 40, 317, 5, 40, 320,
-	10, 40, 3, 40, 3, 40, 6, 40, 324, 10, 40, 13, 40, 14, 40, 325, 3, 40, 3,
-	40, 3, 40, 6, 40, 331, 10, 40, 13, 40, 14, 40, 332, 3, 40, 3, 40, 5, 40,
-	337, 10, 40, 3, 41, 3, 41, 3, 42, 3, 42, 3, 43, 3, 43, 3, 44, 3, 44, 5,
-	44, 347, 10, 44, 3, 44, 6, 44, 350, 10, 44, 13, 44, 14, 44, 351, 3, 45,
-	3, 45, 3, 45, 3, 45, 3, 45, 3, 45, 7, 45, 360, 10, 45, 12, 45, 14, 45,
-	363, 11, 45, 3, 45, 3, 45, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 7,
-	46, 373, 10, 46, 12, 46, 14, 46, 376, 11, 46, 3, 46, 3, 46, 3, 47, 3, 47,
-	3, 47, 3, 47, 3, 47, 3, 47, 7, 47, 386, 10, 47, 12, 47, 14, 47, 389, 11,
-	47, 3, 47, 3, 47, 3, 48, 3, 48, 3, 48, 3, 49, 3, 49, 3, 49, 3, 49, 2, 2,
-	50, 3, 3, 5, 4, 7, 5, 9, 6, 11, 7, 13, 8, 15, 9, 17, 10, 19, 11, 21, 12,
+	49, 4, 50, 9, 50, 4, 51, 9, 51, 4, 52, 9, 52, 4, 53, 9, 53, 4, 54, 9, 54,
+	4, 55, 9, 55, 4, 56, 9, 56, 4, 57, 9, 57, 4, 58, 9, 58, 4, 59, 9, 59, 4,
+	60, 9, 60, 4, 61, 9, 61, 4, 62, 9, 62, 4, 63, 9, 63, 3, 2, 3, 2, 3, 3,
+	3, 3, 3, 4, 3, 4, 3, 5, 3, 5, 3, 6, 3, 6, 3, 7, 3, 7, 3, 8, 3, 8, 3, 9,
+	3, 9, 3, 10, 3, 10, 3, 10, 3, 11, 3, 11, 3, 12, 3, 12, 3, 12, 3, 12, 3,
+	13, 3, 13, 3, 13, 3, 13, 3, 14, 3, 14, 3, 14, 3, 14, 3, 15, 3, 15, 3, 15,
+	3, 15, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 17, 3, 17, 3, 17, 3,
+	17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17,
+	3, 17, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 19, 3, 19, 3,
+	19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 20, 3, 20, 3, 20, 3, 20,
+	3, 20, 3, 20, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 22, 3, 22, 3, 22, 3,
+	22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 23, 3, 23, 3, 23, 3, 23,
+	3, 23, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3, 25, 3, 25, 3, 25, 3, 26, 3,
+	26, 3, 26, 3, 26, 3, 27, 3, 27, 3, 27, 3, 28, 3, 28, 3, 28, 3, 29, 3, 29,
+	3, 29, 3, 30, 3, 30, 3, 30, 3, 31, 3, 31, 3, 31, 3, 32, 3, 32, 3, 32, 3,
+	32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33, 3, 33,
+	3, 33, 3, 33, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34, 3, 35, 3, 35, 3,
+	35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 36, 3, 36, 3, 36,
+	3, 36, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37, 3, 38, 3, 38, 3, 38, 3, 38, 3,
+	38, 3, 38, 3, 38, 3, 38, 3, 39, 3, 39, 3, 39, 3, 39, 3, 39, 3, 40, 3, 40,
+	3, 40, 3, 40, 3, 40, 3, 41, 3, 41, 3, 41, 3, 42, 3, 42, 3, 42, 3, 42, 3,
+	42, 3, 42, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43,
+	3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 44, 3, 45, 3,
+	45, 3, 45, 3, 45, 3, 45, 3, 45, 3, 46, 3, 46, 3, 46, 3, 46, 3, 47, 3, 47,
+	3, 47, 3, 47, 3, 47, 3, 48, 3, 48, 5, 48, 358, 10, 48, 3, 48, 3, 48, 3,
+	48, 7, 48, 363, 10, 48, 12, 48, 14, 48, 366, 11, 48, 3, 49, 3, 49, 3, 49,
+	3, 49, 3, 49, 7, 49, 373, 10, 49, 12, 49, 14, 49, 376, 11, 49, 5, 49, 378,
+	10, 49, 3, 50, 3, 50, 3, 50, 5, 50, 383, 10, 50, 3, 51, 6, 51, 386, 10,
+	51, 13, 51, 14, 51, 387, 3, 51, 3, 51, 7, 51, 392, 10, 51, 12, 51, 14,
+	51, 395, 11, 51, 5, 51, 397, 10, 51, 3, 52, 6, 52, 400, 10, 52, 13, 52,
+	14, 52, 401, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 5, 52, 411,
+	10, 52, 6, 52, 413, 10, 52, 13, 52, 14, 52, 414, 3, 53, 6, 53, 418, 10,
+	53, 13, 53, 14, 53, 419, 5, 53, 422, 10, 53, 3, 53, 3, 53, 6, 53, 426,
+	10, 53, 13, 53, 14, 53, 427, 3, 53, 6, 53, 431, 10, 53, 13, 53, 14, 53,
+	432, 3, 53, 3, 53, 3, 53, 3, 53, 6, 53, 439, 10, 53, 13, 53, 14, 53, 440,
+	5, 53, 443, 10, 53, 3, 53, 3, 53, 6, 53, 447, 10, 53, 13, 53, 14, 53, 448,
+	3, 53, 3, 53, 3, 53, 6, 53, 454, 10, 53, 13, 53, 14, 53, 455, 3, 53, 3,
+	53, 5, 53, 460, 10, 53, 3, 54, 3, 54, 3, 55, 3, 55, 3, 56, 3, 56, 3, 57,
+	3, 57, 5, 57, 470, 10, 57, 3, 57, 6, 57, 473, 10, 57, 13, 57, 14, 57, 474,
+	3, 58, 3, 58, 5, 58, 479, 10, 58, 3, 58, 3, 58, 3, 58, 7, 58, 484, 10,
+	58, 12, 58, 14, 58, 487, 11, 58, 3, 58, 3, 58, 3, 58, 3, 58, 7, 58, 493,
+	10, 58, 12, 58, 14, 58, 496, 11, 58, 3, 58, 5, 58, 499, 10, 58, 3, 59,
+	3, 59, 3, 59, 3, 59, 3, 59, 3, 59, 7, 59, 507, 10, 59, 12, 59, 14, 59,
+	510, 11, 59, 3, 59, 3, 59, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 7,
+	60, 520, 10, 60, 12, 60, 14, 60, 523, 11, 60, 3, 60, 3, 60, 3, 61, 3, 61,
+	3, 61, 3, 61, 3, 61, 3, 61, 7, 61, 533, 10, 61, 12, 61, 14, 61, 536, 11,
+	61, 3, 61, 3, 61, 3, 62, 3, 62, 3, 62, 3, 63, 3, 63, 3, 63, 3, 63, 2, 2,
+	64, 3, 3, 5, 4, 7, 5, 9, 6, 11, 7, 13, 8, 15, 9, 17, 10, 19, 11, 21, 12,
 	23, 13, 25, 14, 27, 15, 29, 16, 31, 17, 33, 18, 35, 19, 37, 20, 39, 21,
 	41, 22, 43, 23, 45, 24, 47, 25, 49, 26, 51, 27, 53, 28, 55, 29, 57, 30,
 	59, 31, 61, 32, 63, 33, 65, 34, 67, 35, 69, 36, 71, 37, 73, 38, 75, 39,
-	77, 40, 79, 41, 81, 2, 83, 2, 85, 2, 87, 2, 89, 2, 91, 2, 93, 2, 95, 42,
-	97, 43, 3, 2, 9, 4, 2, 47, 48, 97, 97, 4, 2, 67, 92, 99, 124, 4, 2, 45,
-	45, 47, 47, 4, 2, 36, 36, 94, 94, 4, 2, 41, 41, 94, 94, 4, 2, 94, 94, 98,
-	98, 5, 2, 11, 12, 15, 15, 34, 34, 2, 424, 2, 3, 3, 2, 2, 2, 2, 5, 3, 2,
-	2, 2, 2, 7, 3, 2, 2, 2, 2, 9, 3, 2, 2, 2, 2, 11, 3, 2, 2, 2, 2, 13, 3,
-	2, 2, 2, 2, 15, 3, 2, 2, 2, 2, 17, 3, 2, 2, 2, 2, 19, 3, 2, 2, 2, 2, 21,
-	3, 2, 2, 2, 2, 23, 3, 2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2, 2,
-	29, 3, 2, 2, 2, 2, 31, 3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2, 35, 3, 2, 2, 2,
-	2, 37, 3, 2, 2, 2, 2, 39, 3, 2, 2, 2, 2, 41, 3, 2, 2, 2, 2, 43, 3, 2, 2,
-	2, 2, 45, 3, 2, 2, 2, 2, 47, 3, 2, 2, 2, 2, 49, 3, 2, 2, 2, 2, 51, 3, 2,
-	2, 2, 2, 53, 3, 2, 2, 2, 2, 55, 3, 2, 2, 2, 2, 57, 3, 2, 2, 2, 2, 59, 3,
-	2, 2, 2, 2, 61, 3, 2, 2, 2, 2, 63, 3, 2, 2, 2, 2, 65, 3, 2, 2, 2, 2, 67,
-	3, 2, 2, 2, 2, 69, 3, 2, 2, 2, 2, 71, 3, 2, 2, 2, 2, 73, 3, 2, 2, 2, 2,
-	75, 3, 2, 2, 2, 2, 77, 3, 2, 2, 2, 2, 79, 3, 2, 2, 2, 2, 95, 3, 2, 2, 2,
-	2, 97, 3, 2, 2, 2, 3, 99, 3, 2, 2, 2, 5, 101, 3, 2, 2, 2, 7, 103, 3, 2,
-	2, 2, 9, 105, 3, 2, 2, 2, 11, 107, 3, 2, 2, 2, 13, 109, 3, 2, 2, 2, 15,
-	111, 3, 2, 2, 2, 17, 113, 3, 2, 2, 2, 19, 116, 3, 2, 2, 2, 21, 120, 3,
-	2, 2, 2, 23, 124, 3, 2, 2, 2, 25, 128, 3, 2, 2, 2, 27, 132, 3, 2, 2, 2,
-	29, 138, 3, 2, 2, 2, 31, 143, 3, 2, 2, 2, 33, 148, 3, 2, 2, 2, 35, 151,
-	3, 2, 2, 2, 37, 155, 3, 2, 2, 2, 39, 158, 3, 2, 2, 2, 41, 161, 3, 2, 2,
-	2, 43, 164, 3, 2, 2, 2, 45, 167, 3, 2, 2, 2, 47, 170, 3, 2, 2, 2, 49, 178,
-	3, 2, 2, 2, 51, 186, 3, 2, 2, 2, 53, 192, 3, 2, 2, 2, 55, 202, 3, 2, 2,
-	2, 57, 206, 3, 2, 2, 2, 59, 211, 3, 2, 2, 2, 61, 217, 3, 2, 2, 2, 63, 226,
-	3, 2, 2, 2, 65, 235, 3, 2, 2, 2, 67, 241, 3, 2, 2, 2, 69, 245, 3, 2, 2,
-	2, 71, 252, 3, 2, 2, 2, 73, 272, 3, 2, 2, 2, 75, 277, 3, 2, 2, 2, 77, 291,
-	3, 2, 2, 2, 79, 336, 3, 2, 2, 2, 81, 338, 3, 2, 2, 2, 83, 340, 3, 2, 2,
-	2, 85, 342, 3, 2, 2, 2, 87, 344, 3, 2, 2, 2, 89, 353, 3, 2, 2, 2, 91, 366,
-	3, 2, 2, 2, 93, 379, 3, 2, 2, 2, 95, 392, 3, 2, 2, 2, 97, 395, 3, 2, 2,
-	2, 99, 100, 7, 46, 2, 2, 100, 4, 3, 2, 2, 2, 101, 102, 7, 42, 2, 2, 102,
-	6, 3, 2, 2, 2, 103, 104, 7, 43, 2, 2, 104, 8, 3, 2, 2, 2, 105, 106, 7,
-	93, 2, 2, 106, 10, 3, 2, 2, 2, 107, 108, 7, 95, 2, 2, 108, 12, 3, 2, 2,
-	2, 109, 110, 7, 125, 2, 2, 110, 14, 3, 2, 2, 2, 111, 112, 7, 127, 2, 2,
-	112, 16, 3, 2, 2, 2, 113, 114, 7, 125, 2, 2, 114, 115, 7, 40, 2, 2, 115,
-	18, 3, 2, 2, 2, 116, 117, 7, 67, 2, 2, 117, 118, 7, 88, 2, 2, 118, 119,
-	7, 73, 2, 2, 119, 20, 3, 2, 2, 2, 120, 121, 7, 79, 2, 2, 121, 122, 7, 67,
-	2, 2, 122, 123, 7, 90, 2, 2, 123, 22, 3, 2, 2, 2, 124, 125, 7, 79, 2, 2,
-	125, 126, 7, 75, 2, 2, 126, 127, 7, 80, 2, 2, 127, 24, 3, 2, 2, 2, 128,
-	129, 7, 85, 2, 2, 129, 130, 7, 87, 2, 2, 130, 131, 7, 79, 2, 2, 131, 26,
-	3, 2, 2, 2, 132, 133, 7, 69, 2, 2, 133, 134, 7, 81, 2, 2, 134, 135, 7,
-	87, 2, 2, 135, 136, 7, 80, 2, 2, 136, 137, 7, 86, 2, 2, 137, 28, 3, 2,
-	2, 2, 138, 139, 7, 82, 2, 2, 139, 140, 7, 69, 2, 2, 140, 141, 7, 86, 2,
-	2, 141, 142, 7, 78, 2, 2, 142, 30, 3, 2, 2, 2, 143, 144, 7, 82, 2, 2, 144,
-	145, 7, 67, 2, 2, 145, 146, 7, 84, 2, 2, 146, 147, 7, 86, 2, 2, 147, 32,
-	3, 2, 2, 2, 148, 149, 7, 71, 2, 2, 149, 150, 7, 83, 2, 2, 150, 34, 3, 2,
-	2, 2, 151, 152, 7, 80, 2, 2, 152, 153, 7, 71, 2, 2, 153, 154, 7, 83, 2,
-	2, 154, 36, 3, 2, 2, 2, 155, 156, 7, 75, 2, 2, 156, 157, 7, 80, 2, 2, 157,
-	38, 3, 2, 2, 2, 158, 159, 7, 78, 2, 2, 159, 160, 7, 86, 2, 2, 160, 40,
-	3, 2, 2, 2, 161, 162, 7, 78, 2, 2, 162, 163, 7, 71, 2, 2, 163, 42, 3, 2,
-	2, 2, 164, 165, 7, 73, 2, 2, 165, 166, 7, 71, 2, 2, 166, 44, 3, 2, 2, 2,
-	167, 168, 7, 73, 2, 2, 168, 169, 7, 86, 2, 2, 169, 46, 3, 2, 2, 2, 170,
-	171, 7, 68, 2, 2, 171, 172, 7, 71, 2, 2, 172, 173, 7, 86, 2, 2, 173, 174,
-	7, 89, 2, 2, 174, 175, 7, 71, 2, 2, 175, 176, 7, 71, 2, 2, 176, 177, 7,
-	80, 2, 2, 177, 48, 3, 2, 2, 2, 178, 179, 7, 69, 2, 2, 179, 180, 7, 81,
-	2, 2, 180, 181, 7, 80, 2, 2, 181, 182, 7, 86, 2, 2, 182, 183, 7, 67, 2,
-	2, 183, 184, 7, 75, 2, 2, 184, 185, 7, 80, 2, 2, 185, 50, 3, 2, 2, 2, 186,
-	187, 7, 71, 2, 2, 187, 188, 7, 90, 2, 2, 188, 189, 7, 75, 2, 2, 189, 190,
-	7, 85, 2, 2, 190, 191, 7, 86, 2, 2, 191, 52, 3, 2, 2, 2, 192, 193, 7, 86,
-	2, 2, 193, 194, 7, 75, 2, 2, 194, 195, 7, 79, 2, 2, 195, 196, 7, 71, 2,
-	2, 196, 197, 7, 72, 2, 2, 197, 198, 7, 84, 2, 2, 198, 199, 7, 67, 2, 2,
-	199, 200, 7, 79, 2, 2, 200, 201, 7, 71, 2, 2, 201, 54, 3, 2, 2, 2, 202,
-	203, 7, 77, 2, 2, 203, 204, 7, 71, 2, 2, 204, 205, 7, 91, 2, 2, 205, 56,
-	3, 2, 2, 2, 206, 207, 7, 72, 2, 2, 207, 208, 7, 75, 2, 2, 208, 209, 7,
-	80, 2, 2, 209, 210, 7, 70, 2, 2, 210, 58, 3, 2, 2, 2, 211, 212, 7, 89,
-	2, 2, 212, 213, 7, 74, 2, 2, 213, 214, 7, 71, 2, 2, 214, 215, 7, 84, 2,
-	2, 215, 216, 7, 71, 2, 2, 216, 60, 3, 2, 2, 2, 217, 218, 7, 81, 2, 2, 218,
-	219, 7, 84, 2, 2, 219, 220, 7, 70, 2, 2, 220, 221, 7, 71, 2, 2, 221, 222,
-	7, 84, 2, 2, 222, 223, 7, 47, 2, 2, 223, 224, 7, 68, 2, 2, 224, 225, 7,
-	91, 2, 2, 225, 62, 3, 2, 2, 2, 226, 227, 7, 73, 2, 2, 227, 228, 7, 84,
-	2, 2, 228, 229, 7, 81, 2, 2, 229, 230, 7, 87, 2, 2, 230, 231, 7, 82, 2,
-	2, 231, 232, 7, 47, 2, 2, 232, 233, 7, 68, 2, 2, 233, 234, 7, 91, 2, 2,
-	234, 64, 3, 2, 2, 2, 235, 236, 7, 78, 2, 2, 236, 237, 7, 75, 2, 2, 237,
-	238, 7, 79, 2, 2, 238, 239, 7, 75, 2, 2, 239, 240, 7, 86, 2, 2, 240, 66,
-	3, 2, 2, 2, 241, 242, 7, 67, 2, 2, 242, 243, 7, 85, 2, 2, 243, 244, 7,
-	69, 2, 2, 244, 68, 3, 2, 2, 2, 245, 246, 7, 70, 2, 2, 246, 247, 7, 71,
-	2, 2, 247, 248, 7, 85, 2, 2, 248, 249, 7, 69, 2, 2, 249, 70, 3, 2, 2, 2,
-	250, 253, 5, 81, 41, 2, 251, 253, 7, 97, 2, 2, 252, 250, 3, 2, 2, 2, 252,
-	251, 3, 2, 2, 2, 253, 259, 3, 2, 2, 2, 254, 258, 5, 81, 41, 2, 255, 258,
-	5, 85, 43, 2, 256, 258, 9, 2, 2, 2, 257, 254, 3, 2, 2, 2, 257, 255, 3,
-	2, 2, 2, 257, 256, 3, 2, 2, 2, 258, 261, 3, 2, 2, 2, 259, 257, 3, 2, 2,
-	2, 259, 260, 3, 2, 2, 2, 260, 72, 3, 2, 2, 2, 261, 259, 3, 2, 2, 2, 262,
-	273, 7, 49, 2, 2, 263, 264, 7, 49, 2, 2, 264, 269, 5, 71, 36, 2, 265, 266,
-	7, 49, 2, 2, 266, 268, 5, 71, 36, 2, 267, 265, 3, 2, 2, 2, 268, 271, 3,
-	2, 2, 2, 269, 267, 3, 2, 2, 2, 269, 270, 3, 2, 2, 2, 270, 273, 3, 2, 2,
-	2, 271, 269, 3, 2, 2, 2, 272, 262, 3, 2, 2, 2, 272, 263, 3, 2, 2, 2, 273,
-	74, 3, 2, 2, 2, 274, 278, 5, 89, 45, 2, 275, 278, 5, 91, 46, 2, 276, 278,
-	5, 93, 47, 2, 277, 274, 3, 2, 2, 2, 277, 275, 3, 2, 2, 2, 277, 276, 3,
-	2, 2, 2, 278, 76, 3, 2, 2, 2, 279, 281, 7, 50, 2, 2, 280, 279, 3, 2, 2,
-	2, 281, 282, 3, 2, 2, 2, 282, 280, 3, 2, 2, 2, 282, 283, 3, 2, 2, 2, 283,
-	292, 3, 2, 2, 2, 284, 288, 5, 83, 42, 2, 285, 287, 5, 85, 43, 2, 286, 285,
-	3, 2, 2, 2, 287, 290, 3, 2, 2, 2, 288, 286, 3, 2, 2, 2, 288, 289, 3, 2,
-	2, 2, 289, 292, 3, 2, 2, 2, 290, 288, 3, 2, 2, 2, 291, 280, 3, 2, 2, 2,
-	291, 284, 3, 2, 2, 2, 292, 78, 3, 2, 2, 2, 293, 295, 5, 85, 43, 2, 294,
-	293, 3, 2, 2, 2, 295, 296, 3, 2, 2, 2, 296, 294, 3, 2, 2, 2, 296, 297,
-	3, 2, 2, 2, 297, 299, 3, 2, 2, 2, 298, 294, 3, 2, 2, 2, 298, 299, 3, 2,
-	2, 2, 299, 300, 3, 2, 2, 2, 300, 302, 7, 48, 2, 2, 301, 303, 5, 85, 43,
-	2, 302, 301, 3, 2, 2, 2, 303, 304, 3, 2, 2, 2, 304, 302, 3, 2, 2, 2, 304,
-	305, 3, 2, 2, 2, 305, 337, 3, 2, 2, 2, 306, 308, 5, 85, 43, 2, 307, 306,
-	3, 2, 2, 2, 308, 309, 3, 2, 2, 2, 309, 307, 3, 2, 2, 2, 309, 310, 3, 2,
-	2, 2, 310, 311, 3, 2, 2, 2, 311, 312, 7, 48, 2, 2, 312, 313, 5, 87, 44,
-	2, 313, 337, 3, 2, 2, 2, 314, 316, 5, 85, 43, 2, 315, 314, 3, 2, 2, 2,
-	316, 317, 3, 2, 2, 2, 317, 315, 3, 2, 2, 2, 317, 318, 3, 2, 2, 2, 318,
-	320, 3, 2, 2, 2, 319, 315, 3, 2, 2, 2, 319, 320, 3, 2, 2, 2, 320, 321,
-	3, 2, 2, 2, 321, 323, 7, 48, 2, 2, 322, 324, 5, 85, 43, 2, 323, 322, 3,
-	2, 2, 2, 324, 325, 3, 2, 2, 2, 325, 323, 3, 2, 2, 2, 325, 326, 3, 2, 2,
-	2, 326, 327, 3, 2, 2, 2, 327, 328, 5, 87, 44, 2, 328, 337, 3, 2, 2, 2,
-	329, 331, 5, 85, 43, 2, 330, 329, 3, 2, 2, 2, 331, 332, 3, 2, 2, 2, 332,
-	330, 3, 2, 2, 2, 332, 333, 3, 2, 2, 2, 333, 334, 3, 2, 2, 2, 334, 335,
-	5, 87, 44, 2, 335, 337, 3, 2, 2, 2, 336, 298, 3, 2, 2, 2, 336, 307, 3,
-	2, 2, 2, 336, 319, 3, 2, 2, 2, 336, 330, 3, 2, 2, 2, 337, 80, 3, 2, 2,
-	2, 338, 339, 9, 3, 2, 2, 339, 82, 3, 2, 2, 2, 340, 341, 4, 51, 59, 2, 341,
-	84, 3, 2, 2, 2, 342, 343, 4, 50, 59, 2, 343, 86, 3, 2, 2, 2, 344, 346,
-	7, 71, 2, 2, 345, 347, 9, 4, 2, 2, 346, 345, 3, 2, 2, 2, 346, 347, 3, 2,
-	2, 2, 347, 349, 3, 2, 2, 2, 348, 350, 5, 85, 43, 2, 349, 348, 3, 2, 2,
-	2, 350, 351, 3, 2, 2, 2, 351, 349, 3, 2, 2, 2, 351, 352, 3, 2, 2, 2, 352,
-	88, 3, 2, 2, 2, 353, 361, 7, 36, 2, 2, 354, 355, 7, 94, 2, 2, 355, 360,
-	11, 2, 2, 2, 356, 357, 7, 36, 2, 2, 357, 360, 7, 36, 2, 2, 358, 360, 10,
-	5, 2, 2, 359, 354, 3, 2, 2, 2, 359, 356, 3, 2, 2, 2, 359, 358, 3, 2, 2,
-	2, 360, 363, 3, 2, 2, 2, 361, 359, 3, 2, 2, 2, 361, 362, 3, 2, 2, 2, 362,
-	364, 3, 2, 2, 2, 363, 361, 3, 2, 2, 2, 364, 365, 7, 36, 2, 2, 365, 90,
-	3, 2, 2, 2, 366, 374, 7, 41, 2, 2, 367, 368, 7, 94, 2, 2, 368, 373, 11,
-	2, 2, 2, 369, 370, 7, 41, 2, 2, 370, 373, 7, 41, 2, 2, 371, 373, 10, 6,
-	2, 2, 372, 367, 3, 2, 2, 2, 372, 369, 3, 2, 2, 2, 372, 371, 3, 2, 2, 2,
-	373, 376, 3, 2, 2, 2, 374, 372, 3, 2, 2, 2, 374, 375, 3, 2, 2, 2, 375,
-	377, 3, 2, 2, 2, 376, 374, 3, 2, 2, 2, 377, 378, 7, 41, 2, 2, 378, 92,
-	3, 2, 2, 2, 379, 387, 7, 98, 2, 2, 380, 381, 7, 94, 2, 2, 381, 386, 11,
-	2, 2, 2, 382, 383, 7, 98, 2, 2, 383, 386, 7, 98, 2, 2, 384, 386, 10, 7,
-	2, 2, 385, 380, 3, 2, 2, 2, 385, 382, 3, 2, 2, 2, 385, 384, 3, 2, 2, 2,
-	386, 389, 3, 2, 2, 2, 387, 385, 3, 2, 2, 2, 387, 388, 3, 2, 2, 2, 388,
-	390, 3, 2, 2, 2, 389, 387, 3, 2, 2, 2, 390, 391, 7, 98, 2, 2, 391, 94,
-	3, 2, 2, 2, 392, 393, 7, 38, 2, 2, 393, 394, 5, 71, 36, 2, 394, 96, 3,
-	2, 2, 2, 395, 396, 9, 8, 2, 2, 396, 397, 3, 2, 2, 2, 397, 398, 8, 49, 2,
-	2, 398, 98, 3, 2, 2, 2, 29, 2, 252, 257, 259, 269, 272, 277, 282, 288,
-	291, 296, 298, 304, 309, 317, 319, 325, 332, 336, 346, 351, 359, 361, 372,
-	374, 385, 387, 3, 8, 2, 2,
+	77, 40, 79, 41, 81, 42, 83, 43, 85, 44, 87, 45, 89, 46, 91, 47, 93, 48,
+	95, 49, 97, 50, 99, 51, 101, 52, 103, 53, 105, 54, 107, 2, 109, 2, 111,
+	2, 113, 2, 115, 2, 117, 2, 119, 2, 121, 2, 123, 55, 125, 56, 3, 2, 10,
+	4, 2, 47, 48, 97, 97, 7, 2, 70, 70, 74, 74, 79, 79, 85, 85, 89, 89, 4,
+	2, 67, 92, 99, 124, 4, 2, 45, 45, 47, 47, 4, 2, 36, 36, 94, 94, 4, 2, 41,
+	41, 94, 94, 4, 2, 94, 94, 98, 98, 5, 2, 11, 12, 15, 15, 34, 34, 2, 582,
+	2, 3, 3, 2, 2, 2, 2, 5, 3, 2, 2, 2, 2, 7, 3, 2, 2, 2, 2, 9, 3, 2, 2, 2,
+	2, 11, 3, 2, 2, 2, 2, 13, 3, 2, 2, 2, 2, 15, 3, 2, 2, 2, 2, 17, 3, 2, 2,
+	2, 2, 19, 3, 2, 2, 2, 2, 21, 3, 2, 2, 2, 2, 23, 3, 2, 2, 2, 2, 25, 3, 2,
+	2, 2, 2, 27, 3, 2, 2, 2, 2, 29, 3, 2, 2, 2, 2, 31, 3, 2, 2, 2, 2, 33, 3,
+	2, 2, 2, 2, 35, 3, 2, 2, 2, 2, 37, 3, 2, 2, 2, 2, 39, 3, 2, 2, 2, 2, 41,
+	3, 2, 2, 2, 2, 43, 3, 2, 2, 2, 2, 45, 3, 2, 2, 2, 2, 47, 3, 2, 2, 2, 2,
+	49, 3, 2, 2, 2, 2, 51, 3, 2, 2, 2, 2, 53, 3, 2, 2, 2, 2, 55, 3, 2, 2, 2,
+	2, 57, 3, 2, 2, 2, 2, 59, 3, 2, 2, 2, 2, 61, 3, 2, 2, 2, 2, 63, 3, 2, 2,
+	2, 2, 65, 3, 2, 2, 2, 2, 67, 3, 2, 2, 2, 2, 69, 3, 2, 2, 2, 2, 71, 3, 2,
+	2, 2, 2, 73, 3, 2, 2, 2, 2, 75, 3, 2, 2, 2, 2, 77, 3, 2, 2, 2, 2, 79, 3,
+	2, 2, 2, 2, 81, 3, 2, 2, 2, 2, 83, 3, 2, 2, 2, 2, 85, 3, 2, 2, 2, 2, 87,
+	3, 2, 2, 2, 2, 89, 3, 2, 2, 2, 2, 91, 3, 2, 2, 2, 2, 93, 3, 2, 2, 2, 2,
+	95, 3, 2, 2, 2, 2, 97, 3, 2, 2, 2, 2, 99, 3, 2, 2, 2, 2, 101, 3, 2, 2,
+	2, 2, 103, 3, 2, 2, 2, 2, 105, 3, 2, 2, 2, 2, 123, 3, 2, 2, 2, 2, 125,
+	3, 2, 2, 2, 3, 127, 3, 2, 2, 2, 5, 129, 3, 2, 2, 2, 7, 131, 3, 2, 2, 2,
+	9, 133, 3, 2, 2, 2, 11, 135, 3, 2, 2, 2, 13, 137, 3, 2, 2, 2, 15, 139,
+	3, 2, 2, 2, 17, 141, 3, 2, 2, 2, 19, 143, 3, 2, 2, 2, 21, 146, 3, 2, 2,
+	2, 23, 148, 3, 2, 2, 2, 25, 152, 3, 2, 2, 2, 27, 156, 3, 2, 2, 2, 29, 160,
+	3, 2, 2, 2, 31, 164, 3, 2, 2, 2, 33, 170, 3, 2, 2, 2, 35, 185, 3, 2, 2,
+	2, 37, 192, 3, 2, 2, 2, 39, 201, 3, 2, 2, 2, 41, 207, 3, 2, 2, 2, 43, 212,
+	3, 2, 2, 2, 45, 222, 3, 2, 2, 2, 47, 227, 3, 2, 2, 2, 49, 232, 3, 2, 2,
+	2, 51, 235, 3, 2, 2, 2, 53, 239, 3, 2, 2, 2, 55, 242, 3, 2, 2, 2, 57, 245,
+	3, 2, 2, 2, 59, 248, 3, 2, 2, 2, 61, 251, 3, 2, 2, 2, 63, 254, 3, 2, 2,
+	2, 65, 262, 3, 2, 2, 2, 67, 270, 3, 2, 2, 2, 69, 276, 3, 2, 2, 2, 71, 286,
+	3, 2, 2, 2, 73, 290, 3, 2, 2, 2, 75, 295, 3, 2, 2, 2, 77, 303, 3, 2, 2,
+	2, 79, 308, 3, 2, 2, 2, 81, 313, 3, 2, 2, 2, 83, 316, 3, 2, 2, 2, 85, 322,
+	3, 2, 2, 2, 87, 331, 3, 2, 2, 2, 89, 340, 3, 2, 2, 2, 91, 346, 3, 2, 2,
+	2, 93, 350, 3, 2, 2, 2, 95, 357, 3, 2, 2, 2, 97, 377, 3, 2, 2, 2, 99, 382,
+	3, 2, 2, 2, 101, 396, 3, 2, 2, 2, 103, 412, 3, 2, 2, 2, 105, 459, 3, 2,
+	2, 2, 107, 461, 3, 2, 2, 2, 109, 463, 3, 2, 2, 2, 111, 465, 3, 2, 2, 2,
+	113, 467, 3, 2, 2, 2, 115, 498, 3, 2, 2, 2, 117, 500, 3, 2, 2, 2, 119,
+	513, 3, 2, 2, 2, 121, 526, 3, 2, 2, 2, 123, 539, 3, 2, 2, 2, 125, 542,
+	3, 2, 2, 2, 127, 128, 7, 46, 2, 2, 128, 4, 3, 2, 2, 2, 129, 130, 7, 42,
+	2, 2, 130, 6, 3, 2, 2, 2, 131, 132, 7, 43, 2, 2, 132, 8, 3, 2, 2, 2, 133,
+	134, 7, 63, 2, 2, 134, 10, 3, 2, 2, 2, 135, 136, 7, 93, 2, 2, 136, 12,
+	3, 2, 2, 2, 137, 138, 7, 95, 2, 2, 138, 14, 3, 2, 2, 2, 139, 140, 7, 125,
+	2, 2, 140, 16, 3, 2, 2, 2, 141, 142, 7, 127, 2, 2, 142, 18, 3, 2, 2, 2,
+	143, 144, 7, 125, 2, 2, 144, 145, 7, 40, 2, 2, 145, 20, 3, 2, 2, 2, 146,
+	147, 7, 47, 2, 2, 147, 22, 3, 2, 2, 2, 148, 149, 7, 67, 2, 2, 149, 150,
+	7, 88, 2, 2, 150, 151, 7, 73, 2, 2, 151, 24, 3, 2, 2, 2, 152, 153, 7, 79,
+	2, 2, 153, 154, 7, 67, 2, 2, 154, 155, 7, 90, 2, 2, 155, 26, 3, 2, 2, 2,
+	156, 157, 7, 79, 2, 2, 157, 158, 7, 75, 2, 2, 158, 159, 7, 80, 2, 2, 159,
+	28, 3, 2, 2, 2, 160, 161, 7, 85, 2, 2, 161, 162, 7, 87, 2, 2, 162, 163,
+	7, 79, 2, 2, 163, 30, 3, 2, 2, 2, 164, 165, 7, 69, 2, 2, 165, 166, 7, 81,
+	2, 2, 166, 167, 7, 87, 2, 2, 167, 168, 7, 80, 2, 2, 168, 169, 7, 86, 2,
+	2, 169, 32, 3, 2, 2, 2, 170, 171, 7, 69, 2, 2, 171, 172, 7, 81, 2, 2, 172,
+	173, 7, 87, 2, 2, 173, 174, 7, 80, 2, 2, 174, 175, 7, 86, 2, 2, 175, 176,
+	7, 47, 2, 2, 176, 177, 7, 70, 2, 2, 177, 178, 7, 75, 2, 2, 178, 179, 7,
+	85, 2, 2, 179, 180, 7, 86, 2, 2, 180, 181, 7, 75, 2, 2, 181, 182, 7, 80,
+	2, 2, 182, 183, 7, 69, 2, 2, 183, 184, 7, 86, 2, 2, 184, 34, 3, 2, 2, 2,
+	185, 186, 7, 85, 2, 2, 186, 187, 7, 86, 2, 2, 187, 188, 7, 70, 2, 2, 188,
+	189, 7, 70, 2, 2, 189, 190, 7, 71, 2, 2, 190, 191, 7, 88, 2, 2, 191, 36,
+	3, 2, 2, 2, 192, 193, 7, 88, 2, 2, 193, 194, 7, 67, 2, 2, 194, 195, 7,
+	84, 2, 2, 195, 196, 7, 75, 2, 2, 196, 197, 7, 67, 2, 2, 197, 198, 7, 80,
+	2, 2, 198, 199, 7, 69, 2, 2, 199, 200, 7, 71, 2, 2, 200, 38, 3, 2, 2, 2,
+	201, 202, 7, 72, 2, 2, 202, 203, 7, 75, 2, 2, 203, 204, 7, 84, 2, 2, 204,
+	205, 7, 85, 2, 2, 205, 206, 7, 86, 2, 2, 206, 40, 3, 2, 2, 2, 207, 208,
+	7, 86, 2, 2, 208, 209, 7, 81, 2, 2, 209, 210, 7, 82, 2, 2, 210, 211, 7,
+	77, 2, 2, 211, 42, 3, 2, 2, 2, 212, 213, 7, 74, 2, 2, 213, 214, 7, 75,
+	2, 2, 214, 215, 7, 85, 2, 2, 215, 216, 7, 86, 2, 2, 216, 217, 7, 81, 2,
+	2, 217, 218, 7, 73, 2, 2, 218, 219, 7, 84, 2, 2, 219, 220, 7, 67, 2, 2,
+	220, 221, 7, 79, 2, 2, 221, 44, 3, 2, 2, 2, 222, 223, 7, 82, 2, 2, 223,
+	224, 7, 69, 2, 2, 224, 225, 7, 86, 2, 2, 225, 226, 7, 78, 2, 2, 226, 46,
+	3, 2, 2, 2, 227, 228, 7, 82, 2, 2, 228, 229, 7, 67, 2, 2, 229, 230, 7,
+	84, 2, 2, 230, 231, 7, 86, 2, 2, 231, 48, 3, 2, 2, 2, 232, 233, 7, 71,
+	2, 2, 233, 234, 7, 83, 2, 2, 234, 50, 3, 2, 2, 2, 235, 236, 7, 80, 2, 2,
+	236, 237, 7, 71, 2, 2, 237, 238, 7, 83, 2, 2, 238, 52, 3, 2, 2, 2, 239,
+	240, 7, 75, 2, 2, 240, 241, 7, 80, 2, 2, 241, 54, 3, 2, 2, 2, 242, 243,
+	7, 78, 2, 2, 243, 244, 7, 86, 2, 2, 244, 56, 3, 2, 2, 2, 245, 246, 7, 78,
+	2, 2, 246, 247, 7, 71, 2, 2, 247, 58, 3, 2, 2, 2, 248, 249, 7, 73, 2, 2,
+	249, 250, 7, 71, 2, 2, 250, 60, 3, 2, 2, 2, 251, 252, 7, 73, 2, 2, 252,
+	253, 7, 86, 2, 2, 253, 62, 3, 2, 2, 2, 254, 255, 7, 68, 2, 2, 255, 256,
+	7, 71, 2, 2, 256, 257, 7, 86, 2, 2, 257, 258, 7, 89, 2, 2, 258, 259, 7,
+	71, 2, 2, 259, 260, 7, 71, 2, 2, 260, 261, 7, 80, 2, 2, 261, 64, 3, 2,
+	2, 2, 262, 263, 7, 69, 2, 2, 263, 264, 7, 81, 2, 2, 264, 265, 7, 80, 2,
+	2, 265, 266, 7, 86, 2, 2, 266, 267, 7, 67, 2, 2, 267, 268, 7, 75, 2, 2,
+	268, 269, 7, 80, 2, 2, 269, 66, 3, 2, 2, 2, 270, 271, 7, 71, 2, 2, 271,
+	272, 7, 90, 2, 2, 272, 273, 7, 75, 2, 2, 273, 274, 7, 85, 2, 2, 274, 275,
+	7, 86, 2, 2, 275, 68, 3, 2, 2, 2, 276, 277, 7, 86, 2, 2, 277, 278, 7, 75,
+	2, 2, 278, 279, 7, 79, 2, 2, 279, 280, 7, 71, 2, 2, 280, 281, 7, 72, 2,
+	2, 281, 282, 7, 84, 2, 2, 282, 283, 7, 67, 2, 2, 283, 284, 7, 79, 2, 2,
+	284, 285, 7, 71, 2, 2, 285, 70, 3, 2, 2, 2, 286, 287, 7, 77, 2, 2, 287,
+	288, 7, 71, 2, 2, 288, 289, 7, 91, 2, 2, 289, 72, 3, 2, 2, 2, 290, 291,
+	7, 78, 2, 2, 291, 292, 7, 67, 2, 2, 292, 293, 7, 85, 2, 2, 293, 294, 7,
+	86, 2, 2, 294, 74, 3, 2, 2, 2, 295, 296, 7, 71, 2, 2, 296, 297, 7, 90,
+	2, 2, 297, 298, 7, 82, 2, 2, 298, 299, 7, 78, 2, 2, 299, 300, 7, 67, 2,
+	2, 300, 301, 7, 75, 2, 2, 301, 302, 7, 80, 2, 2, 302, 76, 3, 2, 2, 2, 303,
+	304, 7, 72, 2, 2, 304, 305, 7, 75, 2, 2, 305, 306, 7, 80, 2, 2, 306, 307,
+	7, 70, 2, 2, 307, 78, 3, 2, 2, 2, 308, 309, 7, 72, 2, 2, 309, 310, 7, 84,
+	2, 2, 310, 311, 7, 81, 2, 2, 311, 312, 7, 79, 2, 2, 312, 80, 3, 2, 2, 2,
+	313, 314, 7, 81, 2, 2, 314, 315, 7, 84, 2, 2, 315, 82, 3, 2, 2, 2, 316,
+	317, 7, 89, 2, 2, 317, 318, 7, 74, 2, 2, 318, 319, 7, 71, 2, 2, 319, 320,
+	7, 84, 2, 2, 320, 321, 7, 71, 2, 2, 321, 84, 3, 2, 2, 2, 322, 323, 7, 81,
+	2, 2, 323, 324, 7, 84, 2, 2, 324, 325, 7, 70, 2, 2, 325, 326, 7, 71, 2,
+	2, 326, 327, 7, 84, 2, 2, 327, 328, 7, 47, 2, 2, 328, 329, 7, 68, 2, 2,
+	329, 330, 7, 91, 2, 2, 330, 86, 3, 2, 2, 2, 331, 332, 7, 73, 2, 2, 332,
+	333, 7, 84, 2, 2, 333, 334, 7, 81, 2, 2, 334, 335, 7, 87, 2, 2, 335, 336,
+	7, 82, 2, 2, 336, 337, 7, 47, 2, 2, 337, 338, 7, 68, 2, 2, 338, 339, 7,
+	91, 2, 2, 339, 88, 3, 2, 2, 2, 340, 341, 7, 78, 2, 2, 341, 342, 7, 75,
+	2, 2, 342, 343, 7, 79, 2, 2, 343, 344, 7, 75, 2, 2, 344, 345, 7, 86, 2,
+	2, 345, 90, 3, 2, 2, 2, 346, 347, 7, 67, 2, 2, 347, 348, 7, 85, 2, 2, 348,
+	349, 7, 69, 2, 2, 349, 92, 3, 2, 2, 2, 350, 351, 7, 70, 2, 2, 351, 352,
+	7, 71, 2, 2, 352, 353, 7, 85, 2, 2, 353, 354, 7, 69, 2, 2, 354, 94, 3,
+	2, 2, 2, 355, 358, 5, 107, 54, 2, 356, 358, 7, 97, 2, 2, 357, 355, 3, 2,
+	2, 2, 357, 356, 3, 2, 2, 2, 358, 364, 3, 2, 2, 2, 359, 363, 5, 107, 54,
+	2, 360, 363, 5, 111, 56, 2, 361, 363, 9, 2, 2, 2, 362, 359, 3, 2, 2, 2,
+	362, 360, 3, 2, 2, 2, 362, 361, 3, 2, 2, 2, 363, 366, 3, 2, 2, 2, 364,
+	362, 3, 2, 2, 2, 364, 365, 3, 2, 2, 2, 365, 96, 3, 2, 2, 2, 366, 364, 3,
+	2, 2, 2, 367, 378, 7, 49, 2, 2, 368, 369, 7, 49, 2, 2, 369, 374, 5, 115,
+	58, 2, 370, 371, 7, 49, 2, 2, 371, 373, 5, 115, 58, 2, 372, 370, 3, 2,
+	2, 2, 373, 376, 3, 2, 2, 2, 374, 372, 3, 2, 2, 2, 374, 375, 3, 2, 2, 2,
+	375, 378, 3, 2, 2, 2, 376, 374, 3, 2, 2, 2, 377, 367, 3, 2, 2, 2, 377,
+	368, 3, 2, 2, 2, 378, 98, 3, 2, 2, 2, 379, 383, 5, 117, 59, 2, 380, 383,
+	5, 119, 60, 2, 381, 383, 5, 121, 61, 2, 382, 379, 3, 2, 2, 2, 382, 380,
+	3, 2, 2, 2, 382, 381, 3, 2, 2, 2, 383, 100, 3, 2, 2, 2, 384, 386, 7, 50,
+	2, 2, 385, 384, 3, 2, 2, 2, 386, 387, 3, 2, 2, 2, 387, 385, 3, 2, 2, 2,
+	387, 388, 3, 2, 2, 2, 388, 397, 3, 2, 2, 2, 389, 393, 5, 109, 55, 2, 390,
+	392, 5, 111, 56, 2, 391, 390, 3, 2, 2, 2, 392, 395, 3, 2, 2, 2, 393, 391,
+	3, 2, 2, 2, 393, 394, 3, 2, 2, 2, 394, 397, 3, 2, 2, 2, 395, 393, 3, 2,
+	2, 2, 396, 385, 3, 2, 2, 2, 396, 389, 3, 2, 2, 2, 397, 102, 3, 2, 2, 2,
+	398, 400, 5, 111, 56, 2, 399, 398, 3, 2, 2, 2, 400, 401, 3, 2, 2, 2, 401,
+	399, 3, 2, 2, 2, 401, 402, 3, 2, 2, 2, 402, 410, 3, 2, 2, 2, 403, 404,
+	7, 80, 2, 2, 404, 411, 7, 85, 2, 2, 405, 406, 7, 87, 2, 2, 406, 411, 7,
+	85, 2, 2, 407, 408, 7, 79, 2, 2, 408, 411, 7, 85, 2, 2, 409, 411, 9, 3,
+	2, 2, 410, 403, 3, 2, 2, 2, 410, 405, 3, 2, 2, 2, 410, 407, 3, 2, 2, 2,
+	410, 409, 3, 2, 2, 2, 411, 413, 3, 2, 2, 2, 412, 399, 3, 2, 2, 2, 413,
+	414, 3, 2, 2, 2, 414, 412, 3, 2, 2, 2, 414, 415, 3, 2, 2, 2, 415, 104,
+	3, 2, 2, 2, 416, 418, 5, 111, 56, 2, 417, 416, 3, 2, 2, 2, 418, 419, 3,
+	2, 2, 2, 419, 417, 3, 2, 2, 2, 419, 420, 3, 2, 2, 2, 420, 422, 3, 2, 2,
+	2, 421, 417, 3, 2, 2, 2, 421, 422, 3, 2, 2, 2, 422, 423, 3, 2, 2, 2, 423,
+	425, 7, 48, 2, 2, 424, 426, 5, 111, 56, 2, 425, 424, 3, 2, 2, 2, 426, 427,
+	3, 2, 2, 2, 427, 425, 3, 2, 2, 2, 427, 428, 3, 2, 2, 2, 428, 460, 3, 2,
+	2, 2, 429, 431, 5, 111, 56, 2, 430, 429, 3, 2, 2, 2, 431, 432, 3, 2, 2,
+	2, 432, 430, 3, 2, 2, 2, 432, 433, 3, 2, 2, 2, 433, 434, 3, 2, 2, 2, 434,
+	435, 7, 48, 2, 2, 435, 436, 5, 113, 57, 2, 436, 460, 3, 2, 2, 2, 437, 439,
+	5, 111, 56, 2, 438, 437, 3, 2, 2, 2, 439, 440, 3, 2, 2, 2, 440, 438, 3,
+	2, 2, 2, 440, 441, 3, 2, 2, 2, 441, 443, 3, 2, 2, 2, 442, 438, 3, 2, 2,
+	2, 442, 443, 3, 2, 2, 2, 443, 444, 3, 2, 2, 2, 444, 446, 7, 48, 2, 2, 445,
+	447, 5, 111, 56, 2, 446, 445, 3, 2, 2, 2, 447, 448, 3, 2, 2, 2, 448, 446,
+	3, 2, 2, 2, 448, 449, 3, 2, 2, 2, 449, 450, 3, 2, 2, 2, 450, 451, 5, 113,
+	57, 2, 451, 460, 3, 2, 2, 2, 452, 454, 5, 111, 56, 2, 453, 452, 3, 2, 2,
+	2, 454, 455, 3, 2, 2, 2, 455, 453, 3, 2, 2, 2, 455, 456, 3, 2, 2, 2, 456,
+	457, 3, 2, 2, 2, 457, 458, 5, 113, 57, 2, 458, 460, 3, 2, 2, 2, 459, 421,
+	3, 2, 2, 2, 459, 430, 3, 2, 2, 2, 459, 442, 3, 2, 2, 2, 459, 453, 3, 2,
+	2, 2, 460, 106, 3, 2, 2, 2, 461, 462, 9, 4, 2, 2, 462, 108, 3, 2, 2, 2,
+	463, 464, 4, 51, 59, 2, 464, 110, 3, 2, 2, 2, 465, 466, 4, 50, 59, 2, 466,
+	112, 3, 2, 2, 2, 467, 469, 7, 71, 2, 2, 468, 470, 9, 5, 2, 2, 469, 468,
+	3, 2, 2, 2, 469, 470, 3, 2, 2, 2, 470, 472, 3, 2, 2, 2, 471, 473, 5, 111,
+	56, 2, 472, 471, 3, 2, 2, 2, 473, 474, 3, 2, 2, 2, 474, 472, 3, 2, 2, 2,
+	474, 475, 3, 2, 2, 2, 475, 114, 3, 2, 2, 2, 476, 479, 5, 107, 54, 2, 477,
+	479, 7, 97, 2, 2, 478, 476, 3, 2, 2, 2, 478, 477, 3, 2, 2, 2, 479, 485,
+	3, 2, 2, 2, 480, 484, 5, 107, 54, 2, 481, 484, 5, 111, 56, 2, 482, 484,
+	9, 2, 2, 2, 483, 480, 3, 2, 2, 2, 483, 481, 3, 2, 2, 2, 483, 482, 3, 2,
+	2, 2, 484, 487, 3, 2, 2, 2, 485, 483, 3, 2, 2, 2, 485, 486, 3, 2, 2, 2,
+	486, 499, 3, 2, 2, 2, 487, 485, 3, 2, 2, 2, 488, 494, 7, 36, 2, 2, 489,
+	490, 7, 94, 2, 2, 490, 493, 11, 2, 2, 2, 491, 493, 10, 6, 2, 2, 492, 489,
+	3, 2, 2, 2, 492, 491, 3, 2, 2, 2, 493, 496, 3, 2, 2, 2, 494, 492, 3, 2,
+	2, 2, 494, 495, 3, 2, 2, 2, 495, 497, 3, 2, 2, 2, 496, 494, 3, 2, 2, 2,
+	497, 499, 7, 36, 2, 2, 498, 478, 3, 2, 2, 2, 498, 488, 3, 2, 2, 2, 499,
+	116, 3, 2, 2, 2, 500, 508, 7, 36, 2, 2, 501, 502, 7, 94, 2, 2, 502, 507,
+	11, 2, 2, 2, 503, 504, 7, 36, 2, 2, 504, 507, 7, 36, 2, 2, 505, 507, 10,
+	6, 2, 2, 506, 501, 3, 2, 2, 2, 506, 503, 3, 2, 2, 2, 506, 505, 3, 2, 2,
+	2, 507, 510, 3, 2, 2, 2, 508, 506, 3, 2, 2, 2, 508, 509, 3, 2, 2, 2, 509,
+	511, 3, 2, 2, 2, 510, 508, 3, 2, 2, 2, 511, 512, 7, 36, 2, 2, 512, 118,
+	3, 2, 2, 2, 513, 521, 7, 41, 2, 2, 514, 515, 7, 94, 2, 2, 515, 520, 11,
+	2, 2, 2, 516, 517, 7, 41, 2, 2, 517, 520, 7, 41, 2, 2, 518, 520, 10, 7,
+	2, 2, 519, 514, 3, 2, 2, 2, 519, 516, 3, 2, 2, 2, 519, 518, 3, 2, 2, 2,
+	520, 523, 3, 2, 2, 2, 521, 519, 3, 2, 2, 2, 521, 522, 3, 2, 2, 2, 522,
+	524, 3, 2, 2, 2, 523, 521, 3, 2, 2, 2, 524, 525, 7, 41, 2, 2, 525, 120,
+	3, 2, 2, 2, 526, 534, 7, 98, 2, 2, 527, 528, 7, 94, 2, 2, 528, 533, 11,
+	2, 2, 2, 529, 530, 7, 98, 2, 2, 530, 533, 7, 98, 2, 2, 531, 533, 10, 8,
+	2, 2, 532, 527, 3, 2, 2, 2, 532, 529, 3, 2, 2, 2, 532, 531, 3, 2, 2, 2,
+	533, 536, 3, 2, 2, 2, 534, 532, 3, 2, 2, 2, 534, 535, 3, 2, 2, 2, 535,
+	537, 3, 2, 2, 2, 536, 534, 3, 2, 2, 2, 537, 538, 7, 98, 2, 2, 538, 122,
+	3, 2, 2, 2, 539, 540, 7, 38, 2, 2, 540, 541, 5, 95, 48, 2, 541, 124, 3,
+	2, 2, 2, 542, 543, 9, 9, 2, 2, 543, 544, 3, 2, 2, 2, 544, 545, 8, 63, 2,
+	2, 545, 126, 3, 2, 2, 2, 38, 2, 357, 362, 364, 374, 377, 382, 387, 393,
+	396, 401, 410, 414, 419, 421, 427, 432, 440, 442, 448, 455, 459, 469, 474,
+	478, 483, 485, 492, 494, 498, 506, 508, 519, 521, 532, 534, 3, 8, 2, 2,
 }
 
 var lexerDeserializer = antlr.NewATNDeserializer(nil)
@@ -208,29 +274,32 @@ var lexerModeNames = []string{
 }
 
 var lexerLiteralNames = []string{
-	"", "','", "'('", "')'", "'['", "']'", "'{'", "'}'", "'{&'", "'AVG'", "'MAX'",
-	"'MIN'", "'SUM'", "'COUNT'", "'PCTL'", "'PART'", "'EQ'", "'NEQ'", "'IN'",
-	"'LT'", "'LE'", "'GE'", "'GT'", "'BETWEEN'", "'CONTAIN'", "'EXIST'", "'TIMEFRAME'",
-	"'KEY'", "'FIND'", "'WHERE'", "'ORDER-BY'", "'GROUP-BY'", "'LIMIT'", "'ASC'",
-	"'DESC'",
+	"", "','", "'('", "')'", "'='", "'['", "']'", "'{'", "'}'", "'{&'", "'-'",
+	"'AVG'", "'MAX'", "'MIN'", "'SUM'", "'COUNT'", "'COUNT-DISTINCT'", "'STDDEV'",
+	"'VARIANCE'", "'FIRST'", "'TOPK'", "'HISTOGRAM'", "'PCTL'", "'PART'", "'EQ'",
+	"'NEQ'", "'IN'", "'LT'", "'LE'", "'GE'", "'GT'", "'BETWEEN'", "'CONTAIN'",
+	"'EXIST'", "'TIMEFRAME'", "'KEY'", "'LAST'", "'EXPLAIN'", "'FIND'", "'FROM'",
+	"'OR'", "'WHERE'", "'ORDER-BY'", "'GROUP-BY'", "'LIMIT'", "'ASC'", "'DESC'",
 }
 
 var lexerSymbolicNames = []string{
-	"", "", "", "", "", "", "", "", "", "AVG", "MAX", "MIN", "SUM", "COUNT",
+	"", "", "", "", "", "", "", "", "", "", "", "AVG", "MAX", "MIN", "SUM",
+	"COUNT", "COUNT_DISTINCT", "STDDEV", "VARIANCE", "FIRST", "TOPK", "HISTOGRAM",
 	"PERCENTILE", "PARTITION", "EQ", "NEQ", "IN", "LT", "LE", "GE", "GT", "BETWEEN",
-	"CONTAIN", "EXIST", "TIMEFRAME", "KEY", "FIND", "WHERE", "ORDER_BY", "GROUP_BY",
-	"LIMIT", "ASC", "DESC", "NAME", "PATH", "STRING", "INTEGER", "REAL_NUMBER",
-	"IDENTIFIER", "WS",
+	"CONTAIN", "EXIST", "TIMEFRAME", "KEY", "LAST", "EXPLAIN", "FIND", "FROM",
+	"OR", "WHERE", "ORDER_BY", "GROUP_BY", "LIMIT", "ASC", "DESC", "NAME",
+	"PATH", "STRING", "INTEGER", "DURATION", "REAL_NUMBER", "IDENTIFIER", "WS",
 }
 
 var lexerRuleNames = []string{
-	"T__0", "T__1", "T__2", "T__3", "T__4", "T__5", "T__6", "T__7", "AVG",
-	"MAX", "MIN", "SUM", "COUNT", "PERCENTILE", "PARTITION", "EQ", "NEQ", "IN",
-	"LT", "LE", "GE", "GT", "BETWEEN", "CONTAIN", "EXIST", "TIMEFRAME", "KEY",
-	"FIND", "WHERE", "ORDER_BY", "GROUP_BY", "LIMIT", "ASC", "DESC", "NAME",
-	"PATH", "STRING", "INTEGER", "REAL_NUMBER", "LETTER", "NON_ZERO_DIGIT",
-	"DIGIT", "EXPONENT", "DQUOTA_STRING", "SQUOTA_STRING", "BQUOTA_STRING",
-	"IDENTIFIER", "WS",
+	"T__0", "T__1", "T__2", "T__3", "T__4", "T__5", "T__6", "T__7", "T__8",
+	"T__9", "AVG", "MAX", "MIN", "SUM", "COUNT", "COUNT_DISTINCT", "STDDEV",
+	"VARIANCE", "FIRST", "TOPK", "HISTOGRAM", "PERCENTILE", "PARTITION", "EQ",
+	"NEQ", "IN", "LT", "LE", "GE", "GT", "BETWEEN", "CONTAIN", "EXIST", "TIMEFRAME",
+	"KEY", "LAST", "EXPLAIN", "FIND", "FROM", "OR", "WHERE", "ORDER_BY", "GROUP_BY",
+	"LIMIT", "ASC", "DESC", "NAME", "PATH", "STRING", "INTEGER", "DURATION",
+	"REAL_NUMBER", "LETTER", "NON_ZERO_DIGIT", "DIGIT", "EXPONENT", "PATH_KEY",
+	"DQUOTA_STRING", "SQUOTA_STRING", "BQUOTA_STRING", "IDENTIFIER", "WS",
 }
 
 type SSQLLexer struct {
@@ -268,45 +337,58 @@ func NewSSQLLexer(input antlr.CharStream) *SSQLLexer {
 
 // SSQLLexer tokens.
 const (
-	SSQLLexerT__0        = 1
-	SSQLLexerT__1        = 2
-	SSQLLexerT__2        = 3
-	SSQLLexerT__3        = 4
-	SSQLLexerT__4        = 5
-	SSQLLexerT__5        = 6
-	SSQLLexerT__6        = 7
-	SSQLLexerT__7        = 8
-	SSQLLexerAVG         = 9
-	SSQLLexerMAX         = 10
-	SSQLLexerMIN         = 11
-	SSQLLexerSUM         = 12
-	SSQLLexerCOUNT       = 13
-	SSQLLexerPERCENTILE  = 14
-	SSQLLexerPARTITION   = 15
-	SSQLLexerEQ          = 16
-	SSQLLexerNEQ         = 17
-	SSQLLexerIN          = 18
-	SSQLLexerLT          = 19
-	SSQLLexerLE          = 20
-	SSQLLexerGE          = 21
-	SSQLLexerGT          = 22
-	SSQLLexerBETWEEN     = 23
-	SSQLLexerCONTAIN     = 24
-	SSQLLexerEXIST       = 25
-	SSQLLexerTIMEFRAME   = 26
-	SSQLLexerKEY         = 27
-	SSQLLexerFIND        = 28
-	SSQLLexerWHERE       = 29
-	SSQLLexerORDER_BY    = 30
-	SSQLLexerGROUP_BY    = 31
-	SSQLLexerLIMIT       = 32
-	SSQLLexerASC         = 33
-	SSQLLexerDESC        = 34
-	SSQLLexerNAME        = 35
-	SSQLLexerPATH        = 36
-	SSQLLexerSTRING      = 37
-	SSQLLexerINTEGER     = 38
-	SSQLLexerREAL_NUMBER = 39
-	SSQLLexerIDENTIFIER  = 40
-	SSQLLexerWS          = 41
+	SSQLLexerT__0           = 1
+	SSQLLexerT__1           = 2
+	SSQLLexerT__2           = 3
+	SSQLLexerT__3           = 4
+	SSQLLexerT__4           = 5
+	SSQLLexerT__5           = 6
+	SSQLLexerT__6           = 7
+	SSQLLexerT__7           = 8
+	SSQLLexerT__8           = 9
+	SSQLLexerT__9           = 10
+	SSQLLexerAVG            = 11
+	SSQLLexerMAX            = 12
+	SSQLLexerMIN            = 13
+	SSQLLexerSUM            = 14
+	SSQLLexerCOUNT          = 15
+	SSQLLexerCOUNT_DISTINCT = 16
+	SSQLLexerSTDDEV         = 17
+	SSQLLexerVARIANCE       = 18
+	SSQLLexerFIRST          = 19
+	SSQLLexerTOPK           = 20
+	SSQLLexerHISTOGRAM      = 21
+	SSQLLexerPERCENTILE     = 22
+	SSQLLexerPARTITION      = 23
+	SSQLLexerEQ             = 24
+	SSQLLexerNEQ            = 25
+	SSQLLexerIN             = 26
+	SSQLLexerLT             = 27
+	SSQLLexerLE             = 28
+	SSQLLexerGE             = 29
+	SSQLLexerGT             = 30
+	SSQLLexerBETWEEN        = 31
+	SSQLLexerCONTAIN        = 32
+	SSQLLexerEXIST          = 33
+	SSQLLexerTIMEFRAME      = 34
+	SSQLLexerKEY            = 35
+	SSQLLexerLAST           = 36
+	SSQLLexerEXPLAIN        = 37
+	SSQLLexerFIND           = 38
+	SSQLLexerFROM           = 39
+	SSQLLexerOR             = 40
+	SSQLLexerWHERE          = 41
+	SSQLLexerORDER_BY       = 42
+	SSQLLexerGROUP_BY       = 43
+	SSQLLexerLIMIT          = 44
+	SSQLLexerASC            = 45
+	SSQLLexerDESC           = 46
+	SSQLLexerNAME           = 47
+	SSQLLexerPATH           = 48
+	SSQLLexerSTRING         = 49
+	SSQLLexerINTEGER        = 50
+	SSQLLexerDURATION       = 51
+	SSQLLexerREAL_NUMBER    = 52
+	SSQLLexerIDENTIFIER     = 53
+	SSQLLexerWS             = 54
 )
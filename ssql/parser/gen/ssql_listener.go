@@ -22,6 +22,27 @@ type SSQLListener interface {
 	// EnterPercentile is called when entering the percentile production.
 	EnterPercentile(c *PercentileContext)
 
+	// EnterCountDistinct is called when entering the countDistinct production.
+	EnterCountDistinct(c *CountDistinctContext)
+
+	// EnterFirstLast is called when entering the firstLast production.
+	EnterFirstLast(c *FirstLastContext)
+
+	// EnterTopK is called when entering the topK production.
+	EnterTopK(c *TopKContext)
+
+	// EnterHistogram is called when entering the histogram production.
+	EnterHistogram(c *HistogramContext)
+
+	// EnterFrom is called when entering the from production.
+	EnterFrom(c *FromContext)
+
+	// EnterLabel is called when entering the label production.
+	EnterLabel(c *LabelContext)
+
+	// EnterName is called when entering the name production.
+	EnterName(c *NameContext)
+
 	// EnterGroupBy is called when entering the groupBy production.
 	EnterGroupBy(c *GroupByContext)
 
@@ -79,23 +100,26 @@ type SSQLListener interface {
 	// EnterTimeframe is called when entering the timeframe production.
 	EnterTimeframe(c *TimeframeContext)
 
+	// EnterMoment is called when entering the moment production.
+	EnterMoment(c *MomentContext)
+
 	// EnterKey is called when entering the key production.
 	EnterKey(c *KeyContext)
 
 	// EnterScalar is called when entering the scalar production.
 	EnterScalar(c *ScalarContext)
 
+	// EnterSignedInteger is called when entering the signedInteger production.
+	EnterSignedInteger(c *SignedIntegerContext)
+
 	// EnterList is called when entering the list production.
 	EnterList(c *ListContext)
 
 	// EnterStringList is called when entering the stringList production.
 	EnterStringList(c *StringListContext)
 
-	// EnterDoubleList is called when entering the doubleList production.
-	EnterDoubleList(c *DoubleListContext)
-
-	// EnterIntList is called when entering the intList production.
-	EnterIntList(c *IntListContext)
+	// EnterScalarList is called when entering the scalarList production.
+	EnterScalarList(c *ScalarListContext)
 
 	// EnterOrderBy is called when entering the orderBy production.
 	EnterOrderBy(c *OrderByContext)
@@ -121,6 +145,27 @@ type SSQLListener interface {
 	// ExitPercentile is called when exiting the percentile production.
 	ExitPercentile(c *PercentileContext)
 
+	// ExitCountDistinct is called when exiting the countDistinct production.
+	ExitCountDistinct(c *CountDistinctContext)
+
+	// ExitFirstLast is called when exiting the firstLast production.
+	ExitFirstLast(c *FirstLastContext)
+
+	// ExitTopK is called when exiting the topK production.
+	ExitTopK(c *TopKContext)
+
+	// ExitHistogram is called when exiting the histogram production.
+	ExitHistogram(c *HistogramContext)
+
+	// ExitFrom is called when exiting the from production.
+	ExitFrom(c *FromContext)
+
+	// ExitLabel is called when exiting the label production.
+	ExitLabel(c *LabelContext)
+
+	// ExitName is called when exiting the name production.
+	ExitName(c *NameContext)
+
 	// ExitGroupBy is called when exiting the groupBy production.
 	ExitGroupBy(c *GroupByContext)
 
@@ -178,23 +223,26 @@ type SSQLListener interface {
 	// ExitTimeframe is called when exiting the timeframe production.
 	ExitTimeframe(c *TimeframeContext)
 
+	// ExitMoment is called when exiting the moment production.
+	ExitMoment(c *MomentContext)
+
 	// ExitKey is called when exiting the key production.
 	ExitKey(c *KeyContext)
 
 	// ExitScalar is called when exiting the scalar production.
 	ExitScalar(c *ScalarContext)
 
+	// ExitSignedInteger is called when exiting the signedInteger production.
+	ExitSignedInteger(c *SignedIntegerContext)
+
 	// ExitList is called when exiting the list production.
 	ExitList(c *ListContext)
 
 	// ExitStringList is called when exiting the stringList production.
 	ExitStringList(c *StringListContext)
 
-	// ExitDoubleList is called when exiting the doubleList production.
-	ExitDoubleList(c *DoubleListContext)
-
-	// ExitIntList is called when exiting the intList production.
-	ExitIntList(c *IntListContext)
+	// ExitScalarList is called when exiting the scalarList production.
+	ExitScalarList(c *ScalarListContext)
 
 	// ExitOrderBy is called when exiting the orderBy production.
 	ExitOrderBy(c *OrderByContext)
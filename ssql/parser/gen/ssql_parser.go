@@ -15,168 +15,217 @@ var _ = reflect.Copy
 var _ = strconv.Itoa
 
 var parserATN = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 43, 322,
+	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 3, 56, 420,
 	4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7, 9, 7,
 	4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12, 4, 13,
 	9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4, 18, 9,
 	18, 4, 19, 9, 19, 4, 20, 9, 20, 4, 21, 9, 21, 4, 22, 9, 22, 4, 23, 9, 23,
 	4, 24, 9, 24, 4, 25, 9, 25, 4, 26, 9, 26, 4, 27, 9, 27, 4, 28, 9, 28, 4,
 	29, 9, 29, 4, 30, 9, 30, 4, 31, 9, 31, 4, 32, 9, 32, 4, 33, 9, 33, 4, 34,
-	9, 34, 3, 2, 3, 2, 3, 2, 3, 2, 3, 2, 5, 2, 74, 10, 2, 3, 2, 5, 2, 77, 10,
-	2, 3, 2, 3, 2, 3, 3, 3, 3, 3, 3, 7, 3, 84, 10, 3, 12, 3, 14, 3, 87, 11,
-	3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 7, 3, 94, 10, 3, 12, 3, 14, 3, 97, 11,
-	3, 3, 3, 3, 3, 3, 3, 7, 3, 102, 10, 3, 12, 3, 14, 3, 105, 11, 3, 5, 3,
-	107, 10, 3, 3, 4, 3, 4, 5, 4, 111, 10, 4, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5,
-	5, 5, 118, 10, 5, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 7, 3, 7,
-	5, 7, 129, 10, 7, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 9, 3, 9,
-	7, 9, 140, 10, 9, 12, 9, 14, 9, 143, 11, 9, 3, 10, 3, 10, 3, 10, 5, 10,
-	148, 10, 10, 3, 11, 3, 11, 5, 11, 152, 10, 11, 3, 11, 3, 11, 3, 11, 6,
-	11, 157, 10, 11, 13, 11, 14, 11, 158, 5, 11, 161, 10, 11, 3, 11, 3, 11,
-	3, 12, 3, 12, 6, 12, 167, 10, 12, 13, 12, 14, 12, 168, 3, 12, 3, 12, 3,
-	13, 3, 13, 6, 13, 175, 10, 13, 13, 13, 14, 13, 176, 3, 13, 3, 13, 3, 14,
-	3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3,
-	14, 5, 14, 193, 10, 14, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 16, 3, 16,
-	3, 16, 3, 16, 3, 16, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 18, 3, 18, 3,
-	18, 3, 18, 3, 18, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 20, 3, 20, 3, 20,
-	3, 20, 3, 20, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 22, 3, 22, 3, 22, 3,
-	22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22, 5, 22, 242,
-	10, 22, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 24, 3, 24, 3, 24, 5, 24,
-	252, 10, 24, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 26, 3,
-	26, 3, 26, 3, 26, 3, 26, 3, 27, 3, 27, 6, 27, 268, 10, 27, 13, 27, 14,
-	27, 269, 5, 27, 272, 10, 27, 3, 28, 3, 28, 3, 28, 5, 28, 277, 10, 28, 3,
-	29, 3, 29, 3, 29, 7, 29, 282, 10, 29, 12, 29, 14, 29, 285, 11, 29, 3, 30,
-	3, 30, 3, 30, 7, 30, 290, 10, 30, 12, 30, 14, 30, 293, 11, 30, 3, 31, 3,
-	31, 3, 31, 7, 31, 298, 10, 31, 12, 31, 14, 31, 301, 11, 31, 3, 32, 3, 32,
-	3, 32, 3, 32, 7, 32, 307, 10, 32, 12, 32, 14, 32, 310, 11, 32, 3, 33, 3,
-	33, 5, 33, 314, 10, 33, 3, 34, 3, 34, 6, 34, 318, 10, 34, 13, 34, 14, 34,
-	319, 3, 34, 2, 2, 35, 2, 4, 6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28,
-	30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64,
-	66, 2, 5, 3, 2, 11, 15, 3, 2, 39, 40, 3, 2, 35, 36, 2, 329, 2, 68, 3, 2,
-	2, 2, 4, 106, 3, 2, 2, 2, 6, 110, 3, 2, 2, 2, 8, 117, 3, 2, 2, 2, 10, 119,
-	3, 2, 2, 2, 12, 128, 3, 2, 2, 2, 14, 130, 3, 2, 2, 2, 16, 137, 3, 2, 2,
-	2, 18, 147, 3, 2, 2, 2, 20, 149, 3, 2, 2, 2, 22, 164, 3, 2, 2, 2, 24, 172,
-	3, 2, 2, 2, 26, 192, 3, 2, 2, 2, 28, 194, 3, 2, 2, 2, 30, 199, 3, 2, 2,
-	2, 32, 204, 3, 2, 2, 2, 34, 209, 3, 2, 2, 2, 36, 214, 3, 2, 2, 2, 38, 219,
-	3, 2, 2, 2, 40, 224, 3, 2, 2, 2, 42, 241, 3, 2, 2, 2, 44, 243, 3, 2, 2,
-	2, 46, 248, 3, 2, 2, 2, 48, 253, 3, 2, 2, 2, 50, 260, 3, 2, 2, 2, 52, 271,
-	3, 2, 2, 2, 54, 276, 3, 2, 2, 2, 56, 278, 3, 2, 2, 2, 58, 286, 3, 2, 2,
-	2, 60, 294, 3, 2, 2, 2, 62, 302, 3, 2, 2, 2, 64, 311, 3, 2, 2, 2, 66, 315,
-	3, 2, 2, 2, 68, 69, 7, 30, 2, 2, 69, 70, 5, 4, 3, 2, 70, 71, 7, 31, 2,
-	2, 71, 73, 5, 16, 9, 2, 72, 74, 5, 62, 32, 2, 73, 72, 3, 2, 2, 2, 73, 74,
-	3, 2, 2, 2, 74, 76, 3, 2, 2, 2, 75, 77, 5, 66, 34, 2, 76, 75, 3, 2, 2,
-	2, 76, 77, 3, 2, 2, 2, 77, 78, 3, 2, 2, 2, 78, 79, 7, 2, 2, 3, 79, 3, 3,
-	2, 2, 2, 80, 85, 5, 6, 4, 2, 81, 82, 7, 3, 2, 2, 82, 84, 5, 6, 4, 2, 83,
-	81, 3, 2, 2, 2, 84, 87, 3, 2, 2, 2, 85, 83, 3, 2, 2, 2, 85, 86, 3, 2, 2,
-	2, 86, 107, 3, 2, 2, 2, 87, 85, 3, 2, 2, 2, 88, 89, 7, 33, 2, 2, 89, 90,
-	7, 4, 2, 2, 90, 95, 5, 12, 7, 2, 91, 92, 7, 3, 2, 2, 92, 94, 5, 12, 7,
-	2, 93, 91, 3, 2, 2, 2, 94, 97, 3, 2, 2, 2, 95, 93, 3, 2, 2, 2, 95, 96,
-	3, 2, 2, 2, 96, 98, 3, 2, 2, 2, 97, 95, 3, 2, 2, 2, 98, 103, 7, 5, 2, 2,
-	99, 100, 7, 3, 2, 2, 100, 102, 5, 8, 5, 2, 101, 99, 3, 2, 2, 2, 102, 105,
-	3, 2, 2, 2, 103, 101, 3, 2, 2, 2, 103, 104, 3, 2, 2, 2, 104, 107, 3, 2,
-	2, 2, 105, 103, 3, 2, 2, 2, 106, 80, 3, 2, 2, 2, 106, 88, 3, 2, 2, 2, 107,
-	5, 3, 2, 2, 2, 108, 111, 7, 42, 2, 2, 109, 111, 5, 8, 5, 2, 110, 108, 3,
-	2, 2, 2, 110, 109, 3, 2, 2, 2, 111, 7, 3, 2, 2, 2, 112, 113, 9, 2, 2, 2,
-	113, 114, 7, 4, 2, 2, 114, 115, 7, 42, 2, 2, 115, 118, 7, 5, 2, 2, 116,
-	118, 5, 10, 6, 2, 117, 112, 3, 2, 2, 2, 117, 116, 3, 2, 2, 2, 118, 9, 3,
-	2, 2, 2, 119, 120, 7, 16, 2, 2, 120, 121, 7, 4, 2, 2, 121, 122, 7, 42,
-	2, 2, 122, 123, 7, 3, 2, 2, 123, 124, 7, 41, 2, 2, 124, 125, 7, 5, 2, 2,
-	125, 11, 3, 2, 2, 2, 126, 129, 7, 42, 2, 2, 127, 129, 5, 14, 8, 2, 128,
-	126, 3, 2, 2, 2, 128, 127, 3, 2, 2, 2, 129, 13, 3, 2, 2, 2, 130, 131, 7,
-	17, 2, 2, 131, 132, 7, 4, 2, 2, 132, 133, 7, 42, 2, 2, 133, 134, 7, 3,
-	2, 2, 134, 135, 7, 40, 2, 2, 135, 136, 7, 5, 2, 2, 136, 15, 3, 2, 2, 2,
-	137, 141, 5, 18, 10, 2, 138, 140, 5, 18, 10, 2, 139, 138, 3, 2, 2, 2, 140,
-	143, 3, 2, 2, 2, 141, 139, 3, 2, 2, 2, 141, 142, 3, 2, 2, 2, 142, 17, 3,
-	2, 2, 2, 143, 141, 3, 2, 2, 2, 144, 148, 5, 20, 11, 2, 145, 148, 5, 22,
-	12, 2, 146, 148, 5, 24, 13, 2, 147, 144, 3, 2, 2, 2, 147, 145, 3, 2, 2,
-	2, 147, 146, 3, 2, 2, 2, 148, 19, 3, 2, 2, 2, 149, 151, 7, 6, 2, 2, 150,
-	152, 7, 42, 2, 2, 151, 150, 3, 2, 2, 2, 151, 152, 3, 2, 2, 2, 152, 153,
-	3, 2, 2, 2, 153, 160, 7, 38, 2, 2, 154, 161, 5, 26, 14, 2, 155, 157, 5,
-	20, 11, 2, 156, 155, 3, 2, 2, 2, 157, 158, 3, 2, 2, 2, 158, 156, 3, 2,
-	2, 2, 158, 159, 3, 2, 2, 2, 159, 161, 3, 2, 2, 2, 160, 154, 3, 2, 2, 2,
-	160, 156, 3, 2, 2, 2, 160, 161, 3, 2, 2, 2, 161, 162, 3, 2, 2, 2, 162,
-	163, 7, 7, 2, 2, 163, 21, 3, 2, 2, 2, 164, 166, 7, 8, 2, 2, 165, 167, 5,
-	18, 10, 2, 166, 165, 3, 2, 2, 2, 167, 168, 3, 2, 2, 2, 168, 166, 3, 2,
-	2, 2, 168, 169, 3, 2, 2, 2, 169, 170, 3, 2, 2, 2, 170, 171, 7, 9, 2, 2,
-	171, 23, 3, 2, 2, 2, 172, 174, 7, 10, 2, 2, 173, 175, 5, 18, 10, 2, 174,
-	173, 3, 2, 2, 2, 175, 176, 3, 2, 2, 2, 176, 174, 3, 2, 2, 2, 176, 177,
-	3, 2, 2, 2, 177, 178, 3, 2, 2, 2, 178, 179, 7, 9, 2, 2, 179, 25, 3, 2,
-	2, 2, 180, 193, 5, 28, 15, 2, 181, 193, 5, 30, 16, 2, 182, 193, 5, 32,
-	17, 2, 183, 193, 5, 34, 18, 2, 184, 193, 5, 36, 19, 2, 185, 193, 5, 38,
-	20, 2, 186, 193, 5, 40, 21, 2, 187, 193, 5, 42, 22, 2, 188, 193, 5, 44,
-	23, 2, 189, 193, 5, 46, 24, 2, 190, 193, 5, 48, 25, 2, 191, 193, 5, 50,
-	26, 2, 192, 180, 3, 2, 2, 2, 192, 181, 3, 2, 2, 2, 192, 182, 3, 2, 2, 2,
-	192, 183, 3, 2, 2, 2, 192, 184, 3, 2, 2, 2, 192, 185, 3, 2, 2, 2, 192,
-	186, 3, 2, 2, 2, 192, 187, 3, 2, 2, 2, 192, 188, 3, 2, 2, 2, 192, 189,
-	3, 2, 2, 2, 192, 190, 3, 2, 2, 2, 192, 191, 3, 2, 2, 2, 193, 27, 3, 2,
-	2, 2, 194, 195, 7, 18, 2, 2, 195, 196, 7, 4, 2, 2, 196, 197, 5, 52, 27,
-	2, 197, 198, 7, 5, 2, 2, 198, 29, 3, 2, 2, 2, 199, 200, 7, 19, 2, 2, 200,
-	201, 7, 4, 2, 2, 201, 202, 5, 52, 27, 2, 202, 203, 7, 5, 2, 2, 203, 31,
-	3, 2, 2, 2, 204, 205, 7, 24, 2, 2, 205, 206, 7, 4, 2, 2, 206, 207, 5, 52,
-	27, 2, 207, 208, 7, 5, 2, 2, 208, 33, 3, 2, 2, 2, 209, 210, 7, 23, 2, 2,
-	210, 211, 7, 4, 2, 2, 211, 212, 5, 52, 27, 2, 212, 213, 7, 5, 2, 2, 213,
-	35, 3, 2, 2, 2, 214, 215, 7, 21, 2, 2, 215, 216, 7, 4, 2, 2, 216, 217,
-	5, 52, 27, 2, 217, 218, 7, 5, 2, 2, 218, 37, 3, 2, 2, 2, 219, 220, 7, 22,
-	2, 2, 220, 221, 7, 4, 2, 2, 221, 222, 5, 52, 27, 2, 222, 223, 7, 5, 2,
-	2, 223, 39, 3, 2, 2, 2, 224, 225, 7, 20, 2, 2, 225, 226, 7, 4, 2, 2, 226,
-	227, 5, 54, 28, 2, 227, 228, 7, 5, 2, 2, 228, 41, 3, 2, 2, 2, 229, 230,
-	7, 25, 2, 2, 230, 231, 7, 4, 2, 2, 231, 232, 7, 40, 2, 2, 232, 233, 7,
-	3, 2, 2, 233, 234, 7, 40, 2, 2, 234, 242, 7, 5, 2, 2, 235, 236, 7, 25,
-	2, 2, 236, 237, 7, 4, 2, 2, 237, 238, 7, 41, 2, 2, 238, 239, 7, 3, 2, 2,
-	239, 240, 7, 41, 2, 2, 240, 242, 7, 5, 2, 2, 241, 229, 3, 2, 2, 2, 241,
-	235, 3, 2, 2, 2, 242, 43, 3, 2, 2, 2, 243, 244, 7, 26, 2, 2, 244, 245,
-	7, 4, 2, 2, 245, 246, 7, 39, 2, 2, 246, 247, 7, 5, 2, 2, 247, 45, 3, 2,
-	2, 2, 248, 251, 7, 27, 2, 2, 249, 250, 7, 4, 2, 2, 250, 252, 7, 5, 2, 2,
-	251, 249, 3, 2, 2, 2, 251, 252, 3, 2, 2, 2, 252, 47, 3, 2, 2, 2, 253, 254,
-	7, 28, 2, 2, 254, 255, 7, 4, 2, 2, 255, 256, 7, 40, 2, 2, 256, 257, 7,
-	3, 2, 2, 257, 258, 7, 40, 2, 2, 258, 259, 7, 5, 2, 2, 259, 49, 3, 2, 2,
-	2, 260, 261, 7, 29, 2, 2, 261, 262, 7, 4, 2, 2, 262, 263, 9, 3, 2, 2, 263,
-	264, 7, 5, 2, 2, 264, 51, 3, 2, 2, 2, 265, 272, 7, 41, 2, 2, 266, 268,
-	7, 40, 2, 2, 267, 266, 3, 2, 2, 2, 268, 269, 3, 2, 2, 2, 269, 267, 3, 2,
-	2, 2, 269, 270, 3, 2, 2, 2, 270, 272, 3, 2, 2, 2, 271, 265, 3, 2, 2, 2,
-	271, 267, 3, 2, 2, 2, 272, 53, 3, 2, 2, 2, 273, 277, 5, 56, 29, 2, 274,
-	277, 5, 58, 30, 2, 275, 277, 5, 60, 31, 2, 276, 273, 3, 2, 2, 2, 276, 274,
-	3, 2, 2, 2, 276, 275, 3, 2, 2, 2, 277, 55, 3, 2, 2, 2, 278, 283, 7, 39,
-	2, 2, 279, 280, 7, 3, 2, 2, 280, 282, 7, 39, 2, 2, 281, 279, 3, 2, 2, 2,
-	282, 285, 3, 2, 2, 2, 283, 281, 3, 2, 2, 2, 283, 284, 3, 2, 2, 2, 284,
-	57, 3, 2, 2, 2, 285, 283, 3, 2, 2, 2, 286, 291, 7, 41, 2, 2, 287, 288,
-	7, 3, 2, 2, 288, 290, 7, 41, 2, 2, 289, 287, 3, 2, 2, 2, 290, 293, 3, 2,
-	2, 2, 291, 289, 3, 2, 2, 2, 291, 292, 3, 2, 2, 2, 292, 59, 3, 2, 2, 2,
-	293, 291, 3, 2, 2, 2, 294, 299, 7, 40, 2, 2, 295, 296, 7, 3, 2, 2, 296,
-	298, 7, 40, 2, 2, 297, 295, 3, 2, 2, 2, 298, 301, 3, 2, 2, 2, 299, 297,
-	3, 2, 2, 2, 299, 300, 3, 2, 2, 2, 300, 61, 3, 2, 2, 2, 301, 299, 3, 2,
-	2, 2, 302, 303, 7, 32, 2, 2, 303, 308, 5, 64, 33, 2, 304, 305, 7, 3, 2,
-	2, 305, 307, 5, 64, 33, 2, 306, 304, 3, 2, 2, 2, 307, 310, 3, 2, 2, 2,
-	308, 306, 3, 2, 2, 2, 308, 309, 3, 2, 2, 2, 309, 63, 3, 2, 2, 2, 310, 308,
-	3, 2, 2, 2, 311, 313, 7, 42, 2, 2, 312, 314, 9, 4, 2, 2, 313, 312, 3, 2,
-	2, 2, 313, 314, 3, 2, 2, 2, 314, 65, 3, 2, 2, 2, 315, 317, 7, 34, 2, 2,
-	316, 318, 7, 40, 2, 2, 317, 316, 3, 2, 2, 2, 318, 319, 3, 2, 2, 2, 319,
-	317, 3, 2, 2, 2, 319, 320, 3, 2, 2, 2, 320, 67, 3, 2, 2, 2, 30, 73, 76,
-	85, 95, 103, 106, 110, 117, 128, 141, 147, 151, 158, 160, 168, 176, 192,
-	241, 251, 269, 271, 276, 283, 291, 299, 308, 313, 319,
+	9, 34, 4, 35, 9, 35, 4, 36, 9, 36, 4, 37, 9, 37, 4, 38, 9, 38, 4, 39, 9,
+	39, 4, 40, 9, 40, 4, 41, 9, 41, 4, 42, 9, 42, 3, 2, 5, 2, 86, 10, 2, 3,
+	2, 3, 2, 3, 2, 5, 2, 91, 10, 2, 3, 2, 3, 2, 3, 2, 5, 2, 96, 10, 2, 3, 2,
+	5, 2, 99, 10, 2, 3, 2, 3, 2, 3, 3, 3, 3, 3, 3, 7, 3, 106, 10, 3, 12, 3,
+	14, 3, 109, 11, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 7, 3, 116, 10, 3, 12,
+	3, 14, 3, 119, 11, 3, 3, 3, 3, 3, 3, 3, 7, 3, 124, 10, 3, 12, 3, 14, 3,
+	127, 11, 3, 5, 3, 129, 10, 3, 3, 4, 3, 4, 5, 4, 133, 10, 4, 3, 5, 3, 5,
+	3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 5, 5, 144, 10, 5, 3, 6, 3, 6,
+	3, 6, 3, 6, 3, 6, 3, 6, 3, 6, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 5, 7, 158,
+	10, 7, 3, 7, 3, 7, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 8, 3, 9, 3, 9,
+	3, 9, 3, 9, 3, 9, 3, 9, 3, 9, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10,
+	3, 10, 3, 11, 3, 11, 3, 11, 3, 11, 7, 11, 187, 10, 11, 12, 11, 14, 11,
+	190, 11, 11, 3, 12, 3, 12, 5, 12, 194, 10, 12, 3, 12, 3, 12, 3, 12, 3,
+	12, 5, 12, 200, 10, 12, 5, 12, 202, 10, 12, 3, 13, 3, 13, 3, 14, 3, 14,
+	5, 14, 208, 10, 14, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3,
+	16, 3, 16, 7, 16, 219, 10, 16, 12, 16, 14, 16, 222, 11, 16, 3, 17, 3, 17,
+	3, 17, 5, 17, 227, 10, 17, 3, 18, 3, 18, 5, 18, 231, 10, 18, 3, 18, 3,
+	18, 3, 18, 6, 18, 236, 10, 18, 13, 18, 14, 18, 237, 5, 18, 240, 10, 18,
+	3, 18, 3, 18, 3, 19, 3, 19, 6, 19, 246, 10, 19, 13, 19, 14, 19, 247, 3,
+	19, 3, 19, 3, 20, 3, 20, 6, 20, 254, 10, 20, 13, 20, 14, 20, 255, 3, 20,
+	3, 20, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3,
+	21, 3, 21, 3, 21, 5, 21, 272, 10, 21, 3, 22, 3, 22, 3, 22, 3, 22, 3, 22,
+	3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 24, 3, 24, 3, 24, 3, 24, 3, 24, 3,
+	25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 27,
+	3, 27, 3, 27, 3, 27, 3, 27, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 29, 3,
+	29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 29, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30,
+	3, 31, 3, 31, 3, 31, 5, 31, 324, 10, 31, 3, 32, 3, 32, 3, 32, 3, 32, 3,
+	32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 5, 32, 338, 10, 32,
+	3, 33, 3, 33, 5, 33, 342, 10, 33, 3, 34, 3, 34, 3, 34, 3, 34, 5, 34, 348,
+	10, 34, 3, 34, 3, 34, 3, 34, 5, 34, 353, 10, 34, 7, 34, 355, 10, 34, 12,
+	34, 14, 34, 358, 11, 34, 3, 34, 3, 34, 3, 35, 5, 35, 363, 10, 35, 3, 35,
+	3, 35, 6, 35, 367, 10, 35, 13, 35, 14, 35, 368, 5, 35, 371, 10, 35, 3,
+	36, 5, 36, 374, 10, 36, 3, 36, 6, 36, 377, 10, 36, 13, 36, 14, 36, 378,
+	3, 37, 3, 37, 5, 37, 383, 10, 37, 3, 38, 3, 38, 3, 38, 7, 38, 388, 10,
+	38, 12, 38, 14, 38, 391, 11, 38, 3, 39, 3, 39, 3, 39, 7, 39, 396, 10, 39,
+	12, 39, 14, 39, 399, 11, 39, 3, 40, 3, 40, 3, 40, 3, 40, 7, 40, 405, 10,
+	40, 12, 40, 14, 40, 408, 11, 40, 3, 41, 3, 41, 5, 41, 412, 10, 41, 3, 42,
+	3, 42, 6, 42, 416, 10, 42, 13, 42, 14, 42, 417, 3, 42, 2, 2, 43, 2, 4,
+	6, 8, 10, 12, 14, 16, 18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42,
+	44, 46, 48, 50, 52, 54, 56, 58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78,
+	80, 82, 2, 7, 4, 2, 13, 17, 19, 20, 4, 2, 21, 21, 38, 38, 4, 2, 3, 3, 42,
+	42, 4, 2, 13, 41, 44, 49, 3, 2, 47, 48, 2, 436, 2, 85, 3, 2, 2, 2, 4, 128,
+	3, 2, 2, 2, 6, 132, 3, 2, 2, 2, 8, 143, 3, 2, 2, 2, 10, 145, 3, 2, 2, 2,
+	12, 152, 3, 2, 2, 2, 14, 161, 3, 2, 2, 2, 16, 168, 3, 2, 2, 2, 18, 175,
+	3, 2, 2, 2, 20, 182, 3, 2, 2, 2, 22, 193, 3, 2, 2, 2, 24, 203, 3, 2, 2,
+	2, 26, 207, 3, 2, 2, 2, 28, 209, 3, 2, 2, 2, 30, 216, 3, 2, 2, 2, 32, 226,
+	3, 2, 2, 2, 34, 228, 3, 2, 2, 2, 36, 243, 3, 2, 2, 2, 38, 251, 3, 2, 2,
+	2, 40, 271, 3, 2, 2, 2, 42, 273, 3, 2, 2, 2, 44, 278, 3, 2, 2, 2, 46, 283,
+	3, 2, 2, 2, 48, 288, 3, 2, 2, 2, 50, 293, 3, 2, 2, 2, 52, 298, 3, 2, 2,
+	2, 54, 303, 3, 2, 2, 2, 56, 308, 3, 2, 2, 2, 58, 315, 3, 2, 2, 2, 60, 320,
+	3, 2, 2, 2, 62, 337, 3, 2, 2, 2, 64, 341, 3, 2, 2, 2, 66, 343, 3, 2, 2,
+	2, 68, 362, 3, 2, 2, 2, 70, 373, 3, 2, 2, 2, 72, 382, 3, 2, 2, 2, 74, 384,
+	3, 2, 2, 2, 76, 392, 3, 2, 2, 2, 78, 400, 3, 2, 2, 2, 80, 409, 3, 2, 2,
+	2, 82, 413, 3, 2, 2, 2, 84, 86, 7, 39, 2, 2, 85, 84, 3, 2, 2, 2, 85, 86,
+	3, 2, 2, 2, 86, 87, 3, 2, 2, 2, 87, 88, 7, 40, 2, 2, 88, 90, 5, 4, 3, 2,
+	89, 91, 5, 20, 11, 2, 90, 89, 3, 2, 2, 2, 90, 91, 3, 2, 2, 2, 91, 92, 3,
+	2, 2, 2, 92, 93, 7, 43, 2, 2, 93, 95, 5, 30, 16, 2, 94, 96, 5, 78, 40,
+	2, 95, 94, 3, 2, 2, 2, 95, 96, 3, 2, 2, 2, 96, 98, 3, 2, 2, 2, 97, 99,
+	5, 82, 42, 2, 98, 97, 3, 2, 2, 2, 98, 99, 3, 2, 2, 2, 99, 100, 3, 2, 2,
+	2, 100, 101, 7, 2, 2, 3, 101, 3, 3, 2, 2, 2, 102, 107, 5, 6, 4, 2, 103,
+	104, 7, 3, 2, 2, 104, 106, 5, 6, 4, 2, 105, 103, 3, 2, 2, 2, 106, 109,
+	3, 2, 2, 2, 107, 105, 3, 2, 2, 2, 107, 108, 3, 2, 2, 2, 108, 129, 3, 2,
+	2, 2, 109, 107, 3, 2, 2, 2, 110, 111, 7, 45, 2, 2, 111, 112, 7, 4, 2, 2,
+	112, 117, 5, 26, 14, 2, 113, 114, 7, 3, 2, 2, 114, 116, 5, 26, 14, 2, 115,
+	113, 3, 2, 2, 2, 116, 119, 3, 2, 2, 2, 117, 115, 3, 2, 2, 2, 117, 118,
+	3, 2, 2, 2, 118, 120, 3, 2, 2, 2, 119, 117, 3, 2, 2, 2, 120, 125, 7, 5,
+	2, 2, 121, 122, 7, 3, 2, 2, 122, 124, 5, 8, 5, 2, 123, 121, 3, 2, 2, 2,
+	124, 127, 3, 2, 2, 2, 125, 123, 3, 2, 2, 2, 125, 126, 3, 2, 2, 2, 126,
+	129, 3, 2, 2, 2, 127, 125, 3, 2, 2, 2, 128, 102, 3, 2, 2, 2, 128, 110,
+	3, 2, 2, 2, 129, 5, 3, 2, 2, 2, 130, 133, 7, 55, 2, 2, 131, 133, 5, 8,
+	5, 2, 132, 130, 3, 2, 2, 2, 132, 131, 3, 2, 2, 2, 133, 7, 3, 2, 2, 2, 134,
+	135, 9, 2, 2, 2, 135, 136, 7, 4, 2, 2, 136, 137, 7, 55, 2, 2, 137, 144,
+	7, 5, 2, 2, 138, 144, 5, 10, 6, 2, 139, 144, 5, 12, 7, 2, 140, 144, 5,
+	14, 8, 2, 141, 144, 5, 16, 9, 2, 142, 144, 5, 18, 10, 2, 143, 134, 3, 2,
+	2, 2, 143, 138, 3, 2, 2, 2, 143, 139, 3, 2, 2, 2, 143, 140, 3, 2, 2, 2,
+	143, 141, 3, 2, 2, 2, 143, 142, 3, 2, 2, 2, 144, 9, 3, 2, 2, 2, 145, 146,
+	7, 24, 2, 2, 146, 147, 7, 4, 2, 2, 147, 148, 7, 55, 2, 2, 148, 149, 7,
+	3, 2, 2, 149, 150, 7, 54, 2, 2, 150, 151, 7, 5, 2, 2, 151, 11, 3, 2, 2,
+	2, 152, 153, 7, 18, 2, 2, 153, 154, 7, 4, 2, 2, 154, 157, 7, 55, 2, 2,
+	155, 156, 7, 3, 2, 2, 156, 158, 7, 52, 2, 2, 157, 155, 3, 2, 2, 2, 157,
+	158, 3, 2, 2, 2, 158, 159, 3, 2, 2, 2, 159, 160, 7, 5, 2, 2, 160, 13, 3,
+	2, 2, 2, 161, 162, 9, 3, 2, 2, 162, 163, 7, 4, 2, 2, 163, 164, 7, 55, 2,
+	2, 164, 165, 7, 3, 2, 2, 165, 166, 7, 55, 2, 2, 166, 167, 7, 5, 2, 2, 167,
+	15, 3, 2, 2, 2, 168, 169, 7, 22, 2, 2, 169, 170, 7, 4, 2, 2, 170, 171,
+	7, 55, 2, 2, 171, 172, 7, 3, 2, 2, 172, 173, 7, 52, 2, 2, 173, 174, 7,
+	5, 2, 2, 174, 17, 3, 2, 2, 2, 175, 176, 7, 23, 2, 2, 176, 177, 7, 4, 2,
+	2, 177, 178, 7, 55, 2, 2, 178, 179, 7, 3, 2, 2, 179, 180, 7, 52, 2, 2,
+	180, 181, 7, 5, 2, 2, 181, 19, 3, 2, 2, 2, 182, 183, 7, 41, 2, 2, 183,
+	188, 5, 22, 12, 2, 184, 185, 9, 4, 2, 2, 185, 187, 5, 22, 12, 2, 186, 184,
+	3, 2, 2, 2, 187, 190, 3, 2, 2, 2, 188, 186, 3, 2, 2, 2, 188, 189, 3, 2,
+	2, 2, 189, 21, 3, 2, 2, 2, 190, 188, 3, 2, 2, 2, 191, 194, 5, 24, 13, 2,
+	192, 194, 7, 51, 2, 2, 193, 191, 3, 2, 2, 2, 193, 192, 3, 2, 2, 2, 194,
+	201, 3, 2, 2, 2, 195, 199, 7, 6, 2, 2, 196, 200, 5, 24, 13, 2, 197, 200,
+	7, 51, 2, 2, 198, 200, 7, 52, 2, 2, 199, 196, 3, 2, 2, 2, 199, 197, 3,
+	2, 2, 2, 199, 198, 3, 2, 2, 2, 200, 202, 3, 2, 2, 2, 201, 195, 3, 2, 2,
+	2, 201, 202, 3, 2, 2, 2, 202, 23, 3, 2, 2, 2, 203, 204, 9, 5, 2, 2, 204,
+	25, 3, 2, 2, 2, 205, 208, 7, 55, 2, 2, 206, 208, 5, 28, 15, 2, 207, 205,
+	3, 2, 2, 2, 207, 206, 3, 2, 2, 2, 208, 27, 3, 2, 2, 2, 209, 210, 7, 25,
+	2, 2, 210, 211, 7, 4, 2, 2, 211, 212, 7, 55, 2, 2, 212, 213, 7, 3, 2, 2,
+	213, 214, 7, 52, 2, 2, 214, 215, 7, 5, 2, 2, 215, 29, 3, 2, 2, 2, 216,
+	220, 5, 32, 17, 2, 217, 219, 5, 32, 17, 2, 218, 217, 3, 2, 2, 2, 219, 222,
+	3, 2, 2, 2, 220, 218, 3, 2, 2, 2, 220, 221, 3, 2, 2, 2, 221, 31, 3, 2,
+	2, 2, 222, 220, 3, 2, 2, 2, 223, 227, 5, 34, 18, 2, 224, 227, 5, 36, 19,
+	2, 225, 227, 5, 38, 20, 2, 226, 223, 3, 2, 2, 2, 226, 224, 3, 2, 2, 2,
+	226, 225, 3, 2, 2, 2, 227, 33, 3, 2, 2, 2, 228, 230, 7, 7, 2, 2, 229, 231,
+	7, 55, 2, 2, 230, 229, 3, 2, 2, 2, 230, 231, 3, 2, 2, 2, 231, 232, 3, 2,
+	2, 2, 232, 239, 7, 50, 2, 2, 233, 240, 5, 40, 21, 2, 234, 236, 5, 34, 18,
+	2, 235, 234, 3, 2, 2, 2, 236, 237, 3, 2, 2, 2, 237, 235, 3, 2, 2, 2, 237,
+	238, 3, 2, 2, 2, 238, 240, 3, 2, 2, 2, 239, 233, 3, 2, 2, 2, 239, 235,
+	3, 2, 2, 2, 239, 240, 3, 2, 2, 2, 240, 241, 3, 2, 2, 2, 241, 242, 7, 8,
+	2, 2, 242, 35, 3, 2, 2, 2, 243, 245, 7, 9, 2, 2, 244, 246, 5, 32, 17, 2,
+	245, 244, 3, 2, 2, 2, 246, 247, 3, 2, 2, 2, 247, 245, 3, 2, 2, 2, 247,
+	248, 3, 2, 2, 2, 248, 249, 3, 2, 2, 2, 249, 250, 7, 10, 2, 2, 250, 37,
+	3, 2, 2, 2, 251, 253, 7, 11, 2, 2, 252, 254, 5, 32, 17, 2, 253, 252, 3,
+	2, 2, 2, 254, 255, 3, 2, 2, 2, 255, 253, 3, 2, 2, 2, 255, 256, 3, 2, 2,
+	2, 256, 257, 3, 2, 2, 2, 257, 258, 7, 10, 2, 2, 258, 39, 3, 2, 2, 2, 259,
+	272, 5, 42, 22, 2, 260, 272, 5, 44, 23, 2, 261, 272, 5, 46, 24, 2, 262,
+	272, 5, 48, 25, 2, 263, 272, 5, 50, 26, 2, 264, 272, 5, 52, 27, 2, 265,
+	272, 5, 54, 28, 2, 266, 272, 5, 56, 29, 2, 267, 272, 5, 58, 30, 2, 268,
+	272, 5, 60, 31, 2, 269, 272, 5, 62, 32, 2, 270, 272, 5, 66, 34, 2, 271,
+	259, 3, 2, 2, 2, 271, 260, 3, 2, 2, 2, 271, 261, 3, 2, 2, 2, 271, 262,
+	3, 2, 2, 2, 271, 263, 3, 2, 2, 2, 271, 264, 3, 2, 2, 2, 271, 265, 3, 2,
+	2, 2, 271, 266, 3, 2, 2, 2, 271, 267, 3, 2, 2, 2, 271, 268, 3, 2, 2, 2,
+	271, 269, 3, 2, 2, 2, 271, 270, 3, 2, 2, 2, 272, 41, 3, 2, 2, 2, 273, 274,
+	7, 26, 2, 2, 274, 275, 7, 4, 2, 2, 275, 276, 5, 68, 35, 2, 276, 277, 7,
+	5, 2, 2, 277, 43, 3, 2, 2, 2, 278, 279, 7, 27, 2, 2, 279, 280, 7, 4, 2,
+	2, 280, 281, 5, 68, 35, 2, 281, 282, 7, 5, 2, 2, 282, 45, 3, 2, 2, 2, 283,
+	284, 7, 32, 2, 2, 284, 285, 7, 4, 2, 2, 285, 286, 5, 68, 35, 2, 286, 287,
+	7, 5, 2, 2, 287, 47, 3, 2, 2, 2, 288, 289, 7, 31, 2, 2, 289, 290, 7, 4,
+	2, 2, 290, 291, 5, 68, 35, 2, 291, 292, 7, 5, 2, 2, 292, 49, 3, 2, 2, 2,
+	293, 294, 7, 29, 2, 2, 294, 295, 7, 4, 2, 2, 295, 296, 5, 68, 35, 2, 296,
+	297, 7, 5, 2, 2, 297, 51, 3, 2, 2, 2, 298, 299, 7, 30, 2, 2, 299, 300,
+	7, 4, 2, 2, 300, 301, 5, 68, 35, 2, 301, 302, 7, 5, 2, 2, 302, 53, 3, 2,
+	2, 2, 303, 304, 7, 28, 2, 2, 304, 305, 7, 4, 2, 2, 305, 306, 5, 72, 37,
+	2, 306, 307, 7, 5, 2, 2, 307, 55, 3, 2, 2, 2, 308, 309, 7, 33, 2, 2, 309,
+	310, 7, 4, 2, 2, 310, 311, 5, 68, 35, 2, 311, 312, 7, 3, 2, 2, 312, 313,
+	5, 68, 35, 2, 313, 314, 7, 5, 2, 2, 314, 57, 3, 2, 2, 2, 315, 316, 7, 34,
+	2, 2, 316, 317, 7, 4, 2, 2, 317, 318, 7, 51, 2, 2, 318, 319, 7, 5, 2, 2,
+	319, 59, 3, 2, 2, 2, 320, 323, 7, 35, 2, 2, 321, 322, 7, 4, 2, 2, 322,
+	324, 7, 5, 2, 2, 323, 321, 3, 2, 2, 2, 323, 324, 3, 2, 2, 2, 324, 61, 3,
+	2, 2, 2, 325, 326, 7, 36, 2, 2, 326, 327, 7, 4, 2, 2, 327, 328, 5, 64,
+	33, 2, 328, 329, 7, 3, 2, 2, 329, 330, 5, 64, 33, 2, 330, 331, 7, 5, 2,
+	2, 331, 338, 3, 2, 2, 2, 332, 333, 7, 36, 2, 2, 333, 334, 7, 4, 2, 2, 334,
+	335, 7, 38, 2, 2, 335, 336, 7, 53, 2, 2, 336, 338, 7, 5, 2, 2, 337, 325,
+	3, 2, 2, 2, 337, 332, 3, 2, 2, 2, 338, 63, 3, 2, 2, 2, 339, 342, 5, 70,
+	36, 2, 340, 342, 7, 51, 2, 2, 341, 339, 3, 2, 2, 2, 341, 340, 3, 2, 2,
+	2, 342, 65, 3, 2, 2, 2, 343, 344, 7, 37, 2, 2, 344, 347, 7, 4, 2, 2, 345,
+	348, 5, 70, 36, 2, 346, 348, 7, 51, 2, 2, 347, 345, 3, 2, 2, 2, 347, 346,
+	3, 2, 2, 2, 348, 356, 3, 2, 2, 2, 349, 352, 7, 3, 2, 2, 350, 353, 5, 70,
+	36, 2, 351, 353, 7, 51, 2, 2, 352, 350, 3, 2, 2, 2, 352, 351, 3, 2, 2,
+	2, 353, 355, 3, 2, 2, 2, 354, 349, 3, 2, 2, 2, 355, 358, 3, 2, 2, 2, 356,
+	354, 3, 2, 2, 2, 356, 357, 3, 2, 2, 2, 357, 359, 3, 2, 2, 2, 358, 356,
+	3, 2, 2, 2, 359, 360, 7, 5, 2, 2, 360, 67, 3, 2, 2, 2, 361, 363, 7, 12,
+	2, 2, 362, 361, 3, 2, 2, 2, 362, 363, 3, 2, 2, 2, 363, 370, 3, 2, 2, 2,
+	364, 371, 7, 54, 2, 2, 365, 367, 7, 52, 2, 2, 366, 365, 3, 2, 2, 2, 367,
+	368, 3, 2, 2, 2, 368, 366, 3, 2, 2, 2, 368, 369, 3, 2, 2, 2, 369, 371,
+	3, 2, 2, 2, 370, 364, 3, 2, 2, 2, 370, 366, 3, 2, 2, 2, 371, 69, 3, 2,
+	2, 2, 372, 374, 7, 12, 2, 2, 373, 372, 3, 2, 2, 2, 373, 374, 3, 2, 2, 2,
+	374, 376, 3, 2, 2, 2, 375, 377, 7, 52, 2, 2, 376, 375, 3, 2, 2, 2, 377,
+	378, 3, 2, 2, 2, 378, 376, 3, 2, 2, 2, 378, 379, 3, 2, 2, 2, 379, 71, 3,
+	2, 2, 2, 380, 383, 5, 74, 38, 2, 381, 383, 5, 76, 39, 2, 382, 380, 3, 2,
+	2, 2, 382, 381, 3, 2, 2, 2, 383, 73, 3, 2, 2, 2, 384, 389, 7, 51, 2, 2,
+	385, 386, 7, 3, 2, 2, 386, 388, 7, 51, 2, 2, 387, 385, 3, 2, 2, 2, 388,
+	391, 3, 2, 2, 2, 389, 387, 3, 2, 2, 2, 389, 390, 3, 2, 2, 2, 390, 75, 3,
+	2, 2, 2, 391, 389, 3, 2, 2, 2, 392, 397, 5, 68, 35, 2, 393, 394, 7, 3,
+	2, 2, 394, 396, 5, 68, 35, 2, 395, 393, 3, 2, 2, 2, 396, 399, 3, 2, 2,
+	2, 397, 395, 3, 2, 2, 2, 397, 398, 3, 2, 2, 2, 398, 77, 3, 2, 2, 2, 399,
+	397, 3, 2, 2, 2, 400, 401, 7, 44, 2, 2, 401, 406, 5, 80, 41, 2, 402, 403,
+	7, 3, 2, 2, 403, 405, 5, 80, 41, 2, 404, 402, 3, 2, 2, 2, 405, 408, 3,
+	2, 2, 2, 406, 404, 3, 2, 2, 2, 406, 407, 3, 2, 2, 2, 407, 79, 3, 2, 2,
+	2, 408, 406, 3, 2, 2, 2, 409, 411, 7, 55, 2, 2, 410, 412, 9, 6, 2, 2, 411,
+	410, 3, 2, 2, 2, 411, 412, 3, 2, 2, 2, 412, 81, 3, 2, 2, 2, 413, 415, 7,
+	46, 2, 2, 414, 416, 7, 52, 2, 2, 415, 414, 3, 2, 2, 2, 416, 417, 3, 2,
+	2, 2, 417, 415, 3, 2, 2, 2, 417, 418, 3, 2, 2, 2, 418, 83, 3, 2, 2, 2,
+	43, 85, 90, 95, 98, 107, 117, 125, 128, 132, 143, 157, 188, 193, 199, 201,
+	207, 220, 226, 230, 237, 239, 247, 255, 271, 323, 337, 341, 347, 352, 356,
+	362, 368, 370, 373, 378, 382, 389, 397, 406, 411, 417,
 }
 var deserializer = antlr.NewATNDeserializer(nil)
 var deserializedATN = deserializer.DeserializeFromUInt16(parserATN)
 
 var literalNames = []string{
-	"", "','", "'('", "')'", "'['", "']'", "'{'", "'}'", "'{&'", "'AVG'", "'MAX'",
-	"'MIN'", "'SUM'", "'COUNT'", "'PCTL'", "'PART'", "'EQ'", "'NEQ'", "'IN'",
-	"'LT'", "'LE'", "'GE'", "'GT'", "'BETWEEN'", "'CONTAIN'", "'EXIST'", "'TIMEFRAME'",
-	"'KEY'", "'FIND'", "'WHERE'", "'ORDER-BY'", "'GROUP-BY'", "'LIMIT'", "'ASC'",
-	"'DESC'",
+	"", "','", "'('", "')'", "'='", "'['", "']'", "'{'", "'}'", "'{&'", "'-'",
+	"'AVG'", "'MAX'", "'MIN'", "'SUM'", "'COUNT'", "'COUNT-DISTINCT'", "'STDDEV'",
+	"'VARIANCE'", "'FIRST'", "'TOPK'", "'HISTOGRAM'", "'PCTL'", "'PART'", "'EQ'",
+	"'NEQ'", "'IN'", "'LT'", "'LE'", "'GE'", "'GT'", "'BETWEEN'", "'CONTAIN'",
+	"'EXIST'", "'TIMEFRAME'", "'KEY'", "'LAST'", "'EXPLAIN'", "'FIND'", "'FROM'",
+	"'OR'", "'WHERE'", "'ORDER-BY'", "'GROUP-BY'", "'LIMIT'", "'ASC'", "'DESC'",
 }
 var symbolicNames = []string{
-	"", "", "", "", "", "", "", "", "", "AVG", "MAX", "MIN", "SUM", "COUNT",
+	"", "", "", "", "", "", "", "", "", "", "", "AVG", "MAX", "MIN", "SUM",
+	"COUNT", "COUNT_DISTINCT", "STDDEV", "VARIANCE", "FIRST", "TOPK", "HISTOGRAM",
 	"PERCENTILE", "PARTITION", "EQ", "NEQ", "IN", "LT", "LE", "GE", "GT", "BETWEEN",
-	"CONTAIN", "EXIST", "TIMEFRAME", "KEY", "FIND", "WHERE", "ORDER_BY", "GROUP_BY",
-	"LIMIT", "ASC", "DESC", "NAME", "PATH", "STRING", "INTEGER", "REAL_NUMBER",
-	"IDENTIFIER", "WS",
+	"CONTAIN", "EXIST", "TIMEFRAME", "KEY", "LAST", "EXPLAIN", "FIND", "FROM",
+	"OR", "WHERE", "ORDER_BY", "GROUP_BY", "LIMIT", "ASC", "DESC", "NAME",
+	"PATH", "STRING", "INTEGER", "DURATION", "REAL_NUMBER", "IDENTIFIER", "WS",
 }
 
 var ruleNames = []string{
-	"start", "selection", "attribute", "aggregate", "percentile", "groupBy",
-	"partition", "expression", "tuple", "vector", "or", "and", "predicate",
-	"eq", "neq", "gt", "ge", "lt", "le", "in", "between", "contain", "exist",
-	"timeframe", "key", "scalar", "list", "stringList", "doubleList", "intList",
+	"start", "selection", "attribute", "aggregate", "percentile", "countDistinct",
+	"firstLast", "topK", "histogram", "from", "label", "name", "groupBy", "partition",
+	"expression", "tuple", "vector", "or", "and", "predicate", "eq", "neq",
+	"gt", "ge", "lt", "le", "in", "between", "contain", "exist", "timeframe",
+	"moment", "key", "scalar", "signedInteger", "list", "stringList", "scalarList",
 	"orderBy", "order", "limit",
 }
 var decisionToDFA = make([]*antlr.DFA, len(deserializedATN.DecisionToState))
@@ -207,85 +256,106 @@ func NewSSQLParser(input antlr.TokenStream) *SSQLParser {
 
 // SSQLParser tokens.
 const (
-	SSQLParserEOF         = antlr.TokenEOF
-	SSQLParserT__0        = 1
-	SSQLParserT__1        = 2
-	SSQLParserT__2        = 3
-	SSQLParserT__3        = 4
-	SSQLParserT__4        = 5
-	SSQLParserT__5        = 6
-	SSQLParserT__6        = 7
-	SSQLParserT__7        = 8
-	SSQLParserAVG         = 9
-	SSQLParserMAX         = 10
-	SSQLParserMIN         = 11
-	SSQLParserSUM         = 12
-	SSQLParserCOUNT       = 13
-	SSQLParserPERCENTILE  = 14
-	SSQLParserPARTITION   = 15
-	SSQLParserEQ          = 16
-	SSQLParserNEQ         = 17
-	SSQLParserIN          = 18
-	SSQLParserLT          = 19
-	SSQLParserLE          = 20
-	SSQLParserGE          = 21
-	SSQLParserGT          = 22
-	SSQLParserBETWEEN     = 23
-	SSQLParserCONTAIN     = 24
-	SSQLParserEXIST       = 25
-	SSQLParserTIMEFRAME   = 26
-	SSQLParserKEY         = 27
-	SSQLParserFIND        = 28
-	SSQLParserWHERE       = 29
-	SSQLParserORDER_BY    = 30
-	SSQLParserGROUP_BY    = 31
-	SSQLParserLIMIT       = 32
-	SSQLParserASC         = 33
-	SSQLParserDESC        = 34
-	SSQLParserNAME        = 35
-	SSQLParserPATH        = 36
-	SSQLParserSTRING      = 37
-	SSQLParserINTEGER     = 38
-	SSQLParserREAL_NUMBER = 39
-	SSQLParserIDENTIFIER  = 40
-	SSQLParserWS          = 41
+	SSQLParserEOF            = antlr.TokenEOF
+	SSQLParserT__0           = 1
+	SSQLParserT__1           = 2
+	SSQLParserT__2           = 3
+	SSQLParserT__3           = 4
+	SSQLParserT__4           = 5
+	SSQLParserT__5           = 6
+	SSQLParserT__6           = 7
+	SSQLParserT__7           = 8
+	SSQLParserT__8           = 9
+	SSQLParserT__9           = 10
+	SSQLParserAVG            = 11
+	SSQLParserMAX            = 12
+	SSQLParserMIN            = 13
+	SSQLParserSUM            = 14
+	SSQLParserCOUNT          = 15
+	SSQLParserCOUNT_DISTINCT = 16
+	SSQLParserSTDDEV         = 17
+	SSQLParserVARIANCE       = 18
+	SSQLParserFIRST          = 19
+	SSQLParserTOPK           = 20
+	SSQLParserHISTOGRAM      = 21
+	SSQLParserPERCENTILE     = 22
+	SSQLParserPARTITION      = 23
+	SSQLParserEQ             = 24
+	SSQLParserNEQ            = 25
+	SSQLParserIN             = 26
+	SSQLParserLT             = 27
+	SSQLParserLE             = 28
+	SSQLParserGE             = 29
+	SSQLParserGT             = 30
+	SSQLParserBETWEEN        = 31
+	SSQLParserCONTAIN        = 32
+	SSQLParserEXIST          = 33
+	SSQLParserTIMEFRAME      = 34
+	SSQLParserKEY            = 35
+	SSQLParserLAST           = 36
+	SSQLParserEXPLAIN        = 37
+	SSQLParserFIND           = 38
+	SSQLParserFROM           = 39
+	SSQLParserOR             = 40
+	SSQLParserWHERE          = 41
+	SSQLParserORDER_BY       = 42
+	SSQLParserGROUP_BY       = 43
+	SSQLParserLIMIT          = 44
+	SSQLParserASC            = 45
+	SSQLParserDESC           = 46
+	SSQLParserNAME           = 47
+	SSQLParserPATH           = 48
+	SSQLParserSTRING         = 49
+	SSQLParserINTEGER        = 50
+	SSQLParserDURATION       = 51
+	SSQLParserREAL_NUMBER    = 52
+	SSQLParserIDENTIFIER     = 53
+	SSQLParserWS             = 54
 )
 
 // SSQLParser rules.
 const (
-	SSQLParserRULE_start      = 0
-	SSQLParserRULE_selection  = 1
-	SSQLParserRULE_attribute  = 2
-	SSQLParserRULE_aggregate  = 3
-	SSQLParserRULE_percentile = 4
-	SSQLParserRULE_groupBy    = 5
-	SSQLParserRULE_partition  = 6
-	SSQLParserRULE_expression = 7
-	SSQLParserRULE_tuple      = 8
-	SSQLParserRULE_vector     = 9
-	SSQLParserRULE_or         = 10
-	SSQLParserRULE_and        = 11
-	SSQLParserRULE_predicate  = 12
-	SSQLParserRULE_eq         = 13
-	SSQLParserRULE_neq        = 14
-	SSQLParserRULE_gt         = 15
-	SSQLParserRULE_ge         = 16
-	SSQLParserRULE_lt         = 17
-	SSQLParserRULE_le         = 18
-	SSQLParserRULE_in         = 19
-	SSQLParserRULE_between    = 20
-	SSQLParserRULE_contain    = 21
-	SSQLParserRULE_exist      = 22
-	SSQLParserRULE_timeframe  = 23
-	SSQLParserRULE_key        = 24
-	SSQLParserRULE_scalar     = 25
-	SSQLParserRULE_list       = 26
-	SSQLParserRULE_stringList = 27
-	SSQLParserRULE_doubleList = 28
-	SSQLParserRULE_intList    = 29
-	SSQLParserRULE_orderBy    = 30
-	SSQLParserRULE_order      = 31
-	SSQLParserRULE_limit      = 32
+	SSQLParserRULE_start         = 0
+	SSQLParserRULE_selection     = 1
+	SSQLParserRULE_attribute     = 2
+	SSQLParserRULE_aggregate     = 3
+	SSQLParserRULE_percentile    = 4
+	SSQLParserRULE_countDistinct = 5
+	SSQLParserRULE_firstLast     = 6
+	SSQLParserRULE_topK          = 7
+	SSQLParserRULE_histogram     = 8
+	SSQLParserRULE_from          = 9
+	SSQLParserRULE_label         = 10
+	SSQLParserRULE_name          = 11
+	SSQLParserRULE_groupBy       = 12
+	SSQLParserRULE_partition     = 13
+	SSQLParserRULE_expression    = 14
+	SSQLParserRULE_tuple         = 15
+	SSQLParserRULE_vector        = 16
+	SSQLParserRULE_or            = 17
+	SSQLParserRULE_and           = 18
+	SSQLParserRULE_predicate     = 19
+	SSQLParserRULE_eq            = 20
+	SSQLParserRULE_neq           = 21
+	SSQLParserRULE_gt            = 22
+	SSQLParserRULE_ge            = 23
+	SSQLParserRULE_lt            = 24
+	SSQLParserRULE_le            = 25
+	SSQLParserRULE_in            = 26
+	SSQLParserRULE_between       = 27
+	SSQLParserRULE_contain       = 28
+	SSQLParserRULE_exist         = 29
+	SSQLParserRULE_timeframe     = 30
+	SSQLParserRULE_moment        = 31
+	SSQLParserRULE_key           = 32
+	SSQLParserRULE_scalar        = 33
+	SSQLParserRULE_signedInteger = 34
+	SSQLParserRULE_list          = 35
+	SSQLParserRULE_stringList    = 36
+	SSQLParserRULE_scalarList    = 37
+	SSQLParserRULE_orderBy       = 38
+	SSQLParserRULE_order         = 39
+	SSQLParserRULE_limit         = 40
 )
 
 // IStartContext is an interface to support dynamic dispatch.
@@ -358,6 +428,20 @@ func (s *StartContext) EOF() antlr.TerminalNode {
 	return s.GetToken(SSQLParserEOF, 0)
 }
 
+func (s *StartContext) EXPLAIN() antlr.TerminalNode {
+	return s.GetToken(SSQLParserEXPLAIN, 0)
+}
+
+func (s *StartContext) From() IFromContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFromContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFromContext)
+}
+
 func (s *StartContext) OrderBy() IOrderByContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOrderByContext)(nil)).Elem(), 0)
 
@@ -430,46 +514,68 @@ func (p *SSQLParser) Start() (localctx IStartContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
+	p.SetState(83)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SSQLParserEXPLAIN {
+		{
+			p.SetState(82)
+			p.Match(SSQLParserEXPLAIN)
+		}
+
+	}
 	{
-		p.SetState(66)
+		p.SetState(85)
 		p.Match(SSQLParserFIND)
 	}
 	{
-		p.SetState(67)
+		p.SetState(86)
 		p.Selection()
 	}
+	p.SetState(88)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SSQLParserFROM {
+		{
+			p.SetState(87)
+			p.From()
+		}
+
+	}
 	{
-		p.SetState(68)
+		p.SetState(90)
 		p.Match(SSQLParserWHERE)
 	}
 	{
-		p.SetState(69)
+		p.SetState(91)
 		p.Expression()
 	}
-	p.SetState(71)
+	p.SetState(93)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SSQLParserORDER_BY {
 		{
-			p.SetState(70)
+			p.SetState(92)
 			p.OrderBy()
 		}
 
 	}
-	p.SetState(74)
+	p.SetState(96)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SSQLParserLIMIT {
 		{
-			p.SetState(73)
+			p.SetState(95)
 			p.Limit()
 		}
 
 	}
 	{
-		p.SetState(76)
+		p.SetState(98)
 		p.Match(SSQLParserEOF)
 	}
 
@@ -638,31 +744,31 @@ func (p *SSQLParser) Selection() (localctx ISelectionContext) {
 		}
 	}()
 
-	p.SetState(104)
+	p.SetState(126)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case SSQLParserAVG, SSQLParserMAX, SSQLParserMIN, SSQLParserSUM, SSQLParserCOUNT, SSQLParserPERCENTILE, SSQLParserIDENTIFIER:
+	case SSQLParserAVG, SSQLParserMAX, SSQLParserMIN, SSQLParserSUM, SSQLParserCOUNT, SSQLParserCOUNT_DISTINCT, SSQLParserSTDDEV, SSQLParserVARIANCE, SSQLParserFIRST, SSQLParserTOPK, SSQLParserHISTOGRAM, SSQLParserPERCENTILE, SSQLParserLAST, SSQLParserIDENTIFIER:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(78)
+			p.SetState(100)
 			p.Attribute()
 		}
-		p.SetState(83)
+		p.SetState(105)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == SSQLParserT__0 {
 			{
-				p.SetState(79)
+				p.SetState(101)
 				p.Match(SSQLParserT__0)
 			}
 			{
-				p.SetState(80)
+				p.SetState(102)
 				p.Attribute()
 			}
 
-			p.SetState(85)
+			p.SetState(107)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
@@ -670,54 +776,54 @@ func (p *SSQLParser) Selection() (localctx ISelectionContext) {
 	case SSQLParserGROUP_BY:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(86)
+			p.SetState(108)
 			p.Match(SSQLParserGROUP_BY)
 		}
 		{
-			p.SetState(87)
+			p.SetState(109)
 			p.Match(SSQLParserT__1)
 		}
 		{
-			p.SetState(88)
+			p.SetState(110)
 			p.GroupBy()
 		}
-		p.SetState(93)
+		p.SetState(115)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == SSQLParserT__0 {
 			{
-				p.SetState(89)
+				p.SetState(111)
 				p.Match(SSQLParserT__0)
 			}
 			{
-				p.SetState(90)
+				p.SetState(112)
 				p.GroupBy()
 			}
 
-			p.SetState(95)
+			p.SetState(117)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
 		{
-			p.SetState(96)
+			p.SetState(118)
 			p.Match(SSQLParserT__2)
 		}
-		p.SetState(101)
+		p.SetState(123)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
 		for _la == SSQLParserT__0 {
 			{
-				p.SetState(97)
+				p.SetState(119)
 				p.Match(SSQLParserT__0)
 			}
 			{
-				p.SetState(98)
+				p.SetState(120)
 				p.Aggregate()
 			}
 
-			p.SetState(103)
+			p.SetState(125)
 			p.GetErrorHandler().Sync(p)
 			_la = p.GetTokenStream().LA(1)
 		}
@@ -831,21 +937,21 @@ func (p *SSQLParser) Attribute() (localctx IAttributeContext) {
 		}
 	}()
 
-	p.SetState(108)
+	p.SetState(130)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SSQLParserIDENTIFIER:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(106)
+			p.SetState(128)
 			p.Match(SSQLParserIDENTIFIER)
 		}
 
-	case SSQLParserAVG, SSQLParserMAX, SSQLParserMIN, SSQLParserSUM, SSQLParserCOUNT, SSQLParserPERCENTILE:
+	case SSQLParserAVG, SSQLParserMAX, SSQLParserMIN, SSQLParserSUM, SSQLParserCOUNT, SSQLParserCOUNT_DISTINCT, SSQLParserSTDDEV, SSQLParserVARIANCE, SSQLParserFIRST, SSQLParserTOPK, SSQLParserHISTOGRAM, SSQLParserPERCENTILE, SSQLParserLAST:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(107)
+			p.SetState(129)
 			p.Aggregate()
 		}
 
@@ -918,6 +1024,14 @@ func (s *AggregateContext) COUNT() antlr.TerminalNode {
 	return s.GetToken(SSQLParserCOUNT, 0)
 }
 
+func (s *AggregateContext) STDDEV() antlr.TerminalNode {
+	return s.GetToken(SSQLParserSTDDEV, 0)
+}
+
+func (s *AggregateContext) VARIANCE() antlr.TerminalNode {
+	return s.GetToken(SSQLParserVARIANCE, 0)
+}
+
 func (s *AggregateContext) Percentile() IPercentileContext {
 	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPercentileContext)(nil)).Elem(), 0)
 
@@ -928,6 +1042,46 @@ func (s *AggregateContext) Percentile() IPercentileContext {
 	return t.(IPercentileContext)
 }
 
+func (s *AggregateContext) CountDistinct() ICountDistinctContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ICountDistinctContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICountDistinctContext)
+}
+
+func (s *AggregateContext) FirstLast() IFirstLastContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IFirstLastContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFirstLastContext)
+}
+
+func (s *AggregateContext) TopK() ITopKContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITopKContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITopKContext)
+}
+
+func (s *AggregateContext) Histogram() IHistogramContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IHistogramContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IHistogramContext)
+}
+
 func (s *AggregateContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
@@ -979,17 +1133,17 @@ func (p *SSQLParser) Aggregate() (localctx IAggregateContext) {
 		}
 	}()
 
-	p.SetState(115)
+	p.SetState(141)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
-	case SSQLParserAVG, SSQLParserMAX, SSQLParserMIN, SSQLParserSUM, SSQLParserCOUNT:
+	case SSQLParserAVG, SSQLParserMAX, SSQLParserMIN, SSQLParserSUM, SSQLParserCOUNT, SSQLParserSTDDEV, SSQLParserVARIANCE:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(110)
+			p.SetState(132)
 			_la = p.GetTokenStream().LA(1)
 
-			if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SSQLParserAVG)|(1<<SSQLParserMAX)|(1<<SSQLParserMIN)|(1<<SSQLParserSUM)|(1<<SSQLParserCOUNT))) != 0) {
+			if !(((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SSQLParserAVG)|(1<<SSQLParserMAX)|(1<<SSQLParserMIN)|(1<<SSQLParserSUM)|(1<<SSQLParserCOUNT)|(1<<SSQLParserSTDDEV)|(1<<SSQLParserVARIANCE))) != 0) {
 				p.GetErrorHandler().RecoverInline(p)
 			} else {
 				p.GetErrorHandler().ReportMatch(p)
@@ -997,25 +1151,53 @@ func (p *SSQLParser) Aggregate() (localctx IAggregateContext) {
 			}
 		}
 		{
-			p.SetState(111)
+			p.SetState(133)
 			p.Match(SSQLParserT__1)
 		}
 		{
-			p.SetState(112)
+			p.SetState(134)
 			p.Match(SSQLParserIDENTIFIER)
 		}
 		{
-			p.SetState(113)
+			p.SetState(135)
 			p.Match(SSQLParserT__2)
 		}
 
 	case SSQLParserPERCENTILE:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(114)
+			p.SetState(136)
 			p.Percentile()
 		}
 
+	case SSQLParserCOUNT_DISTINCT:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(137)
+			p.CountDistinct()
+		}
+
+	case SSQLParserFIRST, SSQLParserLAST:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(138)
+			p.FirstLast()
+		}
+
+	case SSQLParserTOPK:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(139)
+			p.TopK()
+		}
+
+	case SSQLParserHISTOGRAM:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(140)
+			p.Histogram()
+		}
+
 	default:
 		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
@@ -1125,118 +1307,117 @@ func (p *SSQLParser) Percentile() (localctx IPercentileContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(117)
+		p.SetState(143)
 		p.Match(SSQLParserPERCENTILE)
 	}
 	{
-		p.SetState(118)
+		p.SetState(144)
 		p.Match(SSQLParserT__1)
 	}
 	{
-		p.SetState(119)
+		p.SetState(145)
 		p.Match(SSQLParserIDENTIFIER)
 	}
 	{
-		p.SetState(120)
+		p.SetState(146)
 		p.Match(SSQLParserT__0)
 	}
 	{
-		p.SetState(121)
+		p.SetState(147)
 		p.Match(SSQLParserREAL_NUMBER)
 	}
 	{
-		p.SetState(122)
+		p.SetState(148)
 		p.Match(SSQLParserT__2)
 	}
 
 	return localctx
 }
 
-// IGroupByContext is an interface to support dynamic dispatch.
-type IGroupByContext interface {
+// ICountDistinctContext is an interface to support dynamic dispatch.
+type ICountDistinctContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsGroupByContext differentiates from other interfaces.
-	IsGroupByContext()
+	// IsCountDistinctContext differentiates from other interfaces.
+	IsCountDistinctContext()
 }
 
-type GroupByContext struct {
+type CountDistinctContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyGroupByContext() *GroupByContext {
-	var p = new(GroupByContext)
+func NewEmptyCountDistinctContext() *CountDistinctContext {
+	var p = new(CountDistinctContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SSQLParserRULE_groupBy
+	p.RuleIndex = SSQLParserRULE_countDistinct
 	return p
 }
 
-func (*GroupByContext) IsGroupByContext() {}
+func (*CountDistinctContext) IsCountDistinctContext() {}
 
-func NewGroupByContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GroupByContext {
-	var p = new(GroupByContext)
+func NewCountDistinctContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CountDistinctContext {
+	var p = new(CountDistinctContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SSQLParserRULE_groupBy
+	p.RuleIndex = SSQLParserRULE_countDistinct
 
 	return p
 }
 
-func (s *GroupByContext) GetParser() antlr.Parser { return s.parser }
+func (s *CountDistinctContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *GroupByContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(SSQLParserIDENTIFIER, 0)
+func (s *CountDistinctContext) COUNT_DISTINCT() antlr.TerminalNode {
+	return s.GetToken(SSQLParserCOUNT_DISTINCT, 0)
 }
 
-func (s *GroupByContext) Partition() IPartitionContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPartitionContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
+func (s *CountDistinctContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SSQLParserIDENTIFIER, 0)
+}
 
-	return t.(IPartitionContext)
+func (s *CountDistinctContext) INTEGER() antlr.TerminalNode {
+	return s.GetToken(SSQLParserINTEGER, 0)
 }
 
-func (s *GroupByContext) GetRuleContext() antlr.RuleContext {
+func (s *CountDistinctContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *GroupByContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *CountDistinctContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *GroupByContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *CountDistinctContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.EnterGroupBy(s)
+		listenerT.EnterCountDistinct(s)
 	}
 }
 
-func (s *GroupByContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *CountDistinctContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.ExitGroupBy(s)
+		listenerT.ExitCountDistinct(s)
 	}
 }
 
-func (s *GroupByContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *CountDistinctContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SSQLVisitor:
-		return t.VisitGroupBy(s)
+		return t.VisitCountDistinct(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SSQLParser) GroupBy() (localctx IGroupByContext) {
-	localctx = NewGroupByContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 10, SSQLParserRULE_groupBy)
+func (p *SSQLParser) CountDistinct() (localctx ICountDistinctContext) {
+	localctx = NewCountDistinctContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 10, SSQLParserRULE_countDistinct)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -1254,114 +1435,130 @@ func (p *SSQLParser) GroupBy() (localctx IGroupByContext) {
 		}
 	}()
 
-	p.SetState(126)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(150)
+		p.Match(SSQLParserCOUNT_DISTINCT)
+	}
+	{
+		p.SetState(151)
+		p.Match(SSQLParserT__1)
+	}
+	{
+		p.SetState(152)
+		p.Match(SSQLParserIDENTIFIER)
+	}
+	p.SetState(155)
 	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetTokenStream().LA(1) {
-	case SSQLParserIDENTIFIER:
-		p.EnterOuterAlt(localctx, 1)
+	if _la == SSQLParserT__0 {
 		{
-			p.SetState(124)
-			p.Match(SSQLParserIDENTIFIER)
+			p.SetState(153)
+			p.Match(SSQLParserT__0)
 		}
-
-	case SSQLParserPARTITION:
-		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(125)
-			p.Partition()
+			p.SetState(154)
+			p.Match(SSQLParserINTEGER)
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+	{
+		p.SetState(157)
+		p.Match(SSQLParserT__2)
 	}
 
 	return localctx
 }
 
-// IPartitionContext is an interface to support dynamic dispatch.
-type IPartitionContext interface {
+// IFirstLastContext is an interface to support dynamic dispatch.
+type IFirstLastContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsPartitionContext differentiates from other interfaces.
-	IsPartitionContext()
+	// IsFirstLastContext differentiates from other interfaces.
+	IsFirstLastContext()
 }
 
-type PartitionContext struct {
+type FirstLastContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyPartitionContext() *PartitionContext {
-	var p = new(PartitionContext)
+func NewEmptyFirstLastContext() *FirstLastContext {
+	var p = new(FirstLastContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SSQLParserRULE_partition
+	p.RuleIndex = SSQLParserRULE_firstLast
 	return p
 }
 
-func (*PartitionContext) IsPartitionContext() {}
+func (*FirstLastContext) IsFirstLastContext() {}
 
-func NewPartitionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PartitionContext {
-	var p = new(PartitionContext)
+func NewFirstLastContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FirstLastContext {
+	var p = new(FirstLastContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SSQLParserRULE_partition
+	p.RuleIndex = SSQLParserRULE_firstLast
 
 	return p
 }
 
-func (s *PartitionContext) GetParser() antlr.Parser { return s.parser }
+func (s *FirstLastContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *PartitionContext) PARTITION() antlr.TerminalNode {
-	return s.GetToken(SSQLParserPARTITION, 0)
+func (s *FirstLastContext) AllIDENTIFIER() []antlr.TerminalNode {
+	return s.GetTokens(SSQLParserIDENTIFIER)
 }
 
-func (s *PartitionContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(SSQLParserIDENTIFIER, 0)
+func (s *FirstLastContext) IDENTIFIER(i int) antlr.TerminalNode {
+	return s.GetToken(SSQLParserIDENTIFIER, i)
 }
 
-func (s *PartitionContext) INTEGER() antlr.TerminalNode {
-	return s.GetToken(SSQLParserINTEGER, 0)
+func (s *FirstLastContext) FIRST() antlr.TerminalNode {
+	return s.GetToken(SSQLParserFIRST, 0)
 }
 
-func (s *PartitionContext) GetRuleContext() antlr.RuleContext {
+func (s *FirstLastContext) LAST() antlr.TerminalNode {
+	return s.GetToken(SSQLParserLAST, 0)
+}
+
+func (s *FirstLastContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *PartitionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *FirstLastContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *PartitionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FirstLastContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.EnterPartition(s)
+		listenerT.EnterFirstLast(s)
 	}
 }
 
-func (s *PartitionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FirstLastContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.ExitPartition(s)
+		listenerT.ExitFirstLast(s)
 	}
 }
 
-func (s *PartitionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *FirstLastContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SSQLVisitor:
-		return t.VisitPartition(s)
+		return t.VisitFirstLast(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SSQLParser) Partition() (localctx IPartitionContext) {
-	localctx = NewPartitionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 12, SSQLParserRULE_partition)
+func (p *SSQLParser) FirstLast() (localctx IFirstLastContext) {
+	localctx = NewFirstLastContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 12, SSQLParserRULE_firstLast)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -1381,128 +1578,123 @@ func (p *SSQLParser) Partition() (localctx IPartitionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(128)
-		p.Match(SSQLParserPARTITION)
+		p.SetState(159)
+		_la = p.GetTokenStream().LA(1)
+
+		if !(_la == SSQLParserFIRST || _la == SSQLParserLAST) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
 	}
 	{
-		p.SetState(129)
+		p.SetState(160)
 		p.Match(SSQLParserT__1)
 	}
 	{
-		p.SetState(130)
+		p.SetState(161)
 		p.Match(SSQLParserIDENTIFIER)
 	}
 	{
-		p.SetState(131)
+		p.SetState(162)
 		p.Match(SSQLParserT__0)
 	}
 	{
-		p.SetState(132)
-		p.Match(SSQLParserINTEGER)
+		p.SetState(163)
+		p.Match(SSQLParserIDENTIFIER)
 	}
 	{
-		p.SetState(133)
+		p.SetState(164)
 		p.Match(SSQLParserT__2)
 	}
 
 	return localctx
 }
 
-// IExpressionContext is an interface to support dynamic dispatch.
-type IExpressionContext interface {
+// ITopKContext is an interface to support dynamic dispatch.
+type ITopKContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsExpressionContext differentiates from other interfaces.
-	IsExpressionContext()
+	// IsTopKContext differentiates from other interfaces.
+	IsTopKContext()
 }
 
-type ExpressionContext struct {
+type TopKContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyExpressionContext() *ExpressionContext {
-	var p = new(ExpressionContext)
+func NewEmptyTopKContext() *TopKContext {
+	var p = new(TopKContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SSQLParserRULE_expression
+	p.RuleIndex = SSQLParserRULE_topK
 	return p
 }
 
-func (*ExpressionContext) IsExpressionContext() {}
+func (*TopKContext) IsTopKContext() {}
 
-func NewExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionContext {
-	var p = new(ExpressionContext)
+func NewTopKContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TopKContext {
+	var p = new(TopKContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SSQLParserRULE_expression
+	p.RuleIndex = SSQLParserRULE_topK
 
 	return p
 }
 
-func (s *ExpressionContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ExpressionContext) AllTuple() []ITupleContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ITupleContext)(nil)).Elem())
-	var tst = make([]ITupleContext, len(ts))
-
-	for i, t := range ts {
-		if t != nil {
-			tst[i] = t.(ITupleContext)
-		}
-	}
+func (s *TopKContext) GetParser() antlr.Parser { return s.parser }
 
-	return tst
+func (s *TopKContext) TOPK() antlr.TerminalNode {
+	return s.GetToken(SSQLParserTOPK, 0)
 }
 
-func (s *ExpressionContext) Tuple(i int) ITupleContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITupleContext)(nil)).Elem(), i)
-
-	if t == nil {
-		return nil
-	}
+func (s *TopKContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SSQLParserIDENTIFIER, 0)
+}
 
-	return t.(ITupleContext)
+func (s *TopKContext) INTEGER() antlr.TerminalNode {
+	return s.GetToken(SSQLParserINTEGER, 0)
 }
 
-func (s *ExpressionContext) GetRuleContext() antlr.RuleContext {
+func (s *TopKContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TopKContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TopKContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.EnterExpression(s)
+		listenerT.EnterTopK(s)
 	}
 }
 
-func (s *ExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TopKContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.ExitExpression(s)
+		listenerT.ExitTopK(s)
 	}
 }
 
-func (s *ExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *TopKContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SSQLVisitor:
-		return t.VisitExpression(s)
+		return t.VisitTopK(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SSQLParser) Expression() (localctx IExpressionContext) {
-	localctx = NewExpressionContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 14, SSQLParserRULE_expression)
-	var _la int
+func (p *SSQLParser) TopK() (localctx ITopKContext) {
+	localctx = NewTopKContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 14, SSQLParserRULE_topK)
 
 	defer func() {
 		p.ExitRule()
@@ -1522,128 +1714,116 @@ func (p *SSQLParser) Expression() (localctx IExpressionContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(135)
-		p.Tuple()
+		p.SetState(166)
+		p.Match(SSQLParserTOPK)
 	}
-	p.SetState(139)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
-
-	for ((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SSQLParserT__3)|(1<<SSQLParserT__5)|(1<<SSQLParserT__7))) != 0 {
-		{
-			p.SetState(136)
-			p.Tuple()
-		}
-
-		p.SetState(141)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
+	{
+		p.SetState(167)
+		p.Match(SSQLParserT__1)
 	}
-
-	return localctx
-}
-
-// ITupleContext is an interface to support dynamic dispatch.
-type ITupleContext interface {
+	{
+		p.SetState(168)
+		p.Match(SSQLParserIDENTIFIER)
+	}
+	{
+		p.SetState(169)
+		p.Match(SSQLParserT__0)
+	}
+	{
+		p.SetState(170)
+		p.Match(SSQLParserINTEGER)
+	}
+	{
+		p.SetState(171)
+		p.Match(SSQLParserT__2)
+	}
+
+	return localctx
+}
+
+// IHistogramContext is an interface to support dynamic dispatch.
+type IHistogramContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsTupleContext differentiates from other interfaces.
-	IsTupleContext()
+	// IsHistogramContext differentiates from other interfaces.
+	IsHistogramContext()
 }
 
-type TupleContext struct {
+type HistogramContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTupleContext() *TupleContext {
-	var p = new(TupleContext)
+func NewEmptyHistogramContext() *HistogramContext {
+	var p = new(HistogramContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SSQLParserRULE_tuple
+	p.RuleIndex = SSQLParserRULE_histogram
 	return p
 }
 
-func (*TupleContext) IsTupleContext() {}
+func (*HistogramContext) IsHistogramContext() {}
 
-func NewTupleContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TupleContext {
-	var p = new(TupleContext)
+func NewHistogramContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *HistogramContext {
+	var p = new(HistogramContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SSQLParserRULE_tuple
+	p.RuleIndex = SSQLParserRULE_histogram
 
 	return p
 }
 
-func (s *TupleContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *TupleContext) Vector() IVectorContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IVectorContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
+func (s *HistogramContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(IVectorContext)
+func (s *HistogramContext) HISTOGRAM() antlr.TerminalNode {
+	return s.GetToken(SSQLParserHISTOGRAM, 0)
 }
 
-func (s *TupleContext) Or() IOrContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOrContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IOrContext)
+func (s *HistogramContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SSQLParserIDENTIFIER, 0)
 }
 
-func (s *TupleContext) And() IAndContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAndContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IAndContext)
+func (s *HistogramContext) INTEGER() antlr.TerminalNode {
+	return s.GetToken(SSQLParserINTEGER, 0)
 }
 
-func (s *TupleContext) GetRuleContext() antlr.RuleContext {
+func (s *HistogramContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TupleContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *HistogramContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TupleContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *HistogramContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.EnterTuple(s)
+		listenerT.EnterHistogram(s)
 	}
 }
 
-func (s *TupleContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *HistogramContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.ExitTuple(s)
+		listenerT.ExitHistogram(s)
 	}
 }
 
-func (s *TupleContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *HistogramContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SSQLVisitor:
-		return t.VisitTuple(s)
+		return t.VisitHistogram(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SSQLParser) Tuple() (localctx ITupleContext) {
-	localctx = NewTupleContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 16, SSQLParserRULE_tuple)
+func (p *SSQLParser) Histogram() (localctx IHistogramContext) {
+	localctx = NewHistogramContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 16, SSQLParserRULE_histogram)
 
 	defer func() {
 		p.ExitRule()
@@ -1661,150 +1841,141 @@ func (p *SSQLParser) Tuple() (localctx ITupleContext) {
 		}
 	}()
 
-	p.SetState(145)
-	p.GetErrorHandler().Sync(p)
-
-	switch p.GetTokenStream().LA(1) {
-	case SSQLParserT__3:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(142)
-			p.Vector()
-		}
-
-	case SSQLParserT__5:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(143)
-			p.Or()
-		}
-
-	case SSQLParserT__7:
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(144)
-			p.And()
-		}
-
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(173)
+		p.Match(SSQLParserHISTOGRAM)
+	}
+	{
+		p.SetState(174)
+		p.Match(SSQLParserT__1)
+	}
+	{
+		p.SetState(175)
+		p.Match(SSQLParserIDENTIFIER)
+	}
+	{
+		p.SetState(176)
+		p.Match(SSQLParserT__0)
+	}
+	{
+		p.SetState(177)
+		p.Match(SSQLParserINTEGER)
+	}
+	{
+		p.SetState(178)
+		p.Match(SSQLParserT__2)
 	}
 
 	return localctx
 }
 
-// IVectorContext is an interface to support dynamic dispatch.
-type IVectorContext interface {
+// IFromContext is an interface to support dynamic dispatch.
+type IFromContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsVectorContext differentiates from other interfaces.
-	IsVectorContext()
+	// IsFromContext differentiates from other interfaces.
+	IsFromContext()
 }
 
-type VectorContext struct {
+type FromContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyVectorContext() *VectorContext {
-	var p = new(VectorContext)
+func NewEmptyFromContext() *FromContext {
+	var p = new(FromContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SSQLParserRULE_vector
+	p.RuleIndex = SSQLParserRULE_from
 	return p
 }
 
-func (*VectorContext) IsVectorContext() {}
+func (*FromContext) IsFromContext() {}
 
-func NewVectorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *VectorContext {
-	var p = new(VectorContext)
+func NewFromContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FromContext {
+	var p = new(FromContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SSQLParserRULE_vector
+	p.RuleIndex = SSQLParserRULE_from
 
 	return p
 }
 
-func (s *VectorContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *VectorContext) PATH() antlr.TerminalNode {
-	return s.GetToken(SSQLParserPATH, 0)
-}
-
-func (s *VectorContext) IDENTIFIER() antlr.TerminalNode {
-	return s.GetToken(SSQLParserIDENTIFIER, 0)
-}
-
-func (s *VectorContext) Predicate() IPredicateContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPredicateContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
+func (s *FromContext) GetParser() antlr.Parser { return s.parser }
 
-	return t.(IPredicateContext)
+func (s *FromContext) FROM() antlr.TerminalNode {
+	return s.GetToken(SSQLParserFROM, 0)
 }
 
-func (s *VectorContext) AllVector() []IVectorContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IVectorContext)(nil)).Elem())
-	var tst = make([]IVectorContext, len(ts))
+func (s *FromContext) AllLabel() []ILabelContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ILabelContext)(nil)).Elem())
+	var tst = make([]ILabelContext, len(ts))
 
 	for i, t := range ts {
 		if t != nil {
-			tst[i] = t.(IVectorContext)
+			tst[i] = t.(ILabelContext)
 		}
 	}
 
 	return tst
 }
 
-func (s *VectorContext) Vector(i int) IVectorContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IVectorContext)(nil)).Elem(), i)
+func (s *FromContext) Label(i int) ILabelContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ILabelContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IVectorContext)
+	return t.(ILabelContext)
 }
 
-func (s *VectorContext) GetRuleContext() antlr.RuleContext {
+func (s *FromContext) AllOR() []antlr.TerminalNode {
+	return s.GetTokens(SSQLParserOR)
+}
+
+func (s *FromContext) OR(i int) antlr.TerminalNode {
+	return s.GetToken(SSQLParserOR, i)
+}
+
+func (s *FromContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *VectorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *FromContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *VectorContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *FromContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.EnterVector(s)
+		listenerT.EnterFrom(s)
 	}
 }
 
-func (s *VectorContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *FromContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.ExitVector(s)
+		listenerT.ExitFrom(s)
 	}
 }
 
-func (s *VectorContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *FromContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SSQLVisitor:
-		return t.VisitVector(s)
+		return t.VisitFrom(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SSQLParser) Vector() (localctx IVectorContext) {
-	localctx = NewVectorContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 18, SSQLParserRULE_vector)
+func (p *SSQLParser) From() (localctx IFromContext) {
+	localctx = NewFromContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 18, SSQLParserRULE_from)
 	var _la int
 
 	defer func() {
@@ -1825,156 +1996,148 @@ func (p *SSQLParser) Vector() (localctx IVectorContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(147)
-		p.Match(SSQLParserT__3)
+		p.SetState(180)
+		p.Match(SSQLParserFROM)
 	}
-	p.SetState(149)
+	{
+		p.SetState(181)
+		p.Label()
+	}
+	p.SetState(186)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	if _la == SSQLParserIDENTIFIER {
+	for _la == SSQLParserT__0 || _la == SSQLParserOR {
 		{
-			p.SetState(148)
-			p.Match(SSQLParserIDENTIFIER)
-		}
-
-	}
-	{
-		p.SetState(151)
-		p.Match(SSQLParserPATH)
-	}
-	p.SetState(158)
-	p.GetErrorHandler().Sync(p)
+			p.SetState(182)
+			_la = p.GetTokenStream().LA(1)
 
-	switch p.GetTokenStream().LA(1) {
-	case SSQLParserEQ, SSQLParserNEQ, SSQLParserIN, SSQLParserLT, SSQLParserLE, SSQLParserGE, SSQLParserGT, SSQLParserBETWEEN, SSQLParserCONTAIN, SSQLParserEXIST, SSQLParserTIMEFRAME, SSQLParserKEY:
+			if !(_la == SSQLParserT__0 || _la == SSQLParserOR) {
+				p.GetErrorHandler().RecoverInline(p)
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
 		{
-			p.SetState(152)
-			p.Predicate()
+			p.SetState(183)
+			p.Label()
 		}
 
-	case SSQLParserT__3:
-		p.SetState(154)
+		p.SetState(188)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
-
-		for ok := true; ok; ok = _la == SSQLParserT__3 {
-			{
-				p.SetState(153)
-				p.Vector()
-			}
-
-			p.SetState(156)
-			p.GetErrorHandler().Sync(p)
-			_la = p.GetTokenStream().LA(1)
-		}
-
-	case SSQLParserT__4:
-
-	default:
-	}
-	{
-		p.SetState(160)
-		p.Match(SSQLParserT__4)
 	}
 
 	return localctx
 }
 
-// IOrContext is an interface to support dynamic dispatch.
-type IOrContext interface {
+// ILabelContext is an interface to support dynamic dispatch.
+type ILabelContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsOrContext differentiates from other interfaces.
-	IsOrContext()
+	// IsLabelContext differentiates from other interfaces.
+	IsLabelContext()
 }
 
-type OrContext struct {
+type LabelContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyOrContext() *OrContext {
-	var p = new(OrContext)
+func NewEmptyLabelContext() *LabelContext {
+	var p = new(LabelContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SSQLParserRULE_or
+	p.RuleIndex = SSQLParserRULE_label
 	return p
 }
 
-func (*OrContext) IsOrContext() {}
+func (*LabelContext) IsLabelContext() {}
 
-func NewOrContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OrContext {
-	var p = new(OrContext)
+func NewLabelContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LabelContext {
+	var p = new(LabelContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SSQLParserRULE_or
+	p.RuleIndex = SSQLParserRULE_label
 
 	return p
 }
 
-func (s *OrContext) GetParser() antlr.Parser { return s.parser }
+func (s *LabelContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *OrContext) AllTuple() []ITupleContext {
-	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ITupleContext)(nil)).Elem())
-	var tst = make([]ITupleContext, len(ts))
+func (s *LabelContext) AllName() []INameContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*INameContext)(nil)).Elem())
+	var tst = make([]INameContext, len(ts))
 
 	for i, t := range ts {
 		if t != nil {
-			tst[i] = t.(ITupleContext)
+			tst[i] = t.(INameContext)
 		}
 	}
 
 	return tst
 }
 
-func (s *OrContext) Tuple(i int) ITupleContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITupleContext)(nil)).Elem(), i)
+func (s *LabelContext) Name(i int) INameContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*INameContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(ITupleContext)
+	return t.(INameContext)
 }
 
-func (s *OrContext) GetRuleContext() antlr.RuleContext {
+func (s *LabelContext) AllSTRING() []antlr.TerminalNode {
+	return s.GetTokens(SSQLParserSTRING)
+}
+
+func (s *LabelContext) STRING(i int) antlr.TerminalNode {
+	return s.GetToken(SSQLParserSTRING, i)
+}
+
+func (s *LabelContext) INTEGER() antlr.TerminalNode {
+	return s.GetToken(SSQLParserINTEGER, 0)
+}
+
+func (s *LabelContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *OrContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *LabelContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *OrContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *LabelContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.EnterOr(s)
+		listenerT.EnterLabel(s)
 	}
 }
 
-func (s *OrContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *LabelContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.ExitOr(s)
+		listenerT.ExitLabel(s)
 	}
 }
 
-func (s *OrContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *LabelContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SSQLVisitor:
-		return t.VisitOr(s)
+		return t.VisitLabel(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SSQLParser) Or() (localctx IOrContext) {
-	localctx = NewOrContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 20, SSQLParserRULE_or)
+func (p *SSQLParser) Label() (localctx ILabelContext) {
+	localctx = NewLabelContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 20, SSQLParserRULE_label)
 	var _la int
 
 	defer func() {
@@ -1994,28 +2157,1174 @@ func (p *SSQLParser) Or() (localctx IOrContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(162)
-		p.Match(SSQLParserT__5)
+	p.SetState(191)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SSQLParserAVG, SSQLParserMAX, SSQLParserMIN, SSQLParserSUM, SSQLParserCOUNT, SSQLParserCOUNT_DISTINCT, SSQLParserSTDDEV, SSQLParserVARIANCE, SSQLParserFIRST, SSQLParserTOPK, SSQLParserHISTOGRAM, SSQLParserPERCENTILE, SSQLParserPARTITION, SSQLParserEQ, SSQLParserNEQ, SSQLParserIN, SSQLParserLT, SSQLParserLE, SSQLParserGE, SSQLParserGT, SSQLParserBETWEEN, SSQLParserCONTAIN, SSQLParserEXIST, SSQLParserTIMEFRAME, SSQLParserKEY, SSQLParserLAST, SSQLParserEXPLAIN, SSQLParserFIND, SSQLParserFROM, SSQLParserORDER_BY, SSQLParserGROUP_BY, SSQLParserLIMIT, SSQLParserASC, SSQLParserDESC, SSQLParserNAME:
+		{
+			p.SetState(189)
+			p.Name()
+		}
+
+	case SSQLParserSTRING:
+		{
+			p.SetState(190)
+			p.Match(SSQLParserSTRING)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
-	p.SetState(164)
+	p.SetState(199)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for ok := true; ok; ok = (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SSQLParserT__3)|(1<<SSQLParserT__5)|(1<<SSQLParserT__7))) != 0) {
+	if _la == SSQLParserT__3 {
 		{
-			p.SetState(163)
-			p.Tuple()
+			p.SetState(193)
+			p.Match(SSQLParserT__3)
 		}
-
-		p.SetState(166)
+		p.SetState(197)
+		p.GetErrorHandler().Sync(p)
+
+		switch p.GetTokenStream().LA(1) {
+		case SSQLParserAVG, SSQLParserMAX, SSQLParserMIN, SSQLParserSUM, SSQLParserCOUNT, SSQLParserCOUNT_DISTINCT, SSQLParserSTDDEV, SSQLParserVARIANCE, SSQLParserFIRST, SSQLParserTOPK, SSQLParserHISTOGRAM, SSQLParserPERCENTILE, SSQLParserPARTITION, SSQLParserEQ, SSQLParserNEQ, SSQLParserIN, SSQLParserLT, SSQLParserLE, SSQLParserGE, SSQLParserGT, SSQLParserBETWEEN, SSQLParserCONTAIN, SSQLParserEXIST, SSQLParserTIMEFRAME, SSQLParserKEY, SSQLParserLAST, SSQLParserEXPLAIN, SSQLParserFIND, SSQLParserFROM, SSQLParserORDER_BY, SSQLParserGROUP_BY, SSQLParserLIMIT, SSQLParserASC, SSQLParserDESC, SSQLParserNAME:
+			{
+				p.SetState(194)
+				p.Name()
+			}
+
+		case SSQLParserSTRING:
+			{
+				p.SetState(195)
+				p.Match(SSQLParserSTRING)
+			}
+
+		case SSQLParserINTEGER:
+			{
+				p.SetState(196)
+				p.Match(SSQLParserINTEGER)
+			}
+
+		default:
+			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		}
+
+	}
+
+	return localctx
+}
+
+// INameContext is an interface to support dynamic dispatch.
+type INameContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsNameContext differentiates from other interfaces.
+	IsNameContext()
+}
+
+type NameContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyNameContext() *NameContext {
+	var p = new(NameContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SSQLParserRULE_name
+	return p
+}
+
+func (*NameContext) IsNameContext() {}
+
+func NewNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NameContext {
+	var p = new(NameContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SSQLParserRULE_name
+
+	return p
+}
+
+func (s *NameContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *NameContext) NAME() antlr.TerminalNode {
+	return s.GetToken(SSQLParserNAME, 0)
+}
+
+func (s *NameContext) AVG() antlr.TerminalNode {
+	return s.GetToken(SSQLParserAVG, 0)
+}
+
+func (s *NameContext) MAX() antlr.TerminalNode {
+	return s.GetToken(SSQLParserMAX, 0)
+}
+
+func (s *NameContext) MIN() antlr.TerminalNode {
+	return s.GetToken(SSQLParserMIN, 0)
+}
+
+func (s *NameContext) SUM() antlr.TerminalNode {
+	return s.GetToken(SSQLParserSUM, 0)
+}
+
+func (s *NameContext) COUNT() antlr.TerminalNode {
+	return s.GetToken(SSQLParserCOUNT, 0)
+}
+
+func (s *NameContext) COUNT_DISTINCT() antlr.TerminalNode {
+	return s.GetToken(SSQLParserCOUNT_DISTINCT, 0)
+}
+
+func (s *NameContext) STDDEV() antlr.TerminalNode {
+	return s.GetToken(SSQLParserSTDDEV, 0)
+}
+
+func (s *NameContext) VARIANCE() antlr.TerminalNode {
+	return s.GetToken(SSQLParserVARIANCE, 0)
+}
+
+func (s *NameContext) FIRST() antlr.TerminalNode {
+	return s.GetToken(SSQLParserFIRST, 0)
+}
+
+func (s *NameContext) TOPK() antlr.TerminalNode {
+	return s.GetToken(SSQLParserTOPK, 0)
+}
+
+func (s *NameContext) HISTOGRAM() antlr.TerminalNode {
+	return s.GetToken(SSQLParserHISTOGRAM, 0)
+}
+
+func (s *NameContext) PERCENTILE() antlr.TerminalNode {
+	return s.GetToken(SSQLParserPERCENTILE, 0)
+}
+
+func (s *NameContext) PARTITION() antlr.TerminalNode {
+	return s.GetToken(SSQLParserPARTITION, 0)
+}
+
+func (s *NameContext) EQ() antlr.TerminalNode {
+	return s.GetToken(SSQLParserEQ, 0)
+}
+
+func (s *NameContext) NEQ() antlr.TerminalNode {
+	return s.GetToken(SSQLParserNEQ, 0)
+}
+
+func (s *NameContext) IN() antlr.TerminalNode {
+	return s.GetToken(SSQLParserIN, 0)
+}
+
+func (s *NameContext) LT() antlr.TerminalNode {
+	return s.GetToken(SSQLParserLT, 0)
+}
+
+func (s *NameContext) LE() antlr.TerminalNode {
+	return s.GetToken(SSQLParserLE, 0)
+}
+
+func (s *NameContext) GE() antlr.TerminalNode {
+	return s.GetToken(SSQLParserGE, 0)
+}
+
+func (s *NameContext) GT() antlr.TerminalNode {
+	return s.GetToken(SSQLParserGT, 0)
+}
+
+func (s *NameContext) BETWEEN() antlr.TerminalNode {
+	return s.GetToken(SSQLParserBETWEEN, 0)
+}
+
+func (s *NameContext) CONTAIN() antlr.TerminalNode {
+	return s.GetToken(SSQLParserCONTAIN, 0)
+}
+
+func (s *NameContext) EXIST() antlr.TerminalNode {
+	return s.GetToken(SSQLParserEXIST, 0)
+}
+
+func (s *NameContext) TIMEFRAME() antlr.TerminalNode {
+	return s.GetToken(SSQLParserTIMEFRAME, 0)
+}
+
+func (s *NameContext) KEY() antlr.TerminalNode {
+	return s.GetToken(SSQLParserKEY, 0)
+}
+
+func (s *NameContext) LAST() antlr.TerminalNode {
+	return s.GetToken(SSQLParserLAST, 0)
+}
+
+func (s *NameContext) EXPLAIN() antlr.TerminalNode {
+	return s.GetToken(SSQLParserEXPLAIN, 0)
+}
+
+func (s *NameContext) FIND() antlr.TerminalNode {
+	return s.GetToken(SSQLParserFIND, 0)
+}
+
+func (s *NameContext) FROM() antlr.TerminalNode {
+	return s.GetToken(SSQLParserFROM, 0)
+}
+
+func (s *NameContext) ORDER_BY() antlr.TerminalNode {
+	return s.GetToken(SSQLParserORDER_BY, 0)
+}
+
+func (s *NameContext) GROUP_BY() antlr.TerminalNode {
+	return s.GetToken(SSQLParserGROUP_BY, 0)
+}
+
+func (s *NameContext) LIMIT() antlr.TerminalNode {
+	return s.GetToken(SSQLParserLIMIT, 0)
+}
+
+func (s *NameContext) ASC() antlr.TerminalNode {
+	return s.GetToken(SSQLParserASC, 0)
+}
+
+func (s *NameContext) DESC() antlr.TerminalNode {
+	return s.GetToken(SSQLParserDESC, 0)
+}
+
+func (s *NameContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *NameContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SSQLListener); ok {
+		listenerT.EnterName(s)
+	}
+}
+
+func (s *NameContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SSQLListener); ok {
+		listenerT.ExitName(s)
+	}
+}
+
+func (s *NameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SSQLVisitor:
+		return t.VisitName(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SSQLParser) Name() (localctx INameContext) {
+	localctx = NewNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 22, SSQLParserRULE_name)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(201)
+		_la = p.GetTokenStream().LA(1)
+
+		if !((((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SSQLParserAVG)|(1<<SSQLParserMAX)|(1<<SSQLParserMIN)|(1<<SSQLParserSUM)|(1<<SSQLParserCOUNT)|(1<<SSQLParserCOUNT_DISTINCT)|(1<<SSQLParserSTDDEV)|(1<<SSQLParserVARIANCE)|(1<<SSQLParserFIRST)|(1<<SSQLParserTOPK)|(1<<SSQLParserHISTOGRAM)|(1<<SSQLParserPERCENTILE)|(1<<SSQLParserPARTITION)|(1<<SSQLParserEQ)|(1<<SSQLParserNEQ)|(1<<SSQLParserIN)|(1<<SSQLParserLT)|(1<<SSQLParserLE)|(1<<SSQLParserGE)|(1<<SSQLParserGT)|(1<<SSQLParserBETWEEN))) != 0) || (((_la-32)&-(0x1f+1)) == 0 && ((1<<uint((_la-32)))&((1<<(SSQLParserCONTAIN-32))|(1<<(SSQLParserEXIST-32))|(1<<(SSQLParserTIMEFRAME-32))|(1<<(SSQLParserKEY-32))|(1<<(SSQLParserLAST-32))|(1<<(SSQLParserEXPLAIN-32))|(1<<(SSQLParserFIND-32))|(1<<(SSQLParserFROM-32))|(1<<(SSQLParserORDER_BY-32))|(1<<(SSQLParserGROUP_BY-32))|(1<<(SSQLParserLIMIT-32))|(1<<(SSQLParserASC-32))|(1<<(SSQLParserDESC-32))|(1<<(SSQLParserNAME-32)))) != 0)) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+
+	return localctx
+}
+
+// IGroupByContext is an interface to support dynamic dispatch.
+type IGroupByContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsGroupByContext differentiates from other interfaces.
+	IsGroupByContext()
+}
+
+type GroupByContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyGroupByContext() *GroupByContext {
+	var p = new(GroupByContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SSQLParserRULE_groupBy
+	return p
+}
+
+func (*GroupByContext) IsGroupByContext() {}
+
+func NewGroupByContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *GroupByContext {
+	var p = new(GroupByContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SSQLParserRULE_groupBy
+
+	return p
+}
+
+func (s *GroupByContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *GroupByContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SSQLParserIDENTIFIER, 0)
+}
+
+func (s *GroupByContext) Partition() IPartitionContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPartitionContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPartitionContext)
+}
+
+func (s *GroupByContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *GroupByContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *GroupByContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SSQLListener); ok {
+		listenerT.EnterGroupBy(s)
+	}
+}
+
+func (s *GroupByContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SSQLListener); ok {
+		listenerT.ExitGroupBy(s)
+	}
+}
+
+func (s *GroupByContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SSQLVisitor:
+		return t.VisitGroupBy(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SSQLParser) GroupBy() (localctx IGroupByContext) {
+	localctx = NewGroupByContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 24, SSQLParserRULE_groupBy)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(205)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SSQLParserIDENTIFIER:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(203)
+			p.Match(SSQLParserIDENTIFIER)
+		}
+
+	case SSQLParserPARTITION:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(204)
+			p.Partition()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IPartitionContext is an interface to support dynamic dispatch.
+type IPartitionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsPartitionContext differentiates from other interfaces.
+	IsPartitionContext()
+}
+
+type PartitionContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyPartitionContext() *PartitionContext {
+	var p = new(PartitionContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SSQLParserRULE_partition
+	return p
+}
+
+func (*PartitionContext) IsPartitionContext() {}
+
+func NewPartitionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *PartitionContext {
+	var p = new(PartitionContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SSQLParserRULE_partition
+
+	return p
+}
+
+func (s *PartitionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *PartitionContext) PARTITION() antlr.TerminalNode {
+	return s.GetToken(SSQLParserPARTITION, 0)
+}
+
+func (s *PartitionContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SSQLParserIDENTIFIER, 0)
+}
+
+func (s *PartitionContext) INTEGER() antlr.TerminalNode {
+	return s.GetToken(SSQLParserINTEGER, 0)
+}
+
+func (s *PartitionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PartitionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *PartitionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SSQLListener); ok {
+		listenerT.EnterPartition(s)
+	}
+}
+
+func (s *PartitionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SSQLListener); ok {
+		listenerT.ExitPartition(s)
+	}
+}
+
+func (s *PartitionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SSQLVisitor:
+		return t.VisitPartition(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SSQLParser) Partition() (localctx IPartitionContext) {
+	localctx = NewPartitionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 26, SSQLParserRULE_partition)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(207)
+		p.Match(SSQLParserPARTITION)
+	}
+	{
+		p.SetState(208)
+		p.Match(SSQLParserT__1)
+	}
+	{
+		p.SetState(209)
+		p.Match(SSQLParserIDENTIFIER)
+	}
+	{
+		p.SetState(210)
+		p.Match(SSQLParserT__0)
+	}
+	{
+		p.SetState(211)
+		p.Match(SSQLParserINTEGER)
+	}
+	{
+		p.SetState(212)
+		p.Match(SSQLParserT__2)
+	}
+
+	return localctx
+}
+
+// IExpressionContext is an interface to support dynamic dispatch.
+type IExpressionContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsExpressionContext differentiates from other interfaces.
+	IsExpressionContext()
+}
+
+type ExpressionContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyExpressionContext() *ExpressionContext {
+	var p = new(ExpressionContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SSQLParserRULE_expression
+	return p
+}
+
+func (*ExpressionContext) IsExpressionContext() {}
+
+func NewExpressionContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExpressionContext {
+	var p = new(ExpressionContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SSQLParserRULE_expression
+
+	return p
+}
+
+func (s *ExpressionContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ExpressionContext) AllTuple() []ITupleContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ITupleContext)(nil)).Elem())
+	var tst = make([]ITupleContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(ITupleContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *ExpressionContext) Tuple(i int) ITupleContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITupleContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITupleContext)
+}
+
+func (s *ExpressionContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExpressionContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ExpressionContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SSQLListener); ok {
+		listenerT.EnterExpression(s)
+	}
+}
+
+func (s *ExpressionContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SSQLListener); ok {
+		listenerT.ExitExpression(s)
+	}
+}
+
+func (s *ExpressionContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SSQLVisitor:
+		return t.VisitExpression(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SSQLParser) Expression() (localctx IExpressionContext) {
+	localctx = NewExpressionContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 28, SSQLParserRULE_expression)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(214)
+		p.Tuple()
+	}
+	p.SetState(218)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for ((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SSQLParserT__4)|(1<<SSQLParserT__6)|(1<<SSQLParserT__8))) != 0 {
+		{
+			p.SetState(215)
+			p.Tuple()
+		}
+
+		p.SetState(220)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// ITupleContext is an interface to support dynamic dispatch.
+type ITupleContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsTupleContext differentiates from other interfaces.
+	IsTupleContext()
+}
+
+type TupleContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTupleContext() *TupleContext {
+	var p = new(TupleContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SSQLParserRULE_tuple
+	return p
+}
+
+func (*TupleContext) IsTupleContext() {}
+
+func NewTupleContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TupleContext {
+	var p = new(TupleContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SSQLParserRULE_tuple
+
+	return p
+}
+
+func (s *TupleContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TupleContext) Vector() IVectorContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IVectorContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IVectorContext)
+}
+
+func (s *TupleContext) Or() IOrContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IOrContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOrContext)
+}
+
+func (s *TupleContext) And() IAndContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IAndContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAndContext)
+}
+
+func (s *TupleContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TupleContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TupleContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SSQLListener); ok {
+		listenerT.EnterTuple(s)
+	}
+}
+
+func (s *TupleContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SSQLListener); ok {
+		listenerT.ExitTuple(s)
+	}
+}
+
+func (s *TupleContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SSQLVisitor:
+		return t.VisitTuple(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SSQLParser) Tuple() (localctx ITupleContext) {
+	localctx = NewTupleContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 30, SSQLParserRULE_tuple)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(224)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SSQLParserT__4:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(221)
+			p.Vector()
+		}
+
+	case SSQLParserT__6:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(222)
+			p.Or()
+		}
+
+	case SSQLParserT__8:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(223)
+			p.And()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IVectorContext is an interface to support dynamic dispatch.
+type IVectorContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsVectorContext differentiates from other interfaces.
+	IsVectorContext()
+}
+
+type VectorContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyVectorContext() *VectorContext {
+	var p = new(VectorContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SSQLParserRULE_vector
+	return p
+}
+
+func (*VectorContext) IsVectorContext() {}
+
+func NewVectorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *VectorContext {
+	var p = new(VectorContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SSQLParserRULE_vector
+
+	return p
+}
+
+func (s *VectorContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *VectorContext) PATH() antlr.TerminalNode {
+	return s.GetToken(SSQLParserPATH, 0)
+}
+
+func (s *VectorContext) IDENTIFIER() antlr.TerminalNode {
+	return s.GetToken(SSQLParserIDENTIFIER, 0)
+}
+
+func (s *VectorContext) Predicate() IPredicateContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IPredicateContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IPredicateContext)
+}
+
+func (s *VectorContext) AllVector() []IVectorContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IVectorContext)(nil)).Elem())
+	var tst = make([]IVectorContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IVectorContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *VectorContext) Vector(i int) IVectorContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IVectorContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IVectorContext)
+}
+
+func (s *VectorContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *VectorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *VectorContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SSQLListener); ok {
+		listenerT.EnterVector(s)
+	}
+}
+
+func (s *VectorContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SSQLListener); ok {
+		listenerT.ExitVector(s)
+	}
+}
+
+func (s *VectorContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SSQLVisitor:
+		return t.VisitVector(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SSQLParser) Vector() (localctx IVectorContext) {
+	localctx = NewVectorContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 32, SSQLParserRULE_vector)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(226)
+		p.Match(SSQLParserT__4)
+	}
+	p.SetState(228)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SSQLParserIDENTIFIER {
+		{
+			p.SetState(227)
+			p.Match(SSQLParserIDENTIFIER)
+		}
+
+	}
+	{
+		p.SetState(230)
+		p.Match(SSQLParserPATH)
+	}
+	p.SetState(237)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SSQLParserEQ, SSQLParserNEQ, SSQLParserIN, SSQLParserLT, SSQLParserLE, SSQLParserGE, SSQLParserGT, SSQLParserBETWEEN, SSQLParserCONTAIN, SSQLParserEXIST, SSQLParserTIMEFRAME, SSQLParserKEY:
+		{
+			p.SetState(231)
+			p.Predicate()
+		}
+
+	case SSQLParserT__4:
+		p.SetState(233)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
+
+		for ok := true; ok; ok = _la == SSQLParserT__4 {
+			{
+				p.SetState(232)
+				p.Vector()
+			}
+
+			p.SetState(235)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+
+	case SSQLParserT__5:
+
+	default:
 	}
 	{
-		p.SetState(168)
+		p.SetState(239)
+		p.Match(SSQLParserT__5)
+	}
+
+	return localctx
+}
+
+// IOrContext is an interface to support dynamic dispatch.
+type IOrContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsOrContext differentiates from other interfaces.
+	IsOrContext()
+}
+
+type OrContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyOrContext() *OrContext {
+	var p = new(OrContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SSQLParserRULE_or
+	return p
+}
+
+func (*OrContext) IsOrContext() {}
+
+func NewOrContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OrContext {
+	var p = new(OrContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SSQLParserRULE_or
+
+	return p
+}
+
+func (s *OrContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *OrContext) AllTuple() []ITupleContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ITupleContext)(nil)).Elem())
+	var tst = make([]ITupleContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(ITupleContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *OrContext) Tuple(i int) ITupleContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ITupleContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITupleContext)
+}
+
+func (s *OrContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *OrContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *OrContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SSQLListener); ok {
+		listenerT.EnterOr(s)
+	}
+}
+
+func (s *OrContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SSQLListener); ok {
+		listenerT.ExitOr(s)
+	}
+}
+
+func (s *OrContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SSQLVisitor:
+		return t.VisitOr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SSQLParser) Or() (localctx IOrContext) {
+	localctx = NewOrContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 34, SSQLParserRULE_or)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(241)
 		p.Match(SSQLParserT__6)
 	}
+	p.SetState(243)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for ok := true; ok; ok = (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SSQLParserT__4)|(1<<SSQLParserT__6)|(1<<SSQLParserT__8))) != 0) {
+		{
+			p.SetState(242)
+			p.Tuple()
+		}
+
+		p.SetState(245)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(247)
+		p.Match(SSQLParserT__7)
+	}
 
 	return localctx
 }
@@ -2113,7 +3422,7 @@ func (s *AndContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SSQLParser) And() (localctx IAndContext) {
 	localctx = NewAndContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 22, SSQLParserRULE_and)
+	p.EnterRule(localctx, 36, SSQLParserRULE_and)
 	var _la int
 
 	defer func() {
@@ -2134,26 +3443,26 @@ func (p *SSQLParser) And() (localctx IAndContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(170)
-		p.Match(SSQLParserT__7)
+		p.SetState(249)
+		p.Match(SSQLParserT__8)
 	}
-	p.SetState(172)
+	p.SetState(251)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
-	for ok := true; ok; ok = (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SSQLParserT__3)|(1<<SSQLParserT__5)|(1<<SSQLParserT__7))) != 0) {
+	for ok := true; ok; ok = (((_la)&-(0x1f+1)) == 0 && ((1<<uint(_la))&((1<<SSQLParserT__4)|(1<<SSQLParserT__6)|(1<<SSQLParserT__8))) != 0) {
 		{
-			p.SetState(171)
+			p.SetState(250)
 			p.Tuple()
 		}
 
-		p.SetState(174)
+		p.SetState(253)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(176)
-		p.Match(SSQLParserT__6)
+		p.SetState(255)
+		p.Match(SSQLParserT__7)
 	}
 
 	return localctx
@@ -2349,7 +3658,7 @@ func (s *PredicateContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SSQLParser) Predicate() (localctx IPredicateContext) {
 	localctx = NewPredicateContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 24, SSQLParserRULE_predicate)
+	p.EnterRule(localctx, 38, SSQLParserRULE_predicate)
 
 	defer func() {
 		p.ExitRule()
@@ -2367,91 +3676,91 @@ func (p *SSQLParser) Predicate() (localctx IPredicateContext) {
 		}
 	}()
 
-	p.SetState(190)
+	p.SetState(269)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SSQLParserEQ:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(178)
+			p.SetState(257)
 			p.Eq()
 		}
 
 	case SSQLParserNEQ:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(179)
+			p.SetState(258)
 			p.Neq()
 		}
 
 	case SSQLParserGT:
 		p.EnterOuterAlt(localctx, 3)
 		{
-			p.SetState(180)
+			p.SetState(259)
 			p.Gt()
 		}
 
 	case SSQLParserGE:
 		p.EnterOuterAlt(localctx, 4)
 		{
-			p.SetState(181)
+			p.SetState(260)
 			p.Ge()
 		}
 
 	case SSQLParserLT:
 		p.EnterOuterAlt(localctx, 5)
 		{
-			p.SetState(182)
+			p.SetState(261)
 			p.Lt()
 		}
 
 	case SSQLParserLE:
 		p.EnterOuterAlt(localctx, 6)
 		{
-			p.SetState(183)
+			p.SetState(262)
 			p.Le()
 		}
 
 	case SSQLParserIN:
 		p.EnterOuterAlt(localctx, 7)
 		{
-			p.SetState(184)
+			p.SetState(263)
 			p.In()
 		}
 
 	case SSQLParserBETWEEN:
 		p.EnterOuterAlt(localctx, 8)
 		{
-			p.SetState(185)
+			p.SetState(264)
 			p.Between()
 		}
 
 	case SSQLParserCONTAIN:
 		p.EnterOuterAlt(localctx, 9)
 		{
-			p.SetState(186)
+			p.SetState(265)
 			p.Contain()
 		}
 
 	case SSQLParserEXIST:
 		p.EnterOuterAlt(localctx, 10)
 		{
-			p.SetState(187)
+			p.SetState(266)
 			p.Exist()
 		}
 
 	case SSQLParserTIMEFRAME:
 		p.EnterOuterAlt(localctx, 11)
 		{
-			p.SetState(188)
+			p.SetState(267)
 			p.Timeframe()
 		}
 
 	case SSQLParserKEY:
 		p.EnterOuterAlt(localctx, 12)
 		{
-			p.SetState(189)
+			p.SetState(268)
 			p.Key()
 		}
 
@@ -2546,7 +3855,7 @@ func (s *EqContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SSQLParser) Eq() (localctx IEqContext) {
 	localctx = NewEqContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 26, SSQLParserRULE_eq)
+	p.EnterRule(localctx, 40, SSQLParserRULE_eq)
 
 	defer func() {
 		p.ExitRule()
@@ -2566,19 +3875,19 @@ func (p *SSQLParser) Eq() (localctx IEqContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(192)
+		p.SetState(271)
 		p.Match(SSQLParserEQ)
 	}
 	{
-		p.SetState(193)
+		p.SetState(272)
 		p.Match(SSQLParserT__1)
 	}
 	{
-		p.SetState(194)
+		p.SetState(273)
 		p.Scalar()
 	}
 	{
-		p.SetState(195)
+		p.SetState(274)
 		p.Match(SSQLParserT__2)
 	}
 
@@ -2669,7 +3978,7 @@ func (s *NeqContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SSQLParser) Neq() (localctx INeqContext) {
 	localctx = NewNeqContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 28, SSQLParserRULE_neq)
+	p.EnterRule(localctx, 42, SSQLParserRULE_neq)
 
 	defer func() {
 		p.ExitRule()
@@ -2689,19 +3998,19 @@ func (p *SSQLParser) Neq() (localctx INeqContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(197)
+		p.SetState(276)
 		p.Match(SSQLParserNEQ)
 	}
 	{
-		p.SetState(198)
+		p.SetState(277)
 		p.Match(SSQLParserT__1)
 	}
 	{
-		p.SetState(199)
+		p.SetState(278)
 		p.Scalar()
 	}
 	{
-		p.SetState(200)
+		p.SetState(279)
 		p.Match(SSQLParserT__2)
 	}
 
@@ -2792,7 +4101,7 @@ func (s *GtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SSQLParser) Gt() (localctx IGtContext) {
 	localctx = NewGtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 30, SSQLParserRULE_gt)
+	p.EnterRule(localctx, 44, SSQLParserRULE_gt)
 
 	defer func() {
 		p.ExitRule()
@@ -2812,19 +4121,19 @@ func (p *SSQLParser) Gt() (localctx IGtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(202)
+		p.SetState(281)
 		p.Match(SSQLParserGT)
 	}
 	{
-		p.SetState(203)
+		p.SetState(282)
 		p.Match(SSQLParserT__1)
 	}
 	{
-		p.SetState(204)
+		p.SetState(283)
 		p.Scalar()
 	}
 	{
-		p.SetState(205)
+		p.SetState(284)
 		p.Match(SSQLParserT__2)
 	}
 
@@ -2915,7 +4224,7 @@ func (s *GeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SSQLParser) Ge() (localctx IGeContext) {
 	localctx = NewGeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 32, SSQLParserRULE_ge)
+	p.EnterRule(localctx, 46, SSQLParserRULE_ge)
 
 	defer func() {
 		p.ExitRule()
@@ -2935,19 +4244,19 @@ func (p *SSQLParser) Ge() (localctx IGeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(207)
+		p.SetState(286)
 		p.Match(SSQLParserGE)
 	}
 	{
-		p.SetState(208)
+		p.SetState(287)
 		p.Match(SSQLParserT__1)
 	}
 	{
-		p.SetState(209)
+		p.SetState(288)
 		p.Scalar()
 	}
 	{
-		p.SetState(210)
+		p.SetState(289)
 		p.Match(SSQLParserT__2)
 	}
 
@@ -3038,7 +4347,7 @@ func (s *LtContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SSQLParser) Lt() (localctx ILtContext) {
 	localctx = NewLtContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 34, SSQLParserRULE_lt)
+	p.EnterRule(localctx, 48, SSQLParserRULE_lt)
 
 	defer func() {
 		p.ExitRule()
@@ -3058,19 +4367,19 @@ func (p *SSQLParser) Lt() (localctx ILtContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(212)
+		p.SetState(291)
 		p.Match(SSQLParserLT)
 	}
 	{
-		p.SetState(213)
+		p.SetState(292)
 		p.Match(SSQLParserT__1)
 	}
 	{
-		p.SetState(214)
+		p.SetState(293)
 		p.Scalar()
 	}
 	{
-		p.SetState(215)
+		p.SetState(294)
 		p.Match(SSQLParserT__2)
 	}
 
@@ -3113,14 +4422,273 @@ func NewLeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingS
 	return p
 }
 
-func (s *LeContext) GetParser() antlr.Parser { return s.parser }
+func (s *LeContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *LeContext) LE() antlr.TerminalNode {
+	return s.GetToken(SSQLParserLE, 0)
+}
+
+func (s *LeContext) Scalar() IScalarContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IScalarContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IScalarContext)
+}
+
+func (s *LeContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *LeContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SSQLListener); ok {
+		listenerT.EnterLe(s)
+	}
+}
+
+func (s *LeContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SSQLListener); ok {
+		listenerT.ExitLe(s)
+	}
+}
+
+func (s *LeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SSQLVisitor:
+		return t.VisitLe(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SSQLParser) Le() (localctx ILeContext) {
+	localctx = NewLeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 50, SSQLParserRULE_le)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(296)
+		p.Match(SSQLParserLE)
+	}
+	{
+		p.SetState(297)
+		p.Match(SSQLParserT__1)
+	}
+	{
+		p.SetState(298)
+		p.Scalar()
+	}
+	{
+		p.SetState(299)
+		p.Match(SSQLParserT__2)
+	}
+
+	return localctx
+}
+
+// IInContext is an interface to support dynamic dispatch.
+type IInContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsInContext differentiates from other interfaces.
+	IsInContext()
+}
+
+type InContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyInContext() *InContext {
+	var p = new(InContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SSQLParserRULE_in
+	return p
+}
+
+func (*InContext) IsInContext() {}
+
+func NewInContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *InContext {
+	var p = new(InContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SSQLParserRULE_in
+
+	return p
+}
+
+func (s *InContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *InContext) IN() antlr.TerminalNode {
+	return s.GetToken(SSQLParserIN, 0)
+}
+
+func (s *InContext) List() IListContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IListContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IListContext)
+}
+
+func (s *InContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *InContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *InContext) EnterRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SSQLListener); ok {
+		listenerT.EnterIn(s)
+	}
+}
+
+func (s *InContext) ExitRule(listener antlr.ParseTreeListener) {
+	if listenerT, ok := listener.(SSQLListener); ok {
+		listenerT.ExitIn(s)
+	}
+}
+
+func (s *InContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case SSQLVisitor:
+		return t.VisitIn(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *SSQLParser) In() (localctx IInContext) {
+	localctx = NewInContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 52, SSQLParserRULE_in)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(301)
+		p.Match(SSQLParserIN)
+	}
+	{
+		p.SetState(302)
+		p.Match(SSQLParserT__1)
+	}
+	{
+		p.SetState(303)
+		p.List()
+	}
+	{
+		p.SetState(304)
+		p.Match(SSQLParserT__2)
+	}
+
+	return localctx
+}
+
+// IBetweenContext is an interface to support dynamic dispatch.
+type IBetweenContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsBetweenContext differentiates from other interfaces.
+	IsBetweenContext()
+}
+
+type BetweenContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyBetweenContext() *BetweenContext {
+	var p = new(BetweenContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = SSQLParserRULE_between
+	return p
+}
+
+func (*BetweenContext) IsBetweenContext() {}
+
+func NewBetweenContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BetweenContext {
+	var p = new(BetweenContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = SSQLParserRULE_between
+
+	return p
+}
+
+func (s *BetweenContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *BetweenContext) BETWEEN() antlr.TerminalNode {
+	return s.GetToken(SSQLParserBETWEEN, 0)
+}
+
+func (s *BetweenContext) AllScalar() []IScalarContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IScalarContext)(nil)).Elem())
+	var tst = make([]IScalarContext, len(ts))
 
-func (s *LeContext) LE() antlr.TerminalNode {
-	return s.GetToken(SSQLParserLE, 0)
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IScalarContext)
+		}
+	}
+
+	return tst
 }
 
-func (s *LeContext) Scalar() IScalarContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IScalarContext)(nil)).Elem(), 0)
+func (s *BetweenContext) Scalar(i int) IScalarContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IScalarContext)(nil)).Elem(), i)
 
 	if t == nil {
 		return nil
@@ -3129,39 +4697,39 @@ func (s *LeContext) Scalar() IScalarContext {
 	return t.(IScalarContext)
 }
 
-func (s *LeContext) GetRuleContext() antlr.RuleContext {
+func (s *BetweenContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *LeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *BetweenContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *LeContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *BetweenContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.EnterLe(s)
+		listenerT.EnterBetween(s)
 	}
 }
 
-func (s *LeContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *BetweenContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.ExitLe(s)
+		listenerT.ExitBetween(s)
 	}
 }
 
-func (s *LeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *BetweenContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SSQLVisitor:
-		return t.VisitLe(s)
+		return t.VisitBetween(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SSQLParser) Le() (localctx ILeContext) {
-	localctx = NewLeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 36, SSQLParserRULE_le)
+func (p *SSQLParser) Between() (localctx IBetweenContext) {
+	localctx = NewBetweenContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 54, SSQLParserRULE_between)
 
 	defer func() {
 		p.ExitRule()
@@ -3181,110 +4749,112 @@ func (p *SSQLParser) Le() (localctx ILeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(217)
-		p.Match(SSQLParserLE)
+		p.SetState(306)
+		p.Match(SSQLParserBETWEEN)
 	}
 	{
-		p.SetState(218)
+		p.SetState(307)
 		p.Match(SSQLParserT__1)
 	}
 	{
-		p.SetState(219)
+		p.SetState(308)
 		p.Scalar()
 	}
 	{
-		p.SetState(220)
+		p.SetState(309)
+		p.Match(SSQLParserT__0)
+	}
+	{
+		p.SetState(310)
+		p.Scalar()
+	}
+	{
+		p.SetState(311)
 		p.Match(SSQLParserT__2)
 	}
 
 	return localctx
 }
 
-// IInContext is an interface to support dynamic dispatch.
-type IInContext interface {
+// IContainContext is an interface to support dynamic dispatch.
+type IContainContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsInContext differentiates from other interfaces.
-	IsInContext()
+	// IsContainContext differentiates from other interfaces.
+	IsContainContext()
 }
 
-type InContext struct {
+type ContainContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyInContext() *InContext {
-	var p = new(InContext)
+func NewEmptyContainContext() *ContainContext {
+	var p = new(ContainContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SSQLParserRULE_in
+	p.RuleIndex = SSQLParserRULE_contain
 	return p
 }
 
-func (*InContext) IsInContext() {}
+func (*ContainContext) IsContainContext() {}
 
-func NewInContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *InContext {
-	var p = new(InContext)
+func NewContainContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ContainContext {
+	var p = new(ContainContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SSQLParserRULE_in
+	p.RuleIndex = SSQLParserRULE_contain
 
 	return p
 }
 
-func (s *InContext) GetParser() antlr.Parser { return s.parser }
+func (s *ContainContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *InContext) IN() antlr.TerminalNode {
-	return s.GetToken(SSQLParserIN, 0)
+func (s *ContainContext) CONTAIN() antlr.TerminalNode {
+	return s.GetToken(SSQLParserCONTAIN, 0)
 }
 
-func (s *InContext) List() IListContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IListContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IListContext)
+func (s *ContainContext) STRING() antlr.TerminalNode {
+	return s.GetToken(SSQLParserSTRING, 0)
 }
 
-func (s *InContext) GetRuleContext() antlr.RuleContext {
+func (s *ContainContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *InContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ContainContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *InContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ContainContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.EnterIn(s)
+		listenerT.EnterContain(s)
 	}
 }
 
-func (s *InContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ContainContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.ExitIn(s)
+		listenerT.ExitContain(s)
 	}
 }
 
-func (s *InContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ContainContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SSQLVisitor:
-		return t.VisitIn(s)
+		return t.VisitContain(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SSQLParser) In() (localctx IInContext) {
-	localctx = NewInContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 38, SSQLParserRULE_in)
+func (p *SSQLParser) Contain() (localctx IContainContext) {
+	localctx = NewContainContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 56, SSQLParserRULE_contain)
 
 	defer func() {
 		p.ExitRule()
@@ -3304,116 +4874,101 @@ func (p *SSQLParser) In() (localctx IInContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(222)
-		p.Match(SSQLParserIN)
+		p.SetState(313)
+		p.Match(SSQLParserCONTAIN)
 	}
 	{
-		p.SetState(223)
+		p.SetState(314)
 		p.Match(SSQLParserT__1)
 	}
 	{
-		p.SetState(224)
-		p.List()
+		p.SetState(315)
+		p.Match(SSQLParserSTRING)
 	}
 	{
-		p.SetState(225)
+		p.SetState(316)
 		p.Match(SSQLParserT__2)
 	}
 
 	return localctx
 }
 
-// IBetweenContext is an interface to support dynamic dispatch.
-type IBetweenContext interface {
+// IExistContext is an interface to support dynamic dispatch.
+type IExistContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsBetweenContext differentiates from other interfaces.
-	IsBetweenContext()
+	// IsExistContext differentiates from other interfaces.
+	IsExistContext()
 }
 
-type BetweenContext struct {
+type ExistContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyBetweenContext() *BetweenContext {
-	var p = new(BetweenContext)
+func NewEmptyExistContext() *ExistContext {
+	var p = new(ExistContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SSQLParserRULE_between
+	p.RuleIndex = SSQLParserRULE_exist
 	return p
 }
 
-func (*BetweenContext) IsBetweenContext() {}
+func (*ExistContext) IsExistContext() {}
 
-func NewBetweenContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BetweenContext {
-	var p = new(BetweenContext)
+func NewExistContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExistContext {
+	var p = new(ExistContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SSQLParserRULE_between
+	p.RuleIndex = SSQLParserRULE_exist
 
 	return p
 }
 
-func (s *BetweenContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *BetweenContext) BETWEEN() antlr.TerminalNode {
-	return s.GetToken(SSQLParserBETWEEN, 0)
-}
-
-func (s *BetweenContext) AllINTEGER() []antlr.TerminalNode {
-	return s.GetTokens(SSQLParserINTEGER)
-}
-
-func (s *BetweenContext) INTEGER(i int) antlr.TerminalNode {
-	return s.GetToken(SSQLParserINTEGER, i)
-}
-
-func (s *BetweenContext) AllREAL_NUMBER() []antlr.TerminalNode {
-	return s.GetTokens(SSQLParserREAL_NUMBER)
-}
+func (s *ExistContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *BetweenContext) REAL_NUMBER(i int) antlr.TerminalNode {
-	return s.GetToken(SSQLParserREAL_NUMBER, i)
+func (s *ExistContext) EXIST() antlr.TerminalNode {
+	return s.GetToken(SSQLParserEXIST, 0)
 }
 
-func (s *BetweenContext) GetRuleContext() antlr.RuleContext {
+func (s *ExistContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *BetweenContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ExistContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *BetweenContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ExistContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.EnterBetween(s)
+		listenerT.EnterExist(s)
 	}
 }
 
-func (s *BetweenContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ExistContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.ExitBetween(s)
+		listenerT.ExitExist(s)
 	}
 }
 
-func (s *BetweenContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ExistContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SSQLVisitor:
-		return t.VisitBetween(s)
+		return t.VisitExist(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SSQLParser) Between() (localctx IBetweenContext) {
-	localctx = NewBetweenContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 40, SSQLParserRULE_between)
+func (p *SSQLParser) Exist() (localctx IExistContext) {
+	localctx = NewExistContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 58, SSQLParserRULE_exist)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -3431,60 +4986,22 @@ func (p *SSQLParser) Between() (localctx IBetweenContext) {
 		}
 	}()
 
-	p.SetState(239)
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(318)
+		p.Match(SSQLParserEXIST)
+	}
+	p.SetState(321)
 	p.GetErrorHandler().Sync(p)
-	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 17, p.GetParserRuleContext()) {
-	case 1:
-		p.EnterOuterAlt(localctx, 1)
-		{
-			p.SetState(227)
-			p.Match(SSQLParserBETWEEN)
-		}
-		{
-			p.SetState(228)
-			p.Match(SSQLParserT__1)
-		}
-		{
-			p.SetState(229)
-			p.Match(SSQLParserINTEGER)
-		}
-		{
-			p.SetState(230)
-			p.Match(SSQLParserT__0)
-		}
-		{
-			p.SetState(231)
-			p.Match(SSQLParserINTEGER)
-		}
-		{
-			p.SetState(232)
-			p.Match(SSQLParserT__2)
-		}
+	_la = p.GetTokenStream().LA(1)
 
-	case 2:
-		p.EnterOuterAlt(localctx, 2)
-		{
-			p.SetState(233)
-			p.Match(SSQLParserBETWEEN)
-		}
+	if _la == SSQLParserT__1 {
 		{
-			p.SetState(234)
+			p.SetState(319)
 			p.Match(SSQLParserT__1)
 		}
 		{
-			p.SetState(235)
-			p.Match(SSQLParserREAL_NUMBER)
-		}
-		{
-			p.SetState(236)
-			p.Match(SSQLParserT__0)
-		}
-		{
-			p.SetState(237)
-			p.Match(SSQLParserREAL_NUMBER)
-		}
-		{
-			p.SetState(238)
+			p.SetState(320)
 			p.Match(SSQLParserT__2)
 		}
 
@@ -3493,85 +5010,112 @@ func (p *SSQLParser) Between() (localctx IBetweenContext) {
 	return localctx
 }
 
-// IContainContext is an interface to support dynamic dispatch.
-type IContainContext interface {
+// ITimeframeContext is an interface to support dynamic dispatch.
+type ITimeframeContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsContainContext differentiates from other interfaces.
-	IsContainContext()
+	// IsTimeframeContext differentiates from other interfaces.
+	IsTimeframeContext()
 }
 
-type ContainContext struct {
+type TimeframeContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyContainContext() *ContainContext {
-	var p = new(ContainContext)
+func NewEmptyTimeframeContext() *TimeframeContext {
+	var p = new(TimeframeContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SSQLParserRULE_contain
+	p.RuleIndex = SSQLParserRULE_timeframe
 	return p
 }
 
-func (*ContainContext) IsContainContext() {}
+func (*TimeframeContext) IsTimeframeContext() {}
 
-func NewContainContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ContainContext {
-	var p = new(ContainContext)
+func NewTimeframeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TimeframeContext {
+	var p = new(TimeframeContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SSQLParserRULE_contain
+	p.RuleIndex = SSQLParserRULE_timeframe
+
+	return p
+}
+
+func (s *TimeframeContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TimeframeContext) TIMEFRAME() antlr.TerminalNode {
+	return s.GetToken(SSQLParserTIMEFRAME, 0)
+}
+
+func (s *TimeframeContext) AllMoment() []IMomentContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IMomentContext)(nil)).Elem())
+	var tst = make([]IMomentContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IMomentContext)
+		}
+	}
+
+	return tst
+}
+
+func (s *TimeframeContext) Moment(i int) IMomentContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IMomentContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
 
-	return p
+	return t.(IMomentContext)
 }
 
-func (s *ContainContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ContainContext) CONTAIN() antlr.TerminalNode {
-	return s.GetToken(SSQLParserCONTAIN, 0)
+func (s *TimeframeContext) LAST() antlr.TerminalNode {
+	return s.GetToken(SSQLParserLAST, 0)
 }
 
-func (s *ContainContext) STRING() antlr.TerminalNode {
-	return s.GetToken(SSQLParserSTRING, 0)
+func (s *TimeframeContext) DURATION() antlr.TerminalNode {
+	return s.GetToken(SSQLParserDURATION, 0)
 }
 
-func (s *ContainContext) GetRuleContext() antlr.RuleContext {
+func (s *TimeframeContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ContainContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *TimeframeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ContainContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *TimeframeContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.EnterContain(s)
+		listenerT.EnterTimeframe(s)
 	}
 }
 
-func (s *ContainContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *TimeframeContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.ExitContain(s)
+		listenerT.ExitTimeframe(s)
 	}
 }
 
-func (s *ContainContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *TimeframeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SSQLVisitor:
-		return t.VisitContain(s)
+		return t.VisitTimeframe(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SSQLParser) Contain() (localctx IContainContext) {
-	localctx = NewContainContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 42, SSQLParserRULE_contain)
+func (p *SSQLParser) Timeframe() (localctx ITimeframeContext) {
+	localctx = NewTimeframeContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 60, SSQLParserRULE_timeframe)
 
 	defer func() {
 		p.ExitRule()
@@ -3589,103 +5133,149 @@ func (p *SSQLParser) Contain() (localctx IContainContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(241)
-		p.Match(SSQLParserCONTAIN)
-	}
-	{
-		p.SetState(242)
-		p.Match(SSQLParserT__1)
-	}
-	{
-		p.SetState(243)
-		p.Match(SSQLParserSTRING)
-	}
-	{
-		p.SetState(244)
-		p.Match(SSQLParserT__2)
+	p.SetState(335)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 25, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(323)
+			p.Match(SSQLParserTIMEFRAME)
+		}
+		{
+			p.SetState(324)
+			p.Match(SSQLParserT__1)
+		}
+		{
+			p.SetState(325)
+			p.Moment()
+		}
+		{
+			p.SetState(326)
+			p.Match(SSQLParserT__0)
+		}
+		{
+			p.SetState(327)
+			p.Moment()
+		}
+		{
+			p.SetState(328)
+			p.Match(SSQLParserT__2)
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(330)
+			p.Match(SSQLParserTIMEFRAME)
+		}
+		{
+			p.SetState(331)
+			p.Match(SSQLParserT__1)
+		}
+		{
+			p.SetState(332)
+			p.Match(SSQLParserLAST)
+		}
+		{
+			p.SetState(333)
+			p.Match(SSQLParserDURATION)
+		}
+		{
+			p.SetState(334)
+			p.Match(SSQLParserT__2)
+		}
+
 	}
 
 	return localctx
 }
 
-// IExistContext is an interface to support dynamic dispatch.
-type IExistContext interface {
+// IMomentContext is an interface to support dynamic dispatch.
+type IMomentContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsExistContext differentiates from other interfaces.
-	IsExistContext()
+	// IsMomentContext differentiates from other interfaces.
+	IsMomentContext()
 }
 
-type ExistContext struct {
+type MomentContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyExistContext() *ExistContext {
-	var p = new(ExistContext)
+func NewEmptyMomentContext() *MomentContext {
+	var p = new(MomentContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SSQLParserRULE_exist
+	p.RuleIndex = SSQLParserRULE_moment
 	return p
 }
 
-func (*ExistContext) IsExistContext() {}
+func (*MomentContext) IsMomentContext() {}
 
-func NewExistContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExistContext {
-	var p = new(ExistContext)
+func NewMomentContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MomentContext {
+	var p = new(MomentContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SSQLParserRULE_exist
+	p.RuleIndex = SSQLParserRULE_moment
 
 	return p
 }
 
-func (s *ExistContext) GetParser() antlr.Parser { return s.parser }
+func (s *MomentContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ExistContext) EXIST() antlr.TerminalNode {
-	return s.GetToken(SSQLParserEXIST, 0)
+func (s *MomentContext) SignedInteger() ISignedIntegerContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISignedIntegerContext)(nil)).Elem(), 0)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISignedIntegerContext)
 }
 
-func (s *ExistContext) GetRuleContext() antlr.RuleContext {
+func (s *MomentContext) STRING() antlr.TerminalNode {
+	return s.GetToken(SSQLParserSTRING, 0)
+}
+
+func (s *MomentContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ExistContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *MomentContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ExistContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *MomentContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.EnterExist(s)
+		listenerT.EnterMoment(s)
 	}
 }
 
-func (s *ExistContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *MomentContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.ExitExist(s)
+		listenerT.ExitMoment(s)
 	}
 }
 
-func (s *ExistContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *MomentContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SSQLVisitor:
-		return t.VisitExist(s)
+		return t.VisitMoment(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SSQLParser) Exist() (localctx IExistContext) {
-	localctx = NewExistContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 44, SSQLParserRULE_exist)
-	var _la int
+func (p *SSQLParser) Moment() (localctx IMomentContext) {
+	localctx = NewMomentContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 62, SSQLParserRULE_moment)
 
 	defer func() {
 		p.ExitRule()
@@ -3703,113 +5293,138 @@ func (p *SSQLParser) Exist() (localctx IExistContext) {
 		}
 	}()
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(246)
-		p.Match(SSQLParserEXIST)
-	}
-	p.SetState(249)
+	p.SetState(339)
 	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
 
-	if _la == SSQLParserT__1 {
+	switch p.GetTokenStream().LA(1) {
+	case SSQLParserT__9, SSQLParserINTEGER:
+		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(247)
-			p.Match(SSQLParserT__1)
+			p.SetState(337)
+			p.SignedInteger()
 		}
+
+	case SSQLParserSTRING:
+		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(248)
-			p.Match(SSQLParserT__2)
+			p.SetState(338)
+			p.Match(SSQLParserSTRING)
 		}
 
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// ITimeframeContext is an interface to support dynamic dispatch.
-type ITimeframeContext interface {
+// IKeyContext is an interface to support dynamic dispatch.
+type IKeyContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsTimeframeContext differentiates from other interfaces.
-	IsTimeframeContext()
+	// IsKeyContext differentiates from other interfaces.
+	IsKeyContext()
 }
 
-type TimeframeContext struct {
+type KeyContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyTimeframeContext() *TimeframeContext {
-	var p = new(TimeframeContext)
+func NewEmptyKeyContext() *KeyContext {
+	var p = new(KeyContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SSQLParserRULE_timeframe
+	p.RuleIndex = SSQLParserRULE_key
 	return p
 }
 
-func (*TimeframeContext) IsTimeframeContext() {}
+func (*KeyContext) IsKeyContext() {}
 
-func NewTimeframeContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TimeframeContext {
-	var p = new(TimeframeContext)
+func NewKeyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *KeyContext {
+	var p = new(KeyContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SSQLParserRULE_timeframe
+	p.RuleIndex = SSQLParserRULE_key
 
 	return p
 }
 
-func (s *TimeframeContext) GetParser() antlr.Parser { return s.parser }
+func (s *KeyContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *TimeframeContext) TIMEFRAME() antlr.TerminalNode {
-	return s.GetToken(SSQLParserTIMEFRAME, 0)
+func (s *KeyContext) KEY() antlr.TerminalNode {
+	return s.GetToken(SSQLParserKEY, 0)
 }
 
-func (s *TimeframeContext) AllINTEGER() []antlr.TerminalNode {
-	return s.GetTokens(SSQLParserINTEGER)
+func (s *KeyContext) AllSignedInteger() []ISignedIntegerContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*ISignedIntegerContext)(nil)).Elem())
+	var tst = make([]ISignedIntegerContext, len(ts))
+
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(ISignedIntegerContext)
+		}
+	}
+
+	return tst
 }
 
-func (s *TimeframeContext) INTEGER(i int) antlr.TerminalNode {
-	return s.GetToken(SSQLParserINTEGER, i)
+func (s *KeyContext) SignedInteger(i int) ISignedIntegerContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*ISignedIntegerContext)(nil)).Elem(), i)
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISignedIntegerContext)
 }
 
-func (s *TimeframeContext) GetRuleContext() antlr.RuleContext {
+func (s *KeyContext) AllSTRING() []antlr.TerminalNode {
+	return s.GetTokens(SSQLParserSTRING)
+}
+
+func (s *KeyContext) STRING(i int) antlr.TerminalNode {
+	return s.GetToken(SSQLParserSTRING, i)
+}
+
+func (s *KeyContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *TimeframeContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *KeyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *TimeframeContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *KeyContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.EnterTimeframe(s)
+		listenerT.EnterKey(s)
 	}
 }
 
-func (s *TimeframeContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *KeyContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.ExitTimeframe(s)
+		listenerT.ExitKey(s)
 	}
 }
 
-func (s *TimeframeContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *KeyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SSQLVisitor:
-		return t.VisitTimeframe(s)
+		return t.VisitKey(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SSQLParser) Timeframe() (localctx ITimeframeContext) {
-	localctx = NewTimeframeContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 46, SSQLParserRULE_timeframe)
+func (p *SSQLParser) Key() (localctx IKeyContext) {
+	localctx = NewKeyContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 64, SSQLParserRULE_key)
+	var _la int
 
 	defer func() {
 		p.ExitRule()
@@ -3829,116 +5444,156 @@ func (p *SSQLParser) Timeframe() (localctx ITimeframeContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(251)
-		p.Match(SSQLParserTIMEFRAME)
+		p.SetState(341)
+		p.Match(SSQLParserKEY)
 	}
 	{
-		p.SetState(252)
+		p.SetState(342)
 		p.Match(SSQLParserT__1)
 	}
-	{
-		p.SetState(253)
-		p.Match(SSQLParserINTEGER)
-	}
-	{
-		p.SetState(254)
-		p.Match(SSQLParserT__0)
+	p.SetState(345)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SSQLParserT__9, SSQLParserINTEGER:
+		{
+			p.SetState(343)
+			p.SignedInteger()
+		}
+
+	case SSQLParserSTRING:
+		{
+			p.SetState(344)
+			p.Match(SSQLParserSTRING)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
-	{
-		p.SetState(255)
-		p.Match(SSQLParserINTEGER)
+	p.SetState(354)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == SSQLParserT__0 {
+		{
+			p.SetState(347)
+			p.Match(SSQLParserT__0)
+		}
+		p.SetState(350)
+		p.GetErrorHandler().Sync(p)
+
+		switch p.GetTokenStream().LA(1) {
+		case SSQLParserT__9, SSQLParserINTEGER:
+			{
+				p.SetState(348)
+				p.SignedInteger()
+			}
+
+		case SSQLParserSTRING:
+			{
+				p.SetState(349)
+				p.Match(SSQLParserSTRING)
+			}
+
+		default:
+			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		}
+
+		p.SetState(356)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 	}
 	{
-		p.SetState(256)
+		p.SetState(357)
 		p.Match(SSQLParserT__2)
 	}
 
 	return localctx
 }
 
-// IKeyContext is an interface to support dynamic dispatch.
-type IKeyContext interface {
+// IScalarContext is an interface to support dynamic dispatch.
+type IScalarContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsKeyContext differentiates from other interfaces.
-	IsKeyContext()
+	// IsScalarContext differentiates from other interfaces.
+	IsScalarContext()
 }
 
-type KeyContext struct {
+type ScalarContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyKeyContext() *KeyContext {
-	var p = new(KeyContext)
+func NewEmptyScalarContext() *ScalarContext {
+	var p = new(ScalarContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SSQLParserRULE_key
+	p.RuleIndex = SSQLParserRULE_scalar
 	return p
 }
 
-func (*KeyContext) IsKeyContext() {}
+func (*ScalarContext) IsScalarContext() {}
 
-func NewKeyContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *KeyContext {
-	var p = new(KeyContext)
+func NewScalarContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ScalarContext {
+	var p = new(ScalarContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SSQLParserRULE_key
+	p.RuleIndex = SSQLParserRULE_scalar
 
 	return p
 }
 
-func (s *KeyContext) GetParser() antlr.Parser { return s.parser }
+func (s *ScalarContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *KeyContext) KEY() antlr.TerminalNode {
-	return s.GetToken(SSQLParserKEY, 0)
+func (s *ScalarContext) REAL_NUMBER() antlr.TerminalNode {
+	return s.GetToken(SSQLParserREAL_NUMBER, 0)
 }
 
-func (s *KeyContext) INTEGER() antlr.TerminalNode {
-	return s.GetToken(SSQLParserINTEGER, 0)
+func (s *ScalarContext) AllINTEGER() []antlr.TerminalNode {
+	return s.GetTokens(SSQLParserINTEGER)
 }
 
-func (s *KeyContext) STRING() antlr.TerminalNode {
-	return s.GetToken(SSQLParserSTRING, 0)
+func (s *ScalarContext) INTEGER(i int) antlr.TerminalNode {
+	return s.GetToken(SSQLParserINTEGER, i)
 }
 
-func (s *KeyContext) GetRuleContext() antlr.RuleContext {
+func (s *ScalarContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *KeyContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ScalarContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *KeyContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ScalarContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.EnterKey(s)
+		listenerT.EnterScalar(s)
 	}
 }
 
-func (s *KeyContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ScalarContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.ExitKey(s)
+		listenerT.ExitScalar(s)
 	}
 }
 
-func (s *KeyContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ScalarContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SSQLVisitor:
-		return t.VisitKey(s)
+		return t.VisitScalar(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SSQLParser) Key() (localctx IKeyContext) {
-	localctx = NewKeyContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 48, SSQLParserRULE_key)
+func (p *SSQLParser) Scalar() (localctx IScalarContext) {
+	localctx = NewScalarContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 66, SSQLParserRULE_scalar)
 	var _la int
 
 	defer func() {
@@ -3958,116 +5613,129 @@ func (p *SSQLParser) Key() (localctx IKeyContext) {
 	}()
 
 	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(258)
-		p.Match(SSQLParserKEY)
-	}
-	{
-		p.SetState(259)
-		p.Match(SSQLParserT__1)
+	p.SetState(360)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == SSQLParserT__9 {
+		{
+			p.SetState(359)
+			p.Match(SSQLParserT__9)
+		}
+
 	}
-	{
-		p.SetState(260)
+	p.SetState(368)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case SSQLParserREAL_NUMBER:
+		{
+			p.SetState(362)
+			p.Match(SSQLParserREAL_NUMBER)
+		}
+
+	case SSQLParserINTEGER:
+		p.SetState(364)
+		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 
-		if !(_la == SSQLParserSTRING || _la == SSQLParserINTEGER) {
-			p.GetErrorHandler().RecoverInline(p)
-		} else {
-			p.GetErrorHandler().ReportMatch(p)
-			p.Consume()
+		for ok := true; ok; ok = _la == SSQLParserINTEGER {
+			{
+				p.SetState(363)
+				p.Match(SSQLParserINTEGER)
+			}
+
+			p.SetState(366)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
 		}
-	}
-	{
-		p.SetState(261)
-		p.Match(SSQLParserT__2)
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
 	}
 
 	return localctx
 }
 
-// IScalarContext is an interface to support dynamic dispatch.
-type IScalarContext interface {
+// ISignedIntegerContext is an interface to support dynamic dispatch.
+type ISignedIntegerContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsScalarContext differentiates from other interfaces.
-	IsScalarContext()
+	// IsSignedIntegerContext differentiates from other interfaces.
+	IsSignedIntegerContext()
 }
 
-type ScalarContext struct {
+type SignedIntegerContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyScalarContext() *ScalarContext {
-	var p = new(ScalarContext)
+func NewEmptySignedIntegerContext() *SignedIntegerContext {
+	var p = new(SignedIntegerContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SSQLParserRULE_scalar
+	p.RuleIndex = SSQLParserRULE_signedInteger
 	return p
 }
 
-func (*ScalarContext) IsScalarContext() {}
+func (*SignedIntegerContext) IsSignedIntegerContext() {}
 
-func NewScalarContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ScalarContext {
-	var p = new(ScalarContext)
+func NewSignedIntegerContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SignedIntegerContext {
+	var p = new(SignedIntegerContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SSQLParserRULE_scalar
+	p.RuleIndex = SSQLParserRULE_signedInteger
 
 	return p
 }
 
-func (s *ScalarContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *ScalarContext) REAL_NUMBER() antlr.TerminalNode {
-	return s.GetToken(SSQLParserREAL_NUMBER, 0)
-}
+func (s *SignedIntegerContext) GetParser() antlr.Parser { return s.parser }
 
-func (s *ScalarContext) AllINTEGER() []antlr.TerminalNode {
+func (s *SignedIntegerContext) AllINTEGER() []antlr.TerminalNode {
 	return s.GetTokens(SSQLParserINTEGER)
 }
 
-func (s *ScalarContext) INTEGER(i int) antlr.TerminalNode {
+func (s *SignedIntegerContext) INTEGER(i int) antlr.TerminalNode {
 	return s.GetToken(SSQLParserINTEGER, i)
 }
 
-func (s *ScalarContext) GetRuleContext() antlr.RuleContext {
+func (s *SignedIntegerContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *ScalarContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *SignedIntegerContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *ScalarContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *SignedIntegerContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.EnterScalar(s)
+		listenerT.EnterSignedInteger(s)
 	}
 }
 
-func (s *ScalarContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *SignedIntegerContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.ExitScalar(s)
+		listenerT.ExitSignedInteger(s)
 	}
 }
 
-func (s *ScalarContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *SignedIntegerContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SSQLVisitor:
-		return t.VisitScalar(s)
+		return t.VisitSignedInteger(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SSQLParser) Scalar() (localctx IScalarContext) {
-	localctx = NewScalarContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 50, SSQLParserRULE_scalar)
+func (p *SSQLParser) SignedInteger() (localctx ISignedIntegerContext) {
+	localctx = NewSignedIntegerContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 68, SSQLParserRULE_signedInteger)
 	var _la int
 
 	defer func() {
@@ -4086,36 +5754,31 @@ func (p *SSQLParser) Scalar() (localctx IScalarContext) {
 		}
 	}()
 
-	p.SetState(269)
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(371)
 	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-	switch p.GetTokenStream().LA(1) {
-	case SSQLParserREAL_NUMBER:
-		p.EnterOuterAlt(localctx, 1)
+	if _la == SSQLParserT__9 {
 		{
-			p.SetState(263)
-			p.Match(SSQLParserREAL_NUMBER)
+			p.SetState(370)
+			p.Match(SSQLParserT__9)
 		}
 
-	case SSQLParserINTEGER:
-		p.EnterOuterAlt(localctx, 2)
-		p.SetState(265)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
-
-		for ok := true; ok; ok = _la == SSQLParserINTEGER {
-			{
-				p.SetState(264)
-				p.Match(SSQLParserINTEGER)
-			}
+	}
+	p.SetState(374)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
 
-			p.SetState(267)
-			p.GetErrorHandler().Sync(p)
-			_la = p.GetTokenStream().LA(1)
+	for ok := true; ok; ok = _la == SSQLParserINTEGER {
+		{
+			p.SetState(373)
+			p.Match(SSQLParserINTEGER)
 		}
 
-	default:
-		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		p.SetState(376)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
 	}
 
 	return localctx
@@ -4169,24 +5832,14 @@ func (s *ListContext) StringList() IStringListContext {
 	return t.(IStringListContext)
 }
 
-func (s *ListContext) DoubleList() IDoubleListContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IDoubleListContext)(nil)).Elem(), 0)
+func (s *ListContext) ScalarList() IScalarListContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IScalarListContext)(nil)).Elem(), 0)
 
 	if t == nil {
 		return nil
 	}
 
-	return t.(IDoubleListContext)
-}
-
-func (s *ListContext) IntList() IIntListContext {
-	var t = s.GetTypedRuleContext(reflect.TypeOf((*IIntListContext)(nil)).Elem(), 0)
-
-	if t == nil {
-		return nil
-	}
-
-	return t.(IIntListContext)
+	return t.(IScalarListContext)
 }
 
 func (s *ListContext) GetRuleContext() antlr.RuleContext {
@@ -4221,7 +5874,7 @@ func (s *ListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SSQLParser) List() (localctx IListContext) {
 	localctx = NewListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 52, SSQLParserRULE_list)
+	p.EnterRule(localctx, 70, SSQLParserRULE_list)
 
 	defer func() {
 		p.ExitRule()
@@ -4239,29 +5892,22 @@ func (p *SSQLParser) List() (localctx IListContext) {
 		}
 	}()
 
-	p.SetState(274)
+	p.SetState(380)
 	p.GetErrorHandler().Sync(p)
 
 	switch p.GetTokenStream().LA(1) {
 	case SSQLParserSTRING:
 		p.EnterOuterAlt(localctx, 1)
 		{
-			p.SetState(271)
+			p.SetState(378)
 			p.StringList()
 		}
 
-	case SSQLParserREAL_NUMBER:
+	case SSQLParserT__9, SSQLParserINTEGER, SSQLParserREAL_NUMBER:
 		p.EnterOuterAlt(localctx, 2)
 		{
-			p.SetState(272)
-			p.DoubleList()
-		}
-
-	case SSQLParserINTEGER:
-		p.EnterOuterAlt(localctx, 3)
-		{
-			p.SetState(273)
-			p.IntList()
+			p.SetState(379)
+			p.ScalarList()
 		}
 
 	default:
@@ -4349,7 +5995,7 @@ func (s *StringListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SSQLParser) StringList() (localctx IStringListContext) {
 	localctx = NewStringListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 54, SSQLParserRULE_stringList)
+	p.EnterRule(localctx, 72, SSQLParserRULE_stringList)
 	var _la int
 
 	defer func() {
@@ -4370,24 +6016,24 @@ func (p *SSQLParser) StringList() (localctx IStringListContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(276)
+		p.SetState(382)
 		p.Match(SSQLParserSTRING)
 	}
-	p.SetState(281)
+	p.SetState(387)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SSQLParserT__0 {
 		{
-			p.SetState(277)
+			p.SetState(383)
 			p.Match(SSQLParserT__0)
 		}
 		{
-			p.SetState(278)
+			p.SetState(384)
 			p.Match(SSQLParserSTRING)
 		}
 
-		p.SetState(283)
+		p.SetState(389)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -4395,209 +6041,100 @@ func (p *SSQLParser) StringList() (localctx IStringListContext) {
 	return localctx
 }
 
-// IDoubleListContext is an interface to support dynamic dispatch.
-type IDoubleListContext interface {
+// IScalarListContext is an interface to support dynamic dispatch.
+type IScalarListContext interface {
 	antlr.ParserRuleContext
 
 	// GetParser returns the parser.
 	GetParser() antlr.Parser
 
-	// IsDoubleListContext differentiates from other interfaces.
-	IsDoubleListContext()
+	// IsScalarListContext differentiates from other interfaces.
+	IsScalarListContext()
 }
 
-type DoubleListContext struct {
+type ScalarListContext struct {
 	*antlr.BaseParserRuleContext
 	parser antlr.Parser
 }
 
-func NewEmptyDoubleListContext() *DoubleListContext {
-	var p = new(DoubleListContext)
+func NewEmptyScalarListContext() *ScalarListContext {
+	var p = new(ScalarListContext)
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SSQLParserRULE_doubleList
+	p.RuleIndex = SSQLParserRULE_scalarList
 	return p
 }
 
-func (*DoubleListContext) IsDoubleListContext() {}
+func (*ScalarListContext) IsScalarListContext() {}
 
-func NewDoubleListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DoubleListContext {
-	var p = new(DoubleListContext)
+func NewScalarListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ScalarListContext {
+	var p = new(ScalarListContext)
 
 	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
 
 	p.parser = parser
-	p.RuleIndex = SSQLParserRULE_doubleList
+	p.RuleIndex = SSQLParserRULE_scalarList
 
 	return p
 }
 
-func (s *DoubleListContext) GetParser() antlr.Parser { return s.parser }
-
-func (s *DoubleListContext) AllREAL_NUMBER() []antlr.TerminalNode {
-	return s.GetTokens(SSQLParserREAL_NUMBER)
-}
-
-func (s *DoubleListContext) REAL_NUMBER(i int) antlr.TerminalNode {
-	return s.GetToken(SSQLParserREAL_NUMBER, i)
-}
-
-func (s *DoubleListContext) GetRuleContext() antlr.RuleContext {
-	return s
-}
-
-func (s *DoubleListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
-	return antlr.TreesStringTree(s, ruleNames, recog)
-}
-
-func (s *DoubleListContext) EnterRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.EnterDoubleList(s)
-	}
-}
-
-func (s *DoubleListContext) ExitRule(listener antlr.ParseTreeListener) {
-	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.ExitDoubleList(s)
-	}
-}
-
-func (s *DoubleListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
-	switch t := visitor.(type) {
-	case SSQLVisitor:
-		return t.VisitDoubleList(s)
-
-	default:
-		return t.VisitChildren(s)
-	}
-}
-
-func (p *SSQLParser) DoubleList() (localctx IDoubleListContext) {
-	localctx = NewDoubleListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 56, SSQLParserRULE_doubleList)
-	var _la int
-
-	defer func() {
-		p.ExitRule()
-	}()
-
-	defer func() {
-		if err := recover(); err != nil {
-			if v, ok := err.(antlr.RecognitionException); ok {
-				localctx.SetException(v)
-				p.GetErrorHandler().ReportError(p, v)
-				p.GetErrorHandler().Recover(p, v)
-			} else {
-				panic(err)
-			}
-		}
-	}()
+func (s *ScalarListContext) GetParser() antlr.Parser { return s.parser }
 
-	p.EnterOuterAlt(localctx, 1)
-	{
-		p.SetState(284)
-		p.Match(SSQLParserREAL_NUMBER)
-	}
-	p.SetState(289)
-	p.GetErrorHandler().Sync(p)
-	_la = p.GetTokenStream().LA(1)
+func (s *ScalarListContext) AllScalar() []IScalarContext {
+	var ts = s.GetTypedRuleContexts(reflect.TypeOf((*IScalarContext)(nil)).Elem())
+	var tst = make([]IScalarContext, len(ts))
 
-	for _la == SSQLParserT__0 {
-		{
-			p.SetState(285)
-			p.Match(SSQLParserT__0)
-		}
-		{
-			p.SetState(286)
-			p.Match(SSQLParserREAL_NUMBER)
+	for i, t := range ts {
+		if t != nil {
+			tst[i] = t.(IScalarContext)
 		}
-
-		p.SetState(291)
-		p.GetErrorHandler().Sync(p)
-		_la = p.GetTokenStream().LA(1)
 	}
 
-	return localctx
-}
-
-// IIntListContext is an interface to support dynamic dispatch.
-type IIntListContext interface {
-	antlr.ParserRuleContext
-
-	// GetParser returns the parser.
-	GetParser() antlr.Parser
-
-	// IsIntListContext differentiates from other interfaces.
-	IsIntListContext()
-}
-
-type IntListContext struct {
-	*antlr.BaseParserRuleContext
-	parser antlr.Parser
-}
-
-func NewEmptyIntListContext() *IntListContext {
-	var p = new(IntListContext)
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
-	p.RuleIndex = SSQLParserRULE_intList
-	return p
-}
-
-func (*IntListContext) IsIntListContext() {}
-
-func NewIntListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *IntListContext {
-	var p = new(IntListContext)
-
-	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
-
-	p.parser = parser
-	p.RuleIndex = SSQLParserRULE_intList
-
-	return p
+	return tst
 }
 
-func (s *IntListContext) GetParser() antlr.Parser { return s.parser }
+func (s *ScalarListContext) Scalar(i int) IScalarContext {
+	var t = s.GetTypedRuleContext(reflect.TypeOf((*IScalarContext)(nil)).Elem(), i)
 
-func (s *IntListContext) AllINTEGER() []antlr.TerminalNode {
-	return s.GetTokens(SSQLParserINTEGER)
-}
+	if t == nil {
+		return nil
+	}
 
-func (s *IntListContext) INTEGER(i int) antlr.TerminalNode {
-	return s.GetToken(SSQLParserINTEGER, i)
+	return t.(IScalarContext)
 }
 
-func (s *IntListContext) GetRuleContext() antlr.RuleContext {
+func (s *ScalarListContext) GetRuleContext() antlr.RuleContext {
 	return s
 }
 
-func (s *IntListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+func (s *ScalarListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
 	return antlr.TreesStringTree(s, ruleNames, recog)
 }
 
-func (s *IntListContext) EnterRule(listener antlr.ParseTreeListener) {
+func (s *ScalarListContext) EnterRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.EnterIntList(s)
+		listenerT.EnterScalarList(s)
 	}
 }
 
-func (s *IntListContext) ExitRule(listener antlr.ParseTreeListener) {
+func (s *ScalarListContext) ExitRule(listener antlr.ParseTreeListener) {
 	if listenerT, ok := listener.(SSQLListener); ok {
-		listenerT.ExitIntList(s)
+		listenerT.ExitScalarList(s)
 	}
 }
 
-func (s *IntListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+func (s *ScalarListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 	switch t := visitor.(type) {
 	case SSQLVisitor:
-		return t.VisitIntList(s)
+		return t.VisitScalarList(s)
 
 	default:
 		return t.VisitChildren(s)
 	}
 }
 
-func (p *SSQLParser) IntList() (localctx IIntListContext) {
-	localctx = NewIntListContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 58, SSQLParserRULE_intList)
+func (p *SSQLParser) ScalarList() (localctx IScalarListContext) {
+	localctx = NewScalarListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 74, SSQLParserRULE_scalarList)
 	var _la int
 
 	defer func() {
@@ -4618,24 +6155,24 @@ func (p *SSQLParser) IntList() (localctx IIntListContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(292)
-		p.Match(SSQLParserINTEGER)
+		p.SetState(390)
+		p.Scalar()
 	}
-	p.SetState(297)
+	p.SetState(395)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SSQLParserT__0 {
 		{
-			p.SetState(293)
+			p.SetState(391)
 			p.Match(SSQLParserT__0)
 		}
 		{
-			p.SetState(294)
-			p.Match(SSQLParserINTEGER)
+			p.SetState(392)
+			p.Scalar()
 		}
 
-		p.SetState(299)
+		p.SetState(397)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -4740,7 +6277,7 @@ func (s *OrderByContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SSQLParser) OrderBy() (localctx IOrderByContext) {
 	localctx = NewOrderByContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 60, SSQLParserRULE_orderBy)
+	p.EnterRule(localctx, 76, SSQLParserRULE_orderBy)
 	var _la int
 
 	defer func() {
@@ -4761,28 +6298,28 @@ func (p *SSQLParser) OrderBy() (localctx IOrderByContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(300)
+		p.SetState(398)
 		p.Match(SSQLParserORDER_BY)
 	}
 	{
-		p.SetState(301)
+		p.SetState(399)
 		p.Order()
 	}
-	p.SetState(306)
+	p.SetState(404)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for _la == SSQLParserT__0 {
 		{
-			p.SetState(302)
+			p.SetState(400)
 			p.Match(SSQLParserT__0)
 		}
 		{
-			p.SetState(303)
+			p.SetState(401)
 			p.Order()
 		}
 
-		p.SetState(308)
+		p.SetState(406)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
@@ -4883,7 +6420,7 @@ func (s *OrderContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SSQLParser) Order() (localctx IOrderContext) {
 	localctx = NewOrderContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 62, SSQLParserRULE_order)
+	p.EnterRule(localctx, 78, SSQLParserRULE_order)
 	var _la int
 
 	defer func() {
@@ -4904,16 +6441,16 @@ func (p *SSQLParser) Order() (localctx IOrderContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(309)
+		p.SetState(407)
 		p.Match(SSQLParserIDENTIFIER)
 	}
-	p.SetState(311)
+	p.SetState(409)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	if _la == SSQLParserASC || _la == SSQLParserDESC {
 		{
-			p.SetState(310)
+			p.SetState(408)
 
 			var _lt = p.GetTokenStream().LT(1)
 
@@ -5018,7 +6555,7 @@ func (s *LimitContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
 
 func (p *SSQLParser) Limit() (localctx ILimitContext) {
 	localctx = NewLimitContext(p, p.GetParserRuleContext(), p.GetState())
-	p.EnterRule(localctx, 64, SSQLParserRULE_limit)
+	p.EnterRule(localctx, 80, SSQLParserRULE_limit)
 	var _la int
 
 	defer func() {
@@ -5039,20 +6576,20 @@ func (p *SSQLParser) Limit() (localctx ILimitContext) {
 
 	p.EnterOuterAlt(localctx, 1)
 	{
-		p.SetState(313)
+		p.SetState(411)
 		p.Match(SSQLParserLIMIT)
 	}
-	p.SetState(315)
+	p.SetState(413)
 	p.GetErrorHandler().Sync(p)
 	_la = p.GetTokenStream().LA(1)
 
 	for ok := true; ok; ok = _la == SSQLParserINTEGER {
 		{
-			p.SetState(314)
+			p.SetState(412)
 			p.Match(SSQLParserINTEGER)
 		}
 
-		p.SetState(317)
+		p.SetState(415)
 		p.GetErrorHandler().Sync(p)
 		_la = p.GetTokenStream().LA(1)
 	}
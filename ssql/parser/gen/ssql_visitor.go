@@ -22,6 +22,27 @@ type SSQLVisitor interface {
 	// Visit a parse tree produced by SSQLParser#percentile.
 	VisitPercentile(ctx *PercentileContext) interface{}
 
+	// Visit a parse tree produced by SSQLParser#countDistinct.
+	VisitCountDistinct(ctx *CountDistinctContext) interface{}
+
+	// Visit a parse tree produced by SSQLParser#firstLast.
+	VisitFirstLast(ctx *FirstLastContext) interface{}
+
+	// Visit a parse tree produced by SSQLParser#topK.
+	VisitTopK(ctx *TopKContext) interface{}
+
+	// Visit a parse tree produced by SSQLParser#histogram.
+	VisitHistogram(ctx *HistogramContext) interface{}
+
+	// Visit a parse tree produced by SSQLParser#from.
+	VisitFrom(ctx *FromContext) interface{}
+
+	// Visit a parse tree produced by SSQLParser#label.
+	VisitLabel(ctx *LabelContext) interface{}
+
+	// Visit a parse tree produced by SSQLParser#name.
+	VisitName(ctx *NameContext) interface{}
+
 	// Visit a parse tree produced by SSQLParser#groupBy.
 	VisitGroupBy(ctx *GroupByContext) interface{}
 
@@ -79,23 +100,26 @@ type SSQLVisitor interface {
 	// Visit a parse tree produced by SSQLParser#timeframe.
 	VisitTimeframe(ctx *TimeframeContext) interface{}
 
+	// Visit a parse tree produced by SSQLParser#moment.
+	VisitMoment(ctx *MomentContext) interface{}
+
 	// Visit a parse tree produced by SSQLParser#key.
 	VisitKey(ctx *KeyContext) interface{}
 
 	// Visit a parse tree produced by SSQLParser#scalar.
 	VisitScalar(ctx *ScalarContext) interface{}
 
+	// Visit a parse tree produced by SSQLParser#signedInteger.
+	VisitSignedInteger(ctx *SignedIntegerContext) interface{}
+
 	// Visit a parse tree produced by SSQLParser#list.
 	VisitList(ctx *ListContext) interface{}
 
 	// Visit a parse tree produced by SSQLParser#stringList.
 	VisitStringList(ctx *StringListContext) interface{}
 
-	// Visit a parse tree produced by SSQLParser#doubleList.
-	VisitDoubleList(ctx *DoubleListContext) interface{}
-
-	// Visit a parse tree produced by SSQLParser#intList.
-	VisitIntList(ctx *IntListContext) interface{}
+	// Visit a parse tree produced by SSQLParser#scalarList.
+	VisitScalarList(ctx *ScalarListContext) interface{}
 
 	// Visit a parse tree produced by SSQLParser#orderBy.
 	VisitOrderBy(ctx *OrderByContext) interface{}
@@ -20,7 +20,9 @@
 package parser
 
 import (
+	"math"
 	"strconv"
+	"strings"
 
 	"github.com/chronowave/chronowave/ssql"
 	"github.com/chronowave/chronowave/ssql/parser/gen"
@@ -104,69 +106,14 @@ func (p *parser) VisitIn(ctx *gen.InContext) interface{} {
 }
 
 func (p *parser) VisitBetween(ctx *gen.BetweenContext) interface{} {
-	operands := ctx.AllINTEGER()
-	if len(operands) > 0 {
-		first, err := strconv.ParseInt(operands[0].GetText(), 10, 64)
-		if err != nil {
-			// syntax error
-		}
-
-		second, err := strconv.ParseInt(operands[1].GetText(), 10, 64)
-		if err != nil {
-			// TODO: syntax error
-		}
-
-		if first >= second {
-			// TODO: semantic error
-		}
-
+	operands := ctx.AllScalar()
+	if len(operands) == 2 {
+		// TODO: semantic error if first is greater than second
 		return &ssql.Tuple{
 			Predicate: &ssql.Tuple_Between{
 				Between: &ssql.Binary{
-					First: &ssql.Operand{
-						Value: &ssql.Operand_Int{
-							Int: first,
-						},
-					},
-					Second: &ssql.Operand{
-						Value: &ssql.Operand_Int{
-							Int: second,
-						},
-					},
-				},
-			},
-		}
-	}
-
-	operands = ctx.AllREAL_NUMBER()
-	if len(operands) > 0 {
-		first, err := strconv.ParseFloat(operands[0].GetText(), 64)
-		if err != nil {
-			// syntax error
-		}
-
-		second, err := strconv.ParseFloat(operands[1].GetText(), 64)
-		if err != nil {
-			// TODO: syntax error
-		}
-
-		if first >= second {
-			// TODO: semantic error
-		}
-
-		return &ssql.Tuple{
-			Predicate: &ssql.Tuple_Between{
-				Between: &ssql.Binary{
-					First: &ssql.Operand{
-						Value: &ssql.Operand_Double{
-							Double: first,
-						},
-					},
-					Second: &ssql.Operand{
-						Value: &ssql.Operand_Double{
-							Double: second,
-						},
-					},
+					First:  p.VisitScalar(operands[0].(*gen.ScalarContext)).(*ssql.Operand),
+					Second: p.VisitScalar(operands[1].(*gen.ScalarContext)).(*ssql.Operand),
 				},
 			},
 		}
@@ -215,16 +162,20 @@ func (p *parser) VisitExist(ctx *gen.ExistContext) interface{} {
 	return &ssql.Tuple{Predicate: &ssql.Tuple_Exist{}}
 }
 
+// VisitScalar parses signed integer or real number, real number in scientific notation without
+// fraction, ie 1e3, is integer if it fits in int64
 func (p *parser) VisitScalar(ctx *gen.ScalarContext) interface{} {
+	text := ctx.GetText()
 	if r := ctx.REAL_NUMBER(); r != nil {
-		v, err := strconv.ParseFloat(r.GetText(), 64)
+		v, err := strconv.ParseFloat(text, 64)
 		if err != nil {
-			token := ctx.GetStart()
-			p.errors = append(p.errors, Error{
-				Line:    token.GetLine(),
-				Column:  token.GetColumn(),
-				Message: err.Error(),
-			})
+			p.error(ctx.GetStart(), err.Error())
+		}
+
+		if !strings.Contains(text, ".") && v == math.Trunc(v) && math.Abs(v) < math.MaxInt64 {
+			return &ssql.Operand{
+				Value: &ssql.Operand_Int{Int: int64(v)},
+			}
 		}
 
 		return &ssql.Operand{
@@ -232,14 +183,9 @@ func (p *parser) VisitScalar(ctx *gen.ScalarContext) interface{} {
 		}
 	}
 
-	v, err := strconv.ParseInt(ctx.GetText(), 10, 64)
+	v, err := strconv.ParseInt(text, 10, 64)
 	if err != nil {
-		token := ctx.GetStart()
-		p.errors = append(p.errors, Error{
-			Line:    token.GetLine(),
-			Column:  token.GetColumn(),
-			Message: err.Error(),
-		})
+		p.error(ctx.GetStart(), err.Error())
 	}
 
 	return &ssql.Operand{
@@ -247,27 +193,31 @@ func (p *parser) VisitScalar(ctx *gen.ScalarContext) interface{} {
 	}
 }
 
+// signedInteger parses integer with optional sign
+func (p *parser) signedInteger(ctx gen.ISignedIntegerContext) int64 {
+	v, err := strconv.ParseInt(ctx.GetText(), 10, 64)
+	if err != nil {
+		p.error(ctx.GetStart(), err.Error())
+	}
+	return v
+}
+
 func (p *parser) VisitList(ctx *gen.ListContext) interface{} {
 	str := ctx.StringList()
 	if str != nil {
 		return p.VisitStringList(str.(*gen.StringListContext))
 	}
 
-	real := ctx.DoubleList()
-	if real != nil {
-		return p.VisitDoubleList(real.(*gen.DoubleListContext))
-	}
-
-	ints := ctx.IntList()
-	if ints != nil {
-		return p.VisitIntList(ints.(*gen.IntListContext))
+	scalars := ctx.ScalarList()
+	if scalars != nil {
+		return p.VisitScalarList(scalars.(*gen.ScalarListContext))
 	}
 
 	token := ctx.GetStart()
 	p.errors = append(p.errors, Error{
 		Line:    token.GetLine(),
 		Column:  token.GetColumn(),
-		Message: "list must be string, double or integer",
+		Message: "list must be string or number",
 	})
 
 	return nil
@@ -288,50 +238,30 @@ func (p *parser) VisitStringList(ctx *gen.StringListContext) interface{} {
 	}
 }
 
-func (p *parser) VisitDoubleList(ctx *gen.DoubleListContext) interface{} {
-	doubles := make([]float64, 0, ctx.GetChildCount())
-	for _, n := range ctx.AllREAL_NUMBER() {
-		v, err := strconv.ParseFloat(n.GetText(), 64)
-		if err != nil {
-			token := ctx.GetStart()
-			p.errors = append(p.errors, Error{
-				Line:    token.GetLine(),
-				Column:  token.GetColumn(),
-				Message: err.Error(),
-			})
+// VisitScalarList returns list of integers or real numbers. Integers of list mixed with real numbers
+// are coerced to real numbers as in BETWEEN, and kept as integers as well to match integer values.
+func (p *parser) VisitScalarList(ctx *gen.ScalarListContext) interface{} {
+	var (
+		list ssql.List
+		ints []float64
+	)
+	for _, n := range ctx.AllScalar() {
+		switch v := p.VisitScalar(n.(*gen.ScalarContext)).(*ssql.Operand).Value.(type) {
+		case *ssql.Operand_Int:
+			list.Int = append(list.Int, v.Int)
+			ints = append(ints, float64(v.Int))
+		case *ssql.Operand_Double:
+			list.Double = append(list.Double, v.Double)
 		}
-		doubles = append(doubles, v)
 	}
 
-	return &ssql.Operand{
-		Value: &ssql.Operand_List{
-			List: &ssql.List{
-				Double: doubles,
-			},
-		},
-	}
-}
-
-func (p *parser) VisitIntList(ctx *gen.IntListContext) interface{} {
-	ints := make([]int64, 0, ctx.GetChildCount())
-	for _, n := range ctx.AllINTEGER() {
-		v, err := strconv.ParseInt(n.GetText(), 10, 64)
-		if err != nil {
-			token := ctx.GetStart()
-			p.errors = append(p.errors, Error{
-				Line:    token.GetLine(),
-				Column:  token.GetColumn(),
-				Message: err.Error(),
-			})
-		}
-		ints = append(ints, v)
+	if len(list.Double) > 0 {
+		list.Double = append(list.Double, ints...)
 	}
 
 	return &ssql.Operand{
 		Value: &ssql.Operand_List{
-			List: &ssql.List{
-				Int: ints,
-			},
+			List: &list,
 		},
 	}
 }
@@ -182,6 +182,39 @@ func TestGT(t *testing.T) {
 							Gt: &ssql.Unary{First: &ssql.Operand{Value: &ssql.Operand_Double{Double: 2.5}}}}}},
 				}}}, false,
 		},
+		{"gt_negative", args{"find $b where [$b /adf/adf gt(-5)]"},
+			&ssql.Statement{
+				Find: []*ssql.Attribute{{Name: "b"}},
+				Where: []*ssql.Expr{{
+					Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
+						Name: "b",
+						Path: "/adf/adf",
+						Predicate: &ssql.Tuple_Gt{
+							Gt: &ssql.Unary{First: &ssql.Operand{Value: &ssql.Operand_Int{Int: -5}}}}}},
+				}}}, false,
+		},
+		{"gt_scientific", args{"find $b where [$b /adf/adf gt(-2e6)]"},
+			&ssql.Statement{
+				Find: []*ssql.Attribute{{Name: "b"}},
+				Where: []*ssql.Expr{{
+					Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
+						Name: "b",
+						Path: "/adf/adf",
+						Predicate: &ssql.Tuple_Gt{
+							Gt: &ssql.Unary{First: &ssql.Operand{Value: &ssql.Operand_Int{Int: -2000000}}}}}},
+				}}}, false,
+		},
+		{"gt_negative_double", args{"find $b where [$b /adf/adf gt(-.5)]"},
+			&ssql.Statement{
+				Find: []*ssql.Attribute{{Name: "b"}},
+				Where: []*ssql.Expr{{
+					Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
+						Name: "b",
+						Path: "/adf/adf",
+						Predicate: &ssql.Tuple_Gt{
+							Gt: &ssql.Unary{First: &ssql.Operand{Value: &ssql.Operand_Double{Double: -0.5}}}}}},
+				}}}, false,
+		},
 		{"gt_string", args{"find $b where [$b /adf/adf gt('abc')]"},
 			&ssql.Statement{
 				Find: []*ssql.Attribute{{Name: "b"}},
@@ -432,6 +465,32 @@ func TestIN(t *testing.T) {
 								Value: &ssql.Operand_List{List: &ssql.List{Double: []float64{2.5, 2.0}}}}}}}},
 				}}}, false,
 		},
+		{"in_signed", args{"find $b where [$b /adf/adf in(-1, 0, 1)]"},
+			&ssql.Statement{
+				Find: []*ssql.Attribute{{Name: "b"}},
+				Where: []*ssql.Expr{{
+					Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
+						Name: "b",
+						Path: "/adf/adf",
+						Predicate: &ssql.Tuple_In{
+							In: &ssql.Unary{First: &ssql.Operand{
+								Value: &ssql.Operand_List{List: &ssql.List{Int: []int64{-1, 0, 1}}}}}}}},
+				}}}, false,
+		},
+		{"in_mixed", args{"find $b where [$b /adf/adf in(-2, 2.5, 1e3)]"},
+			&ssql.Statement{
+				Find: []*ssql.Attribute{{Name: "b"}},
+				Where: []*ssql.Expr{{
+					Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
+						Name: "b",
+						Path: "/adf/adf",
+						Predicate: &ssql.Tuple_In{
+							In: &ssql.Unary{First: &ssql.Operand{
+								Value: &ssql.Operand_List{List: &ssql.List{
+									Int:    []int64{-2, 1000},
+									Double: []float64{2.5, -2, 1000}}}}}}}},
+				}}}, false,
+		},
 		{"in_string", args{"find $b where [$b /adf/adf in('abc', 'adf')]"},
 			&ssql.Statement{
 				Find: []*ssql.Attribute{{Name: "b"}},
@@ -499,6 +558,32 @@ func TestBetween(t *testing.T) {
 								Second: &ssql.Operand{Value: &ssql.Operand_Double{Double: 5.0}}}}}},
 				}}}, false,
 		},
+		{"between_signed", args{"find $b where [$b /adf/adf between(-10, 10)]"},
+			&ssql.Statement{
+				Find: []*ssql.Attribute{{Name: "b"}},
+				Where: []*ssql.Expr{{
+					Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
+						Name: "b",
+						Path: "/adf/adf",
+						Predicate: &ssql.Tuple_Between{
+							Between: &ssql.Binary{
+								First:  &ssql.Operand{Value: &ssql.Operand_Int{Int: -10}},
+								Second: &ssql.Operand{Value: &ssql.Operand_Int{Int: 10}}}}}},
+				}}}, false,
+		},
+		{"between_mixed", args{"find $b where [$b /adf/adf between(-1.5E2, 3)]"},
+			&ssql.Statement{
+				Find: []*ssql.Attribute{{Name: "b"}},
+				Where: []*ssql.Expr{{
+					Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
+						Name: "b",
+						Path: "/adf/adf",
+						Predicate: &ssql.Tuple_Between{
+							Between: &ssql.Binary{
+								First:  &ssql.Operand{Value: &ssql.Operand_Double{Double: -150}},
+								Second: &ssql.Operand{Value: &ssql.Operand_Int{Int: 3}}}}}},
+				}}}, false,
+		},
 		{"between_string", args{"find $b where [$b /adf/adf between('abc', 'adf')]"},
 			&ssql.Statement{
 				Find: []*ssql.Attribute{{Name: "b"}},
@@ -29,7 +29,9 @@ import (
 
 func (p *parser) VisitOrderBy(ctx *gen.OrderByContext) interface{} {
 	for _, order := range ctx.AllOrder() {
-		p.stmt.OrderBy = append(p.stmt.OrderBy, p.VisitOrder(order.(*gen.OrderContext)).(*ssql.OrderBy))
+		orderby := p.VisitOrder(order.(*gen.OrderContext)).(*ssql.OrderBy)
+		p.stmt.OrderBy = append(p.stmt.OrderBy, orderby)
+		p.tokens[orderby] = order.GetStart()
 	}
 	return nil
 }
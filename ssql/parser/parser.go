@@ -22,6 +22,7 @@ package parser
 import (
 	"fmt"
 	"sync"
+	"time"
 
 	resources "github.com/antlr/antlr4/doc/resources"
 	"github.com/antlr/antlr4/runtime/Go/antlr"
@@ -38,6 +39,9 @@ type parser struct {
 	gen.BaseSSQLVisitor
 	errors []Error
 	stmt   *ssql.Statement
+	epoch  func(time.Time) int64
+	// tokens locates attributes of FIND and ORDER-BY in query for semantic errors
+	tokens map[interface{}]antlr.Token
 }
 
 var (
@@ -55,8 +59,16 @@ var (
 )
 
 func Parse(query string) (*ssql.Statement, []Error) {
-	p := parser{stmt: &ssql.Statement{}}
+	return ParseWithEpoch(query, EpochTime)
+}
+
+// ParseWithEpoch parses query, time expressions in TIMEFRAME are converted by epoch
+func ParseWithEpoch(query string, epoch func(time.Time) int64) (*ssql.Statement, []Error) {
+	p := parser{stmt: &ssql.Statement{}, epoch: epoch, tokens: map[interface{}]antlr.Token{}}
 	p.parse(query)
+	if len(p.errors) == 0 {
+		p.validate()
+	}
 	return p.stmt, p.errors
 }
 
@@ -74,7 +86,15 @@ func (p *parser) parse(query string) {
 	lexer.AddErrorListener(p)
 	prsr.AddErrorListener(p)
 
-	p.Visit(prsr.Start())
+	tree := prsr.Start()
+	defer func() {
+		// visitors expect complete parse tree, syntax errors may leave it incomplete
+		if r := recover(); r != nil && len(p.errors) == 0 {
+			panic(r)
+		}
+	}()
+
+	p.Visit(tree)
 }
 
 // Visitor implementations.
@@ -84,6 +104,8 @@ func (p *parser) Visit(tree antlr.ParseTree) interface{} {
 		return p.VisitStart(tree.(*gen.StartContext))
 	case *gen.SelectionContext:
 		return p.VisitSelection(tree.(*gen.SelectionContext))
+	case *gen.FromContext:
+		return p.VisitFrom(tree.(*gen.FromContext))
 	case *gen.ExpressionContext:
 		return p.VisitExpression(tree.(*gen.ExpressionContext))
 	case *gen.TupleContext:
@@ -104,10 +126,14 @@ func (p *parser) Visit(tree antlr.ParseTree) interface{} {
 
 	// Report at least one error if the parser reaches an unknown parse element.
 	// Typically, this happens if the parser has already encountered a syntax error elsewhere.
-	if len(p.errors) > 0 {
-		fmt.Printf("error : %v\n", p.errors)
+	if len(p.errors) == 0 {
+		if rule, ok := tree.(antlr.ParserRuleContext); ok {
+			p.error(rule.GetStart(), fmt.Sprintf("unexpected %T", tree))
+		} else {
+			p.errors = append(p.errors, Error{Message: fmt.Sprintf("unexpected %T", tree)})
+		}
 	}
-	panic("unknown parser state due to previous errors")
+	return nil
 }
 
 func (p *parser) VisitChildren(node antlr.RuleNode) interface{} {
@@ -118,5 +144,6 @@ func (p *parser) VisitChildren(node antlr.RuleNode) interface{} {
 }
 
 func (p *parser) VisitStart(ctx *gen.StartContext) interface{} {
+	p.stmt.Explain = ctx.EXPLAIN() != nil
 	return p.VisitChildren(ctx)
 }
@@ -43,7 +43,7 @@ func TestParse(t *testing.T) {
 			args{`find group-by(part($c,10),$b), avg($g)
                               where [$b /adf/adf eq(10)]
                                     [$g /dkf/adf]
-                                    [/adf/dkf [/df between(15, 20)]
+                                    [$c /adf/dkf [/df between(15, 20)]
                                               [/adf eq(2.5)]
                                     ]`},
 			&ssql.Statement{
@@ -77,6 +77,7 @@ func TestParse(t *testing.T) {
 					},
 					{
 						Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
+							Name: "c",
 							Path: "/adf/dkf",
 							Predicate: &ssql.Tuple_Nested{
 								Nested: &ssql.Nested{
@@ -106,6 +107,37 @@ func TestParse(t *testing.T) {
 						},
 					}}},
 		},
+		{
+			"quoted_path",
+			args{`find $g where [$g /"http/status"/code eq(0)]`},
+			&ssql.Statement{
+				Find: []*ssql.Attribute{{Name: "g"}},
+				Where: []*ssql.Expr{
+					{
+						Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
+							Name: "g",
+							Path: `/"http/status"/code`,
+							Predicate: &ssql.Tuple_Eq{
+								Eq: &ssql.Unary{First: &ssql.Operand{Value: &ssql.Operand_Int{Int: 0}}}}}},
+					}},
+			},
+		},
+		{
+			"explain",
+			args{`EXPLAIN find $g where [$g /adf/adf eq(0)]`},
+			&ssql.Statement{
+				Find: []*ssql.Attribute{{Name: "g"}},
+				Where: []*ssql.Expr{
+					{
+						Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
+							Name: "g",
+							Path: "/adf/adf",
+							Predicate: &ssql.Tuple_Eq{
+								Eq: &ssql.Unary{First: &ssql.Operand{Value: &ssql.Operand_Int{Int: 0}}}}}},
+					}},
+				Explain: true,
+			},
+		},
 		{
 			"zero_int",
 			args{`find $g where [$g /adf/adf eq(0)]`},
@@ -153,9 +185,9 @@ func TestParse(t *testing.T) {
 		},
 		{
 			"nested",
-			args{`find $g where [$r / [/adf/adf eq(0.0)]]`},
+			args{`find $r where [$r / [/adf/adf eq(0.0)]]`},
 			&ssql.Statement{
-				Find: []*ssql.Attribute{{Name: "g"}},
+				Find: []*ssql.Attribute{{Name: "r"}},
 				Where: []*ssql.Expr{
 					{
 						Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
@@ -177,7 +209,7 @@ func TestParse(t *testing.T) {
 		},
 		{
 			"or",
-			args{`find $g where {[/adf/adf eq(0.0)] [/d lt(1)]}`},
+			args{`find $g where {[$g /adf/adf eq(0.0)] [/d lt(1)]}`},
 			&ssql.Statement{
 				Find: []*ssql.Attribute{{Name: "g"}},
 				Where: []*ssql.Expr{{
@@ -185,6 +217,7 @@ func TestParse(t *testing.T) {
 						Expr: []*ssql.Expr{
 							{
 								Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
+									Name: "g",
 									Path: "/adf/adf",
 									Predicate: &ssql.Tuple_Eq{
 										Eq: &ssql.Unary{First: &ssql.Operand{Value: &ssql.Operand_Double{Double: 0}}},
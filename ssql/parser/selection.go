@@ -22,29 +22,42 @@ package parser
 import (
 	"strconv"
 
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+
 	"github.com/chronowave/chronowave/ssql"
 	"github.com/chronowave/chronowave/ssql/parser/gen"
 )
 
 func (p *parser) VisitSelection(ctx *gen.SelectionContext) interface{} {
 	for _, n := range ctx.AllAttribute() {
-		p.stmt.Find = append(p.stmt.Find, p.VisitAttribute(n.(*gen.AttributeContext)).([]*ssql.Attribute)...)
+		for _, attr := range p.VisitAttribute(n.(*gen.AttributeContext)).([]*ssql.Attribute) {
+			p.find(attr, n.GetStart())
+		}
 	}
 
 	for _, n := range ctx.AllGroupBy() {
-		p.stmt.Find = append(p.stmt.Find, p.VisitGroupBy(n.(*gen.GroupByContext)).(*ssql.Attribute))
+		p.find(p.VisitGroupBy(n.(*gen.GroupByContext)).(*ssql.Attribute), n.GetStart())
 	}
 
 	for _, n := range ctx.AllAggregate() {
-		p.stmt.Find = append(p.stmt.Find, p.VisitAggregate(n.(*gen.AggregateContext)).(*ssql.Attribute))
+		p.find(p.VisitAggregate(n.(*gen.AggregateContext)).(*ssql.Attribute), n.GetStart())
 	}
 
 	return nil
 }
 
+// find appends attribute to FIND, token locates the attribute in query
+func (p *parser) find(attr *ssql.Attribute, token antlr.Token) {
+	if attr == nil {
+		return
+	}
+	p.stmt.Find = append(p.stmt.Find, attr)
+	p.tokens[attr] = token
+}
+
 func (p *parser) VisitAttribute(ctx *gen.AttributeContext) interface{} {
 	if f := ctx.Aggregate(); f != nil {
-		return p.VisitAggregate(f.(*gen.AggregateContext))
+		return []*ssql.Attribute{p.VisitAggregate(f.(*gen.AggregateContext)).(*ssql.Attribute)}
 	}
 
 	return []*ssql.Attribute{{
@@ -55,9 +68,17 @@ func (p *parser) VisitAttribute(ctx *gen.AttributeContext) interface{} {
 func (p *parser) VisitAggregate(ctx *gen.AggregateContext) interface{} {
 	if pctl := ctx.Percentile(); pctl != nil {
 		return p.VisitPercentile(pctl.(*gen.PercentileContext))
+	} else if distinct := ctx.CountDistinct(); distinct != nil {
+		return p.VisitCountDistinct(distinct.(*gen.CountDistinctContext))
+	} else if fl := ctx.FirstLast(); fl != nil {
+		return p.VisitFirstLast(fl.(*gen.FirstLastContext))
+	} else if topk := ctx.TopK(); topk != nil {
+		return p.VisitTopK(topk.(*gen.TopKContext))
+	} else if hist := ctx.Histogram(); hist != nil {
+		return p.VisitHistogram(hist.(*gen.HistogramContext))
 	}
 
-	// AVG | MAX | MIN | SUM | COUNT
+	// AVG | MAX | MIN | SUM | COUNT | STDDEV | VARIANCE
 	var fn ssql.Function_Func
 	if ctx.AVG() != nil {
 		fn = ssql.Function_AVG
@@ -69,6 +90,10 @@ func (p *parser) VisitAggregate(ctx *gen.AggregateContext) interface{} {
 		fn = ssql.Function_SUM
 	} else if ctx.COUNT() != nil {
 		fn = ssql.Function_COUNT
+	} else if ctx.STDDEV() != nil {
+		fn = ssql.Function_STDDEV
+	} else if ctx.VARIANCE() != nil {
+		fn = ssql.Function_VARIANCE
 	}
 
 	return &ssql.Attribute{
@@ -95,6 +120,67 @@ func (p *parser) VisitPercentile(ctx *gen.PercentileContext) interface{} {
 	}
 }
 
+// VisitCountDistinct parses COUNT-DISTINCT with optional number of distinct values counted exactly
+func (p *parser) VisitCountDistinct(ctx *gen.CountDistinctContext) interface{} {
+	fn := &ssql.Function{Name: ssql.Function_COUNT_DISTINCT}
+	if n := ctx.INTEGER(); n != nil {
+		fn.Parameter = &ssql.Function_Int{Int: p.positive(n, "exact limit of count-distinct")}
+	}
+
+	return &ssql.Attribute{
+		Name: extractVariableName(ctx.IDENTIFIER().GetText()),
+		Func: fn,
+	}
+}
+
+// VisitFirstLast parses FIRST or LAST of the first variable by timestamp of the second
+func (p *parser) VisitFirstLast(ctx *gen.FirstLastContext) interface{} {
+	fn := ssql.Function_FIRST
+	if ctx.LAST() != nil {
+		fn = ssql.Function_LAST
+	}
+
+	return &ssql.Attribute{
+		Name: extractVariableName(ctx.IDENTIFIER(0).GetText()),
+		Func: &ssql.Function{
+			Name:      fn,
+			Parameter: &ssql.Function_Variable{Variable: extractVariableName(ctx.IDENTIFIER(1).GetText())},
+		},
+	}
+}
+
+// VisitTopK parses TOPK with number of the most frequent values
+func (p *parser) VisitTopK(ctx *gen.TopKContext) interface{} {
+	return &ssql.Attribute{
+		Name: extractVariableName(ctx.IDENTIFIER().GetText()),
+		Func: &ssql.Function{
+			Name:      ssql.Function_TOPK,
+			Parameter: &ssql.Function_Int{Int: p.positive(ctx.INTEGER(), "number of values of topk")},
+		},
+	}
+}
+
+// VisitHistogram parses HISTOGRAM with number of buckets
+func (p *parser) VisitHistogram(ctx *gen.HistogramContext) interface{} {
+	return &ssql.Attribute{
+		Name: extractVariableName(ctx.IDENTIFIER().GetText()),
+		Func: &ssql.Function{
+			Name:      ssql.Function_HISTOGRAM,
+			Parameter: &ssql.Function_Int{Int: p.positive(ctx.INTEGER(), "number of buckets of histogram")},
+		},
+	}
+}
+
+// positive parses integer of function parameter, and reports error unless it is positive
+func (p *parser) positive(n antlr.TerminalNode, name string) int64 {
+	v, err := strconv.ParseInt(n.GetText(), 10, 64)
+	if err != nil || v <= 0 {
+		p.error(n.GetSymbol(), name+" must be a positive integer")
+	}
+
+	return v
+}
+
 func (p *parser) VisitGroupBy(ctx *gen.GroupByContext) interface{} {
 	if part := ctx.Partition(); part != nil {
 		return p.VisitPartition(part.(*gen.PartitionContext))
@@ -154,6 +154,197 @@ func TestFind(t *testing.T) {
 					},
 				}}, false,
 		},
+		{"count-distinct", args{"find group-by($b), count-distinct($d) where [$b /adf/adf] [$d /df]"},
+			&ssql.Statement{
+				Find: []*ssql.Attribute{
+					{Name: "b", Group: true},
+					{Name: "d", Func: &ssql.Function{Name: ssql.Function_COUNT_DISTINCT}},
+				},
+				Where: []*ssql.Expr{
+					{
+						Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
+							Name: "b",
+							Path: "/adf/adf",
+						}},
+					},
+					{
+						Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
+							Name: "d",
+							Path: "/df",
+						}},
+					},
+				}}, false,
+		},
+		{"count-distinct exact", args{"find group-by($b), count-distinct($d, 100000) where [$b /adf/adf] [$d /df]"},
+			&ssql.Statement{
+				Find: []*ssql.Attribute{
+					{Name: "b", Group: true},
+					{Name: "d", Func: &ssql.Function{
+						Name:      ssql.Function_COUNT_DISTINCT,
+						Parameter: &ssql.Function_Int{Int: 100000},
+					}},
+				},
+				Where: []*ssql.Expr{
+					{
+						Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
+							Name: "b",
+							Path: "/adf/adf",
+						}},
+					},
+					{
+						Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
+							Name: "d",
+							Path: "/df",
+						}},
+					},
+				}}, false,
+		},
+		{"count-distinct zero", args{"find group-by($b), count-distinct($d, 0) where [$b /adf/adf] [$d /df]"},
+			&ssql.Statement{
+				Find: []*ssql.Attribute{
+					{Name: "b", Group: true},
+					{Name: "d", Func: &ssql.Function{
+						Name:      ssql.Function_COUNT_DISTINCT,
+						Parameter: &ssql.Function_Int{Int: 0},
+					}},
+				},
+				Where: []*ssql.Expr{
+					{
+						Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
+							Name: "b",
+							Path: "/adf/adf",
+						}},
+					},
+					{
+						Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
+							Name: "d",
+							Path: "/df",
+						}},
+					},
+				}}, true,
+		},
+		{"stddev", args{"find group-by($b), stddev($d), variance($d) where [$b /adf/adf] [$d /df]"},
+			&ssql.Statement{
+				Find: []*ssql.Attribute{
+					{Name: "b", Group: true},
+					{Name: "d", Func: &ssql.Function{Name: ssql.Function_STDDEV}},
+					{Name: "d", Func: &ssql.Function{Name: ssql.Function_VARIANCE}},
+				},
+				Where: []*ssql.Expr{
+					{
+						Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
+							Name: "b",
+							Path: "/adf/adf",
+						}},
+					},
+					{
+						Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
+							Name: "d",
+							Path: "/df",
+						}},
+					},
+				}}, false,
+		},
+		{"first last", args{"find group-by($b), first($d, $b), last($d, $b) where [$b /adf/adf] [$d /df]"},
+			&ssql.Statement{
+				Find: []*ssql.Attribute{
+					{Name: "b", Group: true},
+					{Name: "d", Func: &ssql.Function{
+						Name:      ssql.Function_FIRST,
+						Parameter: &ssql.Function_Variable{Variable: "b"},
+					}},
+					{Name: "d", Func: &ssql.Function{
+						Name:      ssql.Function_LAST,
+						Parameter: &ssql.Function_Variable{Variable: "b"},
+					}},
+				},
+				Where: []*ssql.Expr{
+					{
+						Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
+							Name: "b",
+							Path: "/adf/adf",
+						}},
+					},
+					{
+						Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
+							Name: "d",
+							Path: "/df",
+						}},
+					},
+				}}, false,
+		},
+		{"topk", args{"find group-by($b), topk($d, 5) where [$b /adf/adf] [$d /df]"},
+			&ssql.Statement{
+				Find: []*ssql.Attribute{
+					{Name: "b", Group: true},
+					{Name: "d", Func: &ssql.Function{
+						Name:      ssql.Function_TOPK,
+						Parameter: &ssql.Function_Int{Int: 5},
+					}},
+				},
+				Where: []*ssql.Expr{
+					{
+						Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
+							Name: "b",
+							Path: "/adf/adf",
+						}},
+					},
+					{
+						Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
+							Name: "d",
+							Path: "/df",
+						}},
+					},
+				}}, false,
+		},
+		{"histogram", args{"find group-by($b), histogram($d, 10) where [$b /adf/adf] [$d /df]"},
+			&ssql.Statement{
+				Find: []*ssql.Attribute{
+					{Name: "b", Group: true},
+					{Name: "d", Func: &ssql.Function{
+						Name:      ssql.Function_HISTOGRAM,
+						Parameter: &ssql.Function_Int{Int: 10},
+					}},
+				},
+				Where: []*ssql.Expr{
+					{
+						Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
+							Name: "b",
+							Path: "/adf/adf",
+						}},
+					},
+					{
+						Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
+							Name: "d",
+							Path: "/df",
+						}},
+					},
+				}}, false,
+		},
+		{"histogram zero", args{"find group-by($b), histogram($d, 0) where [$b /adf/adf] [$d /df]"},
+			&ssql.Statement{
+				Find: []*ssql.Attribute{
+					{Name: "b", Group: true},
+					{Name: "d", Func: &ssql.Function{
+						Name:      ssql.Function_HISTOGRAM,
+						Parameter: &ssql.Function_Int{Int: 0},
+					}},
+				},
+				Where: []*ssql.Expr{
+					{
+						Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
+							Name: "b",
+							Path: "/adf/adf",
+						}},
+					},
+					{
+						Field: &ssql.Expr_Tuple{Tuple: &ssql.Tuple{
+							Name: "d",
+							Path: "/df",
+						}},
+					},
+				}}, true,
+		},
 		{"part", args{"find group-by(part($b, 20)), pctl($d, 0.6) where [$b /adf/adf] [$d /df]"},
 			&ssql.Statement{
 				Find: []*ssql.Attribute{
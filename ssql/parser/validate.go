@@ -0,0 +1,83 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package parser
+
+import (
+	"fmt"
+
+	"github.com/chronowave/chronowave/ssql"
+)
+
+// validate reports semantic errors of parsed statement: variables of FIND, including timestamps of
+// FIRST and LAST, not bound in WHERE, ORDER-BY not in FIND, and plain attributes not in GROUP-BY
+// mixed with aggregates or GROUP-BY
+func (p *parser) validate() {
+	bound := map[string]bool{}
+	bindVariables(p.stmt.Where, bound)
+
+	found, grouped, aggregated := map[string]bool{}, map[string]bool{}, false
+	for _, attr := range p.stmt.Find {
+		found[attr.Name] = true
+		if !bound[attr.Name] {
+			p.error(p.tokens[attr], fmt.Sprintf("variable $%v is not bound in WHERE", attr.Name))
+		}
+
+		if attr.Group {
+			grouped[attr.Name] = true
+		} else if attr.Func != nil {
+			aggregated = true
+		}
+
+		if ts := attr.GetFunc().GetVariable(); len(ts) > 0 && !bound[ts] {
+			p.error(p.tokens[attr], fmt.Sprintf("timestamp $%v of %v is not bound in WHERE", ts, attr.Func.Name))
+		}
+	}
+
+	if aggregated || len(grouped) > 0 {
+		for _, attr := range p.stmt.Find {
+			if !attr.Group && attr.Func == nil && !grouped[attr.Name] {
+				p.error(p.tokens[attr], fmt.Sprintf("variable $%v must be aggregated or in GROUP-BY", attr.Name))
+			}
+		}
+	}
+
+	for _, orderby := range p.stmt.OrderBy {
+		if !found[orderby.Name] {
+			p.error(p.tokens[orderby], fmt.Sprintf("ORDER-BY $%v is not in FIND", orderby.Name))
+		}
+	}
+}
+
+// bindVariables collects names of tuples in expressions, including nested and OR
+func bindVariables(expr []*ssql.Expr, bound map[string]bool) {
+	for _, e := range expr {
+		switch field := e.Field.(type) {
+		case *ssql.Expr_Tuple:
+			if len(field.Tuple.Name) > 0 {
+				bound[field.Tuple.Name] = true
+			}
+			if nested, ok := field.Tuple.Predicate.(*ssql.Tuple_Nested); ok {
+				bindVariables(nested.Nested.Expr, bound)
+			}
+		case *ssql.Expr_Or:
+			bindVariables(field.Or.Expr, bound)
+		}
+	}
+}
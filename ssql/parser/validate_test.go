@@ -0,0 +1,68 @@
+/*
+ *  Copyright 2020 ChronoWave Authors
+ *
+ *  Licensed under the Apache License, Version 2.0 (the "License");
+ *  you may not use this file except in compliance with the License.
+ *  You may obtain a copy of the License at
+ *
+ *       http://www.apache.org/licenses/LICENSE-2.0
+ *
+ *  Unless required by applicable law or agreed to in writing, software
+ *  distributed under the License is distributed on an "AS IS" BASIS,
+ *  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ *  See the License for the specific language governing permissions and
+ *  limitations under the License.
+ *
+ *  Package parser declares an expression parser with support for macro
+ *  expansion.
+ */
+
+package parser
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name  string
+		query string
+		want  []Error
+	}{
+		{"bound", "find $a, $b where [$a /a] {[$b /b] [/c]} order-by $b desc", nil},
+		{"bound nested", "find $b where [/a [$b /b eq(1)]]", nil},
+		{"group by", "find group-by(part($a, 10), $b), avg($c) where [$a /a] [$b /b] [$c /c]", nil},
+		{"aggregate only", "find avg($a), count($b) where [$a /a] [$b /b]", nil},
+		{"unbound", "find $a, $x where [$a /a]",
+			[]Error{{Line: 1, Column: 9, Message: "variable $x is not bound in WHERE"}}},
+		{"unbound aggregate", "find group-by($a), max($x) where [$a /a]",
+			[]Error{{Line: 1, Column: 19, Message: "variable $x is not bound in WHERE"}}},
+		{"order by", "find $a where [$a /a] [$b /b]\norder-by $b",
+			[]Error{{Line: 2, Column: 9, Message: "ORDER-BY $b is not in FIND"}}},
+		{"mixed aggregate", "find $a, sum($b) where [$a /a] [$b /b]",
+			[]Error{{Line: 1, Column: 5, Message: "variable $a must be aggregated or in GROUP-BY"}}},
+		{"not positive", "find topk($a, 0) where [$a /a]",
+			[]Error{{Line: 1, Column: 14, Message: "number of values of topk must be a positive integer"}}},
+		{"unbound timestamp", "find group-by($a), last($b, $ts) where [$a /a] [$b /b]",
+			[]Error{{Line: 1, Column: 19, Message: "timestamp $ts of LAST is not bound in WHERE"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, got := Parse(tt.query); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse() errors = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSyntaxErrorNoPanic(t *testing.T) {
+	queries := []string{"find", "find $a where", "find where [/a]", "find sum( where [$a /a]", "find $a where [$a /a] order-by",
+		// plain attributes are not in GROUP-BY
+		"find group-by($a), $b, count($c) where [$a /a] [$b /b] [$c /c]", "find group-by($a), $b where [$a /a] [$b /b]"}
+	for _, query := range queries {
+		if _, errs := Parse(query); len(errs) == 0 {
+			t.Errorf("Parse(%q) has no error", query)
+		}
+	}
+}